@@ -2,9 +2,13 @@
 // Use of this source code is governed by an ISC
 // license that can be found in the LICENSE file.
 
-package main
+// Package gorpcbench holds the list of RPC systems and the matrix of test
+// cases that are benchmarked.
+package gorpcbench
 
 import (
+	"time"
+
 	"github.com/matheusd/gorpcbench/internal/rpc/gocapnp"
 	"github.com/matheusd/gorpcbench/internal/rpc/grpc"
 	"github.com/matheusd/gorpcbench/internal/rpc/http1"
@@ -14,56 +18,195 @@ import (
 	"github.com/matheusd/gorpcbench/rpcbench"
 )
 
-var allSystems = []rpcbench.RPCSystem{
+// AllSystems is the list of every RPC system that is benchmarked.
+var AllSystems = []rpcbench.RPCSystem{
 	{
 		Name:   "tcp",
 		Initer: tcp.TCPFactoryIniter,
 		Notes:  "Raw TCP-based RPC implementation",
+		Caps:   rpcbench.CapServerStream | rpcbench.CapClientStream | rpcbench.CapBidiStream | rpcbench.CapCallback | rpcbench.CapAppError | rpcbench.CapTLS | rpcbench.CapCancel | rpcbench.CapOverlap | rpcbench.CapLargeMessage | rpcbench.CapRecords | rpcbench.CapRows | rpcbench.CapMap | rpcbench.CapVector | rpcbench.CapMetadata,
 	}, {
 		Name:   "http1",
 		Initer: http1.HTTP1FactoryIniter,
 		Notes:  "HTTP-based RPC implementation",
+		Caps:   rpcbench.CapAppError | rpcbench.CapTLS | rpcbench.CapCancel | rpcbench.CapLargeMessage | rpcbench.CapRecords | rpcbench.CapRows | rpcbench.CapMap | rpcbench.CapVector | rpcbench.CapMetadata,
 	}, {
 		Name:   "ws",
 		Initer: websocket.WSFactoryIniter,
 		Notes:  "Websockets-based RPC implementation",
+		Caps:   rpcbench.CapServerStream | rpcbench.CapClientStream | rpcbench.CapBidiStream | rpcbench.CapCallback | rpcbench.CapAppError | rpcbench.CapTLS | rpcbench.CapCancel | rpcbench.CapOverlap | rpcbench.CapLargeMessage | rpcbench.CapRecords | rpcbench.CapRows | rpcbench.CapMap | rpcbench.CapVector | rpcbench.CapMetadata,
 	}, {
 		Name:   "wsjson",
 		Initer: websocket.WSJsonFactoryIniter,
 		Notes:  "Websockets-based RPC implementation (JSON)",
+		Caps:   rpcbench.CapServerStream | rpcbench.CapClientStream | rpcbench.CapBidiStream | rpcbench.CapCallback | rpcbench.CapAppError | rpcbench.CapTLS | rpcbench.CapCancel | rpcbench.CapOverlap | rpcbench.CapLargeMessage | rpcbench.CapRecords | rpcbench.CapRows | rpcbench.CapMap | rpcbench.CapMetadata,
+
+		Unsupported: map[rpcbench.Capability]string{
+			rpcbench.CapVector: "JSON cannot encode NaN or ±Inf",
+		},
 	}, {
 		Name:   "grpc",
 		Initer: grpc.GRPCFactoryIniter,
 		Notes:  "gRPC based implementation",
+		Caps:   rpcbench.CapMultiplex | rpcbench.CapServerStream | rpcbench.CapClientStream | rpcbench.CapBidiStream | rpcbench.CapCallback | rpcbench.CapAppError | rpcbench.CapTLS | rpcbench.CapCancel | rpcbench.CapOverlap | rpcbench.CapLargeMessage | rpcbench.CapRecords | rpcbench.CapRows | rpcbench.CapMap | rpcbench.CapVector | rpcbench.CapMetadata,
 	}, {
 		Name:   "gocapnp",
 		Initer: gocapnp.GoCapnpIniter,
 		Notes:  "go-CapNProto based implementation",
+		Caps:   rpcbench.CapMultiplex | rpcbench.CapServerStream | rpcbench.CapClientStream | rpcbench.CapPipeline | rpcbench.CapCallback | rpcbench.CapAppError | rpcbench.CapTLS | rpcbench.CapCancel | rpcbench.CapOverlap | rpcbench.CapLargeMessage | rpcbench.CapRecords | rpcbench.CapRows | rpcbench.CapMap | rpcbench.CapVector | rpcbench.CapMetadata,
 	}, {
 		Name:   "mdcapnp",
 		Initer: mdcapnp.MDCapNProtoFactoryIniter,
 		Notes:  "MdCapNProto based implementation",
+		Caps:   rpcbench.CapMultiplex | rpcbench.CapAppError | rpcbench.CapTLS | rpcbench.CapCancel | rpcbench.CapOverlap | rpcbench.CapRecords | rpcbench.CapRows | rpcbench.CapMap | rpcbench.CapVector | rpcbench.CapMetadata,
+
+		// The workloads built on capabilities passed between client and
+		// server are pending, as is raising the read limits of the conns
+		// to the max message size.
+		Pending: rpcbench.CapServerStream | rpcbench.CapClientStream | rpcbench.CapPipeline | rpcbench.CapCallback | rpcbench.CapLargeMessage,
 	}, {
 		Name:   "mdcapl0",
 		Initer: mdcapnp.MDCapNProtoLevel0FactoryIniter,
 		Notes:  "Level 0 MdCapNProto based implementation",
+		Caps:   rpcbench.CapAppError | rpcbench.CapTLS | rpcbench.CapCancel | rpcbench.CapRecords | rpcbench.CapRows | rpcbench.CapMap | rpcbench.CapVector | rpcbench.CapMetadata,
+
+		// Raising the read limits of the conns to the max message size
+		// is pending.
+		Pending: rpcbench.CapLargeMessage,
 	},
 }
 
-func fullTestMatrix() []rpcbench.BenchCase {
+// openLoopRates are the target call rates (calls/second) of the open-loop
+// cases.
+var openLoopRates = []int{1000, 10000, 50000}
+
+// clientCounts are the number of concurrent clients of the parallel cases that
+// sweep the client count.
+var clientCounts = []int{1, 8, 64, 512}
+
+// bidiWindows are the number of in-flight messages of the bidi stream cases
+// that sweep the window size.
+var bidiWindows = []int{1, 8, 64, 512}
+
+// chainLengths are the number of dependent calls of the chain cases that sweep
+// the chain length.
+var chainLengths = []int{1, 2, 8, 16}
+
+// errorFractions are the fractions of failed calls of the error cases that
+// sweep the error fraction.
+var errorFractions = []float64{0.01, 0.5, 1}
+
+// headOfLineWorks are the durations of the Work calls of the head-of-line cases
+// that sweep the work duration.
+var headOfLineWorks = []time.Duration{100 * time.Microsecond, 10 * time.Millisecond}
+
+// largeMessageSizes are the payload sizes of the large-message cases that sweep
+// the message size. Sizes above the max message size of the run are skipped.
+var largeMessageSizes = []int{4 << 20, 16 << 20, 64 << 20}
+
+// mapSizes are the number of entries of the map cases that sweep the map size.
+var mapSizes = []int{4, 1024}
+
+// callTimeout is the timeout of every call of the cases that measure the
+// overhead of carrying a deadline on calls. Calls are not expected to hit it.
+const callTimeout = time.Second
+
+// FullTestMatrix returns every test case that is benchmarked. This includes the
+// cases whose implementation is pending in their system and the ones their
+// system cannot support, which are skipped when run.
+func FullTestMatrix() []rpcbench.BenchCase {
 	calls := rpcbench.ClientCallMatrix()
-	parallelCases := []bool{false, true}
-	matrix := make([]rpcbench.BenchCase, 0, len(parallelCases)*len(calls)*len(allSystems))
-	for _, parallel := range parallelCases {
+	var matrix []rpcbench.BenchCase
+
+	// addCallCases adds one case for every one of the calls and system,
+	// with the remaining settings copied from tmpl. Cases not supported by
+	// a system are skipped, unless they are to be reported as skipped.
+	addCallCases := func(tmpl rpcbench.BenchCase, calls []rpcbench.ClientCall) {
 		for _, call := range calls {
-			for si := range allSystems {
-				matrix = append(matrix, rpcbench.BenchCase{
-					Sys:      &allSystems[si],
-					Call:     call,
-					Parallel: parallel,
-				})
+			for si := range AllSystems {
+				bc := tmpl
+				bc.Sys = &AllSystems[si]
+				bc.Call = call
+				if !bc.Supported() && bc.SkipReason() == "" {
+					continue
+				}
+				matrix = append(matrix, bc)
 			}
 		}
 	}
+	addCases := func(tmpl rpcbench.BenchCase) {
+		addCallCases(tmpl, calls)
+	}
+
+	parallelCases := []bool{false, true}
+	for _, serverProc := range []bool{false, true} {
+		for _, parallel := range parallelCases {
+			addCases(rpcbench.BenchCase{Parallel: parallel, ServerProcess: serverProc})
+		}
+	}
+	for _, clients := range clientCounts {
+		addCases(rpcbench.BenchCase{Parallel: true, Clients: clients})
+	}
+	for _, window := range bidiWindows {
+		for _, parallel := range parallelCases {
+			addCallCases(rpcbench.BenchCase{Parallel: parallel, Window: window},
+				[]rpcbench.ClientCall{rpcbench.ClientCallBidiStream})
+		}
+	}
+	for _, k := range chainLengths {
+		chain := []rpcbench.ClientCall{rpcbench.ClientCallAddChain}
+		addCallCases(rpcbench.BenchCase{ChainLength: k}, chain)
+		addCallCases(rpcbench.BenchCase{ChainLength: k, Link: &rpcbench.LinkLAN}, chain)
+	}
+	for _, f := range errorFractions {
+		addCallCases(rpcbench.BenchCase{ErrorFraction: f}, []rpcbench.ClientCall{rpcbench.ClientCallError})
+		addCallCases(rpcbench.BenchCase{ErrorFraction: f, Parallel: true}, []rpcbench.ClientCall{rpcbench.ClientCallError})
+	}
+	hol := []rpcbench.ClientCall{rpcbench.ClientCallHeadOfLine}
+	for _, parallel := range parallelCases {
+		addCallCases(rpcbench.BenchCase{Parallel: parallel, WorkCPU: true}, hol)
+		for _, work := range headOfLineWorks {
+			addCallCases(rpcbench.BenchCase{Parallel: parallel, Work: work}, hol)
+			addCallCases(rpcbench.BenchCase{Parallel: parallel, Work: work, WorkCPU: true}, hol)
+		}
+	}
+	for _, size := range largeMessageSizes {
+		if size > rpcbench.MaxMessageSize() {
+			continue
+		}
+		for _, parallel := range parallelCases {
+			addCallCases(rpcbench.BenchCase{Parallel: parallel, MessageSize: size},
+				[]rpcbench.ClientCall{rpcbench.ClientCallLarge})
+		}
+	}
+	for _, size := range mapSizes {
+		for _, parallel := range parallelCases {
+			addCallCases(rpcbench.BenchCase{Parallel: parallel, MapSize: size},
+				[]rpcbench.ClientCall{rpcbench.ClientCallMap})
+		}
+	}
+	for _, parallel := range parallelCases {
+		addCases(rpcbench.BenchCase{Parallel: parallel, CallTimeout: callTimeout})
+	}
+	addCases(rpcbench.BenchCase{Parallel: true, SharedClient: true})
+	for _, clients := range clientCounts {
+		addCases(rpcbench.BenchCase{Parallel: true, Clients: clients, SharedClient: true})
+	}
+	for _, parallel := range parallelCases {
+		addCases(rpcbench.BenchCase{Parallel: parallel, Network: "unix"})
+	}
+	for _, tlsMode := range []rpcbench.TLSMode{rpcbench.TLSOn, rpcbench.TLSResume} {
+		for _, parallel := range parallelCases {
+			addCases(rpcbench.BenchCase{Parallel: parallel, TLS: tlsMode})
+		}
+	}
+	for _, rate := range openLoopRates {
+		addCases(rpcbench.BenchCase{Rate: rate})
+	}
+	for _, link := range rpcbench.LinkProfiles() {
+		for _, parallel := range parallelCases {
+			addCases(rpcbench.BenchCase{Parallel: parallel, Link: link})
+		}
+	}
 	return matrix
 }
@@ -2,16 +2,28 @@
 // Use of this source code is governed by an ISC
 // license that can be found in the LICENSE file.
 
-package main
+package gorpcbench
 
 import (
+	"fmt"
+	"os"
 	"testing"
 
 	"github.com/matheusd/gorpcbench/rpcbench"
 )
 
+func TestMain(m *testing.M) {
+	// When re-executed as a server process, this does not return.
+	rpcbench.MaybeRunServerProcess(AllSystems)
+
+	if err := rpcbench.MaxMessageSizeEnvErr(); err != nil {
+		fmt.Fprintf(os.Stderr, "warning: %v\n", err)
+	}
+	os.Exit(m.Run())
+}
+
 func BenchmarkRPC(b *testing.B) {
-	matrix := fullTestMatrix()
+	matrix := FullTestMatrix()
 
 	for _, bc := range matrix {
 		b.Run(bc.Name(), func(b *testing.B) {
@@ -22,3 +34,34 @@ func BenchmarkRPC(b *testing.B) {
 		})
 	}
 }
+
+// TestSkippedCases tests that the cases whose implementation is pending in a
+// system, and the ones a system cannot support, are kept in the test matrix (to
+// be reported as skipped), while every other case in it is supported.
+func TestSkippedCases(t *testing.T) {
+	pending := make(map[string]rpcbench.Capability)
+	unsupported := make(map[string]rpcbench.Capability)
+	for _, bc := range FullTestMatrix() {
+		if bc.Supported() {
+			continue
+		}
+		if bc.SkipReason() == "" {
+			t.Fatalf("case %s is neither supported nor skipped", bc.Name())
+		}
+		pending[bc.Sys.Name] |= bc.PendingCaps()
+		unsupported[bc.Sys.Name] |= bc.UnsupportedCaps()
+	}
+	for _, sys := range AllSystems {
+		got := pending[sys.Name]
+		if (got == 0) != (sys.Pending == 0) || !sys.Pending.Has(got) {
+			t.Fatalf("%s: got cases pending on %v, want some pending on %v", sys.Name, got, sys.Pending)
+		}
+		var want rpcbench.Capability
+		for c := range sys.Unsupported {
+			want |= c
+		}
+		if got := unsupported[sys.Name]; got != want {
+			t.Fatalf("%s: got unsupported cases on %v, want %v", sys.Name, got, want)
+		}
+	}
+}
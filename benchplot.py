@@ -10,6 +10,15 @@ import matplotlib.pyplot as plt
 RE_TIME = re.compile(r'(?P<v>[\d.]+)\s*(?P<u>ns|us|µs|μs|ms|s)/op')
 RE_BYTES_OP = re.compile(r'(?P<v>[\d.]+)\s*B/op')
 RE_THROUGHPUT = re.compile(r'(?P<v>[\d.]+)\s*(?P<u>[kKmMgG]?B)/s')
+RE_P99 = re.compile(r'(?P<v>[\d.]+)\s*p99-ns')
+
+# Kind of the tests that sweep the number of clients, like "parallel-c64"
+RE_CLIENTS_KIND = re.compile(r'^parallel-c(?P<n>\d+)$')
+
+# Workload of the dependent call chains, like "chain-k8". Plain "chain" uses the
+# default chain length.
+RE_CHAIN_WORKLOAD = re.compile(r'^chain(-k(?P<k>\d+))?$')
+DEFAULT_CHAIN_LENGTH = 4
 
 UNIT_TIME = {'ns': 1e-9, 'us': 1e-6, 'µs': 1e-6, 'μs': 1e-6, 'ms': 1e-3, 's': 1.0}
 UNIT_BYTES_SEC = {'B': 1.0, 'kB': 1e3, 'KB': 1e3, 'MB': 1e6, 'GB': 1e9}  # decimal; constant cancels in ratios
@@ -57,6 +66,16 @@ def parse_line(line):
             ukey = 'B'
         bytes_per_sec = float(m_thr.group('v')) * UNIT_BYTES_SEC[ukey]
 
+    p99 = None
+    m_p99 = RE_P99.search(line)
+    if m_p99:
+        p99 = float(m_p99.group('v')) * 1e-9
+
+    clients = None
+    m_clients = RE_CLIENTS_KIND.match(kind)
+    if m_clients:
+        clients = int(m_clients.group('n'))
+
     return {
         'name': name,
         'kind': kind,
@@ -65,6 +84,8 @@ def parse_line(line):
         'sec/op': sec_per_op,
         'bytes/op': bytes_per_op,
         'bytes/sec': bytes_per_sec,
+        'p99': p99,
+        'clients': clients,
     }
 
 def read_bench(stream):
@@ -122,6 +143,70 @@ def plot_relative(df, metric, title, ylabel, outfile, kind_filter, lower_better,
     plt.close()
     print(f"Saved: {outfile}")
 
+def plot_clients(df, workload, outfile):
+    subset = df[(df['workload'] == workload) & df['clients'].notna()].copy()
+    if subset.empty:
+        print(f"Warning: no client sweep rows for workload={workload}")
+        return
+
+    subset['calls/sec'] = 1 / subset['sec/op']
+    subset['p99 (ms)'] = subset['p99'] * 1e3
+
+    os.makedirs(os.path.dirname(outfile), exist_ok=True)
+    fig, axes = plt.subplots(1, 2, figsize=(14, 6))
+    for ax, metric in zip(axes, ['calls/sec', 'p99 (ms)']):
+        pivot = subset.pivot_table(index='clients', columns='system', values=metric, aggfunc='mean')
+        pivot.plot(ax=ax, marker='o', logx=True, logy=True)
+        ax.set_title(f'{workload}: {metric} by number of clients')
+        ax.set_xlabel('Clients (log scale)')
+        ax.set_ylabel(f'{metric} (log scale)')
+        ax.set_xticks(pivot.index)
+        ax.set_xticklabels([str(int(n)) for n in pivot.index])
+        ax.legend(title='System')
+    plt.tight_layout()
+    plt.savefig(outfile, dpi=150)
+    plt.close()
+    print(f"Saved: {outfile}")
+
+def plot_chain(df, kinds, outfile):
+    fig, axes = plt.subplots(1, len(kinds), figsize=(7 * len(kinds), 6), squeeze=False)
+    plotted = False
+    for ax, kind in zip(axes[0], kinds):
+        subset = df[df['kind'] == kind]
+        add = subset[subset['workload'] == 'add'].groupby('system')['sec/op'].mean()
+
+        # Latency saved by a chain of k calls, compared to k independent
+        # round trips of the add test.
+        rows = []
+        for _, r in subset.iterrows():
+            m = RE_CHAIN_WORKLOAD.match(r['workload'])
+            if not m or r['system'] not in add.index or r['sec/op'] is None:
+                continue
+            k = int(m.group('k') or DEFAULT_CHAIN_LENGTH)
+            saved = k * add[r['system']] - r['sec/op']
+            rows.append({'system': r['system'], 'k': k, 'saved (us)': saved * 1e6})
+        if not rows:
+            print(f"Warning: no chain rows for kind={kind}")
+            continue
+
+        pivot = pd.DataFrame(rows).pivot_table(index='k', columns='system', values='saved (us)', aggfunc='mean')
+        pivot.plot(ax=ax, marker='o', logx=True)
+        ax.axhline(0, color='gray', linestyle='--', alpha=0.7)
+        ax.set_title(f'{kind}: latency saved by pipelining')
+        ax.set_xlabel('Chain length (log scale)')
+        ax.set_ylabel('k * add - chain (us)')
+        ax.set_xticks(pivot.index)
+        ax.set_xticklabels([str(int(k)) for k in pivot.index])
+        ax.legend(title='System')
+        plotted = True
+
+    if plotted:
+        os.makedirs(os.path.dirname(outfile), exist_ok=True)
+        plt.tight_layout()
+        plt.savefig(outfile, dpi=150)
+        print(f"Saved: {outfile}")
+    plt.close()
+
 def main():
     ap = argparse.ArgumentParser(description="Plot Go benchmark results (relative to tcp) from go test -bench output.")
     ap.add_argument('--in', dest='infile', default='-',
@@ -168,5 +253,17 @@ def main():
         logy=False,
     )
 
+    # 4) Throughput and tail latency by number of clients
+    plot_clients(
+        df, workload='nop',
+        outfile=os.path.join(args.outdir, 'nop-clients.png'),
+    )
+
+    # 5) Latency saved by pipelining chains of dependent calls
+    plot_chain(
+        df, kinds=['sequential', 'sequential-lan'],
+        outfile=os.path.join(args.outdir, 'chain-saved.png'),
+    )
+
 if __name__ == '__main__':
     main()
@@ -0,0 +1,184 @@
+// Copyright (c) 2025 Matheus Degiovani
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"runtime"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/matheusd/gorpcbench"
+	"github.com/matheusd/gorpcbench/rpcbench"
+)
+
+// benchPrefix is the prefix of every test name. This matches the name of the
+// benchmark function in "go test", so that results from both tools can be
+// compared and processed by the same tools.
+const benchPrefix = "BenchmarkRPC/"
+
+// listFilter is a flag that holds a comma-separated list of values. An empty
+// list matches every value.
+type listFilter []string
+
+func (lf *listFilter) String() string {
+	return strings.Join(*lf, ",")
+}
+
+func (lf *listFilter) Set(s string) error {
+	*lf = strings.Split(s, ",")
+	return nil
+}
+
+func (lf listFilter) matches(v string) bool {
+	return len(lf) == 0 || slices.Contains(lf, v)
+}
+
+// caseMode returns the mode of the test case (e.g. "sequential", "parallel",
+// etc), which is the first element of its name.
+func caseMode(bc rpcbench.BenchCase) string {
+	mode, _, _ := strings.Cut(bc.Name(), "/")
+	return mode
+}
+
+// matrixFlags are the flags that select and configure the test cases to run,
+// shared between the commands that run tests.
+type matrixFlags struct {
+	calls      listFilter
+	modes      listFilter
+	benchTime  string
+	count      int
+	maxMsgSize int
+}
+
+func (mf *matrixFlags) register(fs *flag.FlagSet) {
+	fs.Var(&mf.calls, "call", "Comma-separated list of calls to run (e.g. nop,hex) (default: all)")
+	fs.Var(&mf.modes, "mode", "Comma-separated list of test modes to run (e.g. sequential,parallel) (default: all)")
+	fs.StringVar(&mf.benchTime, "benchtime", "1s", "Run each test for the given duration (e.g. 5s) or number of calls (e.g. 1000x)")
+	fs.IntVar(&mf.count, "count", 1, "Run each test this many times")
+	registerMaxMsgSize(fs, &mf.maxMsgSize)
+}
+
+// registerMaxMsgSize registers the flag with the max message size of the run.
+// The flag must be applied with applyMaxMsgSize before any test case is
+// created.
+func registerMaxMsgSize(fs *flag.FlagSet, size *int) {
+	fs.IntVar(size, "maxmsgsize", rpcbench.MaxMessageSize(), "Max size (in bytes) of the payload of calls, which also limits the size of large-message tests")
+}
+
+// applyMaxMsgSize applies the max message size flag, after fs is parsed. When
+// the flag is not set and the size in the environment is invalid, this warns
+// that the default one is used.
+func applyMaxMsgSize(fs *flag.FlagSet, size int) error {
+	setFlag := false
+	fs.Visit(func(f *flag.Flag) { setFlag = setFlag || f.Name == "maxmsgsize" })
+	if err := rpcbench.MaxMessageSizeEnvErr(); err != nil && !setFlag {
+		fmt.Fprintf(os.Stderr, "warning: %v\n", err)
+	}
+	return rpcbench.SetMaxMessageSize(size)
+}
+
+// matches returns true if the test case is selected by the flags.
+func (mf *matrixFlags) matches(bc rpcbench.BenchCase) bool {
+	return mf.calls.matches(bc.Call.String()) && mf.modes.matches(caseMode(bc))
+}
+
+// runMatrix runs the test cases and prints their results in the same format
+// as "go test -bench".
+func runMatrix(matrix []rpcbench.BenchCase, mf *matrixFlags) error {
+	if len(matrix) == 0 {
+		return errors.New("no test cases match the filters")
+	}
+
+	if err := flag.Set("test.benchtime", mf.benchTime); err != nil {
+		return fmt.Errorf("invalid benchtime: %w", err)
+	}
+
+	suffix := ""
+	if procs := runtime.GOMAXPROCS(0); procs > 1 {
+		suffix = fmt.Sprintf("-%d", procs)
+	}
+	var maxLen int
+	for _, bc := range matrix {
+		maxLen = max(maxLen, len(benchPrefix+bc.Name()+suffix))
+	}
+
+	fmt.Printf("goos: %s\n", runtime.GOOS)
+	fmt.Printf("goarch: %s\n", runtime.GOARCH)
+	fmt.Printf("pkg: github.com/matheusd/gorpcbench\n")
+
+	var failed bool
+	for _, bc := range matrix {
+		name := benchPrefix + bc.Name() + suffix
+		if reason := bc.SkipReason(); reason != "" {
+			fmt.Printf("--- SKIP: %s\n", name)
+			fmt.Printf("    %s\n", reason)
+			continue
+		}
+		for range mf.count {
+			var runErr error
+			res := testing.Benchmark(func(b *testing.B) {
+				if err := rpcbench.RunCase(b, bc); err != nil {
+					runErr = err
+					b.Fatal(err)
+				}
+			})
+			if runErr != nil || res.N == 0 {
+				failed = true
+				fmt.Printf("--- FAIL: %s\n", name)
+				if runErr != nil {
+					fmt.Printf("    %v\n", runErr)
+				}
+				break
+			}
+			fmt.Printf("%-*s\t%s\t%s\n", maxLen, name, res.String(), res.MemString())
+		}
+	}
+
+	if failed {
+		fmt.Println("FAIL")
+		return errors.New("some tests failed")
+	}
+	fmt.Println("PASS")
+	return nil
+}
+
+// listSystems prints a table with the capabilities and notes of every RPC
+// system.
+func listSystems() error {
+	return rpcbench.WriteCapabilityTable(os.Stdout, gorpcbench.AllSystems)
+}
+
+// runBench runs both servers and clients of the selected test cases.
+func runBench(args []string) error {
+	fs := flag.NewFlagSet("gorpcbench", flag.ContinueOnError)
+	var systems listFilter
+	var mf matrixFlags
+	list := fs.Bool("list", false, "List the available RPC systems and their capabilities and exit")
+	fs.Var(&systems, "sys", "Comma-separated list of RPC systems to run (default: all)")
+	mf.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := applyMaxMsgSize(fs, mf.maxMsgSize); err != nil {
+		return err
+	}
+
+	if *list {
+		return listSystems()
+	}
+
+	var matrix []rpcbench.BenchCase
+	for _, bc := range gorpcbench.FullTestMatrix() {
+		if systems.matches(bc.Sys.Name) && mf.matches(bc) {
+			matrix = append(matrix, bc)
+		}
+	}
+	return runMatrix(matrix, &mf)
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2025 Matheus Degiovani
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Command gorpcbench runs the RPC benchmarks outside of "go test".
+//
+// Usage:
+//
+//	gorpcbench [flags]                                 Run the benchmarks.
+//	gorpcbench serve -sys <name> -listen <addr>        Only run a server.
+//	gorpcbench load -sys <name> -connect <addr> [flags] Only run the clients.
+//
+// Run any of the commands with -h for the list of flags.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/matheusd/gorpcbench"
+	"github.com/matheusd/gorpcbench/rpcbench"
+)
+
+// findSystem returns the RPC system with the given name.
+func findSystem(name string) (*rpcbench.RPCSystem, error) {
+	i := slices.IndexFunc(gorpcbench.AllSystems, func(sys rpcbench.RPCSystem) bool {
+		return sys.Name == name
+	})
+	if i < 0 {
+		return nil, fmt.Errorf("unknown RPC system %q", name)
+	}
+	return &gorpcbench.AllSystems[i], nil
+}
+
+func run(args []string) error {
+	if len(args) > 0 {
+		switch args[0] {
+		case "serve":
+			return runServe(args[1:])
+		case "load":
+			return runLoad(args[1:])
+		}
+	}
+	return runBench(args)
+}
+
+func main() {
+	// When re-executed as a server process, this does not return.
+	rpcbench.MaybeRunServerProcess(gorpcbench.AllSystems)
+
+	// Registers the testing flags (in particular, test.benchtime), which
+	// are used by testing.Benchmark().
+	testing.Init()
+
+	if err := run(os.Args[1:]); err != nil {
+		if err != flag.ErrHelp {
+			fmt.Fprintln(os.Stderr, err)
+		}
+		os.Exit(1)
+	}
+}
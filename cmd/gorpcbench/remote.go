@@ -0,0 +1,100 @@
+// Copyright (c) 2025 Matheus Degiovani
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/matheusd/gorpcbench"
+	"github.com/matheusd/gorpcbench/rpcbench"
+)
+
+// runServe runs only the server side of an RPC system, until the process is
+// interrupted.
+func runServe(args []string) error {
+	fs := flag.NewFlagSet("gorpcbench serve", flag.ContinueOnError)
+	sysName := fs.String("sys", "", "RPC system to serve")
+	network := fs.String("network", "tcp", "Network to listen on (tcp or unix)")
+	listen := fs.String("listen", "127.0.0.1:0", "Address to listen on")
+	var maxMsgSize int
+	registerMaxMsgSize(fs, &maxMsgSize)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := applyMaxMsgSize(fs, maxMsgSize); err != nil {
+		return err
+	}
+
+	sys, err := findSystem(*sysName)
+	if err != nil {
+		return err
+	}
+
+	l, err := net.Listen(*network, *listen)
+	if err != nil {
+		return err
+	}
+
+	s, err := sys.Initer().NewServer(l)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	fmt.Printf("Serving %s on %s\n", sys.Name, l.Addr())
+	err = s.Run(ctx)
+	if err != nil && !errors.Is(err, context.Canceled) {
+		return err
+	}
+	return nil
+}
+
+// runLoad runs only the client side of the selected test cases of an RPC
+// system, against a server started by runServe.
+func runLoad(args []string) error {
+	fs := flag.NewFlagSet("gorpcbench load", flag.ContinueOnError)
+	var mf matrixFlags
+	sysName := fs.String("sys", "", "RPC system of the server")
+	network := fs.String("network", "tcp", "Network of the server (tcp or unix)")
+	connect := fs.String("connect", "", "Address of the server")
+	mf.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := applyMaxMsgSize(fs, mf.maxMsgSize); err != nil {
+		return err
+	}
+
+	sys, err := findSystem(*sysName)
+	if err != nil {
+		return err
+	}
+	if *connect == "" {
+		return errors.New("the address of the server must be specified with -connect")
+	}
+
+	var matrix []rpcbench.BenchCase
+	for _, bc := range gorpcbench.FullTestMatrix() {
+		// Server process, network, TLS and link cases do not make
+		// sense when the server is already running.
+		if bc.Sys.Name != sys.Name || bc.ServerProcess || bc.Network != "" ||
+			bc.TLS != rpcbench.TLSOff || bc.Link != nil || !mf.matches(bc) {
+			continue
+		}
+		bc.Network = *network
+		bc.ServerAddr = *connect
+		matrix = append(matrix, bc)
+	}
+	return runMatrix(matrix, &mf)
+}
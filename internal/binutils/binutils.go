@@ -9,6 +9,10 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io"
+	"math"
+	"slices"
+	"sync"
+	"time"
 
 	"github.com/matheusd/gorpcbench/rpcbench"
 )
@@ -28,6 +32,31 @@ func WriteInt64(w io.Writer, aux []byte, v int64) error {
 	return err
 }
 
+// WriteString writes a size-prefixed string.
+func WriteString(w io.Writer, aux []byte, s string) error {
+	if err := WriteInt64(w, aux, int64(len(s))); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, s)
+	return err
+}
+
+// ReadString reads a string written by WriteString.
+func ReadString(r io.Reader, aux []byte) (string, error) {
+	size, err := ReadInt64(r, aux)
+	if err != nil {
+		return "", err
+	}
+	if size < 0 || size > rpcbench.MaxHexEncodeSize {
+		return "", fmt.Errorf("string size %d out of bounds", size)
+	}
+	b := make([]byte, size)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
 // {read,write}Tree always reads/writes in the same order and there's no
 // multiplexing so we always expect the same sequence coming back.
 
@@ -116,3 +145,443 @@ func DoMultTreeRequest(r io.Reader, w io.Writer, aux []byte) error {
 func ReadMultTreeReponse(r io.Reader, aux []byte, tn *rpcbench.TreeNodeImpl) error {
 	return ReadTree(r, aux, tn)
 }
+
+// WriteAppError writes an application error, as its code followed by its
+// size-prefixed message.
+func WriteAppError(w io.Writer, aux []byte, appErr *rpcbench.AppError) error {
+	if err := WriteInt64(w, aux, int64(appErr.Code)); err != nil {
+		return err
+	}
+	return WriteString(w, aux, appErr.Message)
+}
+
+// ReadAppError reads an application error written by WriteAppError.
+func ReadAppError(r io.Reader, aux []byte) (*rpcbench.AppError, error) {
+	code, err := ReadInt64(r, aux)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := ReadString(r, aux)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcbench.AppError{Code: rpcbench.AppErrorCode(code), Message: msg}, nil
+}
+
+// WriteRecords writes a list of records, as the number of records followed by
+// the id and size-prefixed strings of each one.
+func WriteRecords(w io.Writer, aux []byte, records []rpcbench.Record) error {
+	if err := WriteInt64(w, aux, int64(len(records))); err != nil {
+		return err
+	}
+	for i := range records {
+		r := &records[i]
+		if err := WriteInt64(w, aux, r.ID); err != nil {
+			return err
+		}
+		for _, s := range []string{r.Name, r.Email, r.Bio} {
+			if err := WriteString(w, aux, s); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ReadRecords reads a list of records written by WriteRecords, reusing the
+// storage of records.
+func ReadRecords(r io.Reader, aux []byte, records []rpcbench.Record) ([]rpcbench.Record, error) {
+	n, err := ReadInt64(r, aux)
+	if err != nil {
+		return nil, err
+	}
+	if n < 0 || n > rpcbench.MaxHexEncodeSize {
+		return nil, fmt.Errorf("record count %d out of bounds", n)
+	}
+	records = slices.Grow(records[:0], int(n))[:n]
+	for i := range records {
+		rec := &records[i]
+		if rec.ID, err = ReadInt64(r, aux); err != nil {
+			return nil, err
+		}
+		for _, s := range []*string{&rec.Name, &rec.Email, &rec.Bio} {
+			if *s, err = ReadString(r, aux); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return records, nil
+}
+
+func writeInt32(w io.Writer, aux []byte, v int32) error {
+	return WriteInt64(w, aux, int64(v))
+}
+
+func readInt32(r io.Reader, aux []byte) (int32, error) {
+	v, err := ReadInt64(r, aux)
+	return int32(v), err
+}
+
+// WriteFloat64 writes a float64, as the int64 with its bits.
+func WriteFloat64(w io.Writer, aux []byte, v float64) error {
+	return WriteInt64(w, aux, int64(math.Float64bits(v)))
+}
+
+// ReadFloat64 reads a float64 written by WriteFloat64.
+func ReadFloat64(r io.Reader, aux []byte) (float64, error) {
+	v, err := ReadInt64(r, aux)
+	return math.Float64frombits(uint64(v)), err
+}
+
+// vectorBufs are the buffers vectors are encoded into (and read into before
+// being decoded), such that they are written and read in bulk.
+var vectorBufs = sync.Pool{New: func() any { return new([]byte) }}
+
+// WriteFloat64s writes a vector of float64s, as the number of elements
+// followed by each element (as written by WriteFloat64). The vector is encoded
+// into a single buffer, which is written at once.
+func WriteFloat64s(w io.Writer, v []float64) error {
+	bufp := vectorBufs.Get().(*[]byte)
+	buf := binary.LittleEndian.AppendUint64((*bufp)[:0], uint64(len(v)))
+	for _, f := range v {
+		buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(f))
+	}
+	_, err := w.Write(buf)
+	*bufp = buf
+	vectorBufs.Put(bufp)
+	return err
+}
+
+// ReadFloat64s reads a vector written by WriteFloat64s, reusing the storage of
+// v. The elements are read at once, and then decoded.
+func ReadFloat64s(r io.Reader, aux []byte, v []float64) ([]float64, error) {
+	n, err := ReadInt64(r, aux)
+	if err != nil {
+		return nil, err
+	}
+	if n < 0 || n > rpcbench.MaxHexEncodeSize {
+		return nil, fmt.Errorf("vector size %d out of bounds", n)
+	}
+
+	bufp := vectorBufs.Get().(*[]byte)
+	defer vectorBufs.Put(bufp)
+	buf := slices.Grow((*bufp)[:0], int(n)*8)[:n*8]
+	*bufp = buf
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return nil, err
+	}
+	v = slices.Grow(v[:0], int(n))[:n]
+	for i := range v {
+		v[i] = math.Float64frombits(binary.LittleEndian.Uint64(buf[i*8:]))
+	}
+	return v, nil
+}
+
+// writeBool writes a bool as 1 (or 0 when false).
+func writeBool(w io.Writer, aux []byte, v bool) error {
+	var i int64
+	if v {
+		i = 1
+	}
+	return WriteInt64(w, aux, i)
+}
+
+func readBool(r io.Reader, aux []byte) (bool, error) {
+	v, err := ReadInt64(r, aux)
+	return v != 0, err
+}
+
+// writeOptional writes the value of an optional field, when it is set.
+func writeOptional[T any](w io.Writer, aux []byte, v *T, write func(io.Writer, []byte, T) error) error {
+	if v == nil {
+		return nil
+	}
+	return write(w, aux, *v)
+}
+
+// readOptional reads the value of an optional field, when it is set in the
+// presence mask.
+func readOptional[T any](r io.Reader, aux []byte, mask int64, bit int, read func(io.Reader, []byte) (T, error)) (*T, error) {
+	if mask&(1<<bit) == 0 {
+		return nil, nil
+	}
+	v, err := read(r, aux)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// rowPresence returns the presence mask of the optional fields of a row, with
+// one bit per field (set when the field is set), in the order they are written
+// by WriteRow.
+func rowPresence(row *rpcbench.Row) int64 {
+	var mask int64
+	for i, set := range [...]bool{
+		row.DeletedAt != nil, row.ParentID != nil, row.CreditLimit != nil,
+		row.Priority != nil, row.Retries != nil, row.Discount != nil,
+		row.Archived != nil, row.TwoFactor != nil, row.ExternalID != nil,
+		row.Phone != nil, row.Company != nil, row.Title != nil,
+		row.Locale != nil, row.Timezone != nil, row.Referrer != nil,
+		row.Notes != nil,
+	} {
+		if set {
+			mask |= 1 << i
+		}
+	}
+	return mask
+}
+
+// WriteRow writes a row, as its required fields followed by the presence mask
+// of its optional fields and the values of the ones that are set. Bools and
+// every number are written as int64s.
+func WriteRow(w io.Writer, aux []byte, row *rpcbench.Row) error {
+	for _, v := range [...]int64{row.ID, row.AccountID, row.CreatedAt, row.UpdatedAt,
+		row.Balance, int64(row.Version), int64(row.Status)} {
+		if err := WriteInt64(w, aux, v); err != nil {
+			return err
+		}
+	}
+	if err := WriteFloat64(w, aux, row.Score); err != nil {
+		return err
+	}
+	for _, v := range [...]bool{row.Active, row.Verified} {
+		if err := writeBool(w, aux, v); err != nil {
+			return err
+		}
+	}
+	for _, s := range [...]string{row.Name, row.Email, row.Country, row.Currency,
+		row.Plan, row.Region} {
+		if err := WriteString(w, aux, s); err != nil {
+			return err
+		}
+	}
+
+	if err := WriteInt64(w, aux, rowPresence(row)); err != nil {
+		return err
+	}
+	for _, v := range [...]*int64{row.DeletedAt, row.ParentID, row.CreditLimit} {
+		if err := writeOptional(w, aux, v, WriteInt64); err != nil {
+			return err
+		}
+	}
+	for _, v := range [...]*int32{row.Priority, row.Retries} {
+		if err := writeOptional(w, aux, v, writeInt32); err != nil {
+			return err
+		}
+	}
+	if err := writeOptional(w, aux, row.Discount, WriteFloat64); err != nil {
+		return err
+	}
+	for _, v := range [...]*bool{row.Archived, row.TwoFactor} {
+		if err := writeOptional(w, aux, v, writeBool); err != nil {
+			return err
+		}
+	}
+	for _, v := range [...]*string{row.ExternalID, row.Phone, row.Company, row.Title,
+		row.Locale, row.Timezone, row.Referrer, row.Notes} {
+		if err := writeOptional(w, aux, v, WriteString); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadRow reads a row written by WriteRow.
+func ReadRow(r io.Reader, aux []byte, row *rpcbench.Row) error {
+	var err error
+	var version, status int64
+	for _, v := range [...]*int64{&row.ID, &row.AccountID, &row.CreatedAt, &row.UpdatedAt,
+		&row.Balance, &version, &status} {
+		if *v, err = ReadInt64(r, aux); err != nil {
+			return err
+		}
+	}
+	row.Version, row.Status = int32(version), int32(status)
+	if row.Score, err = ReadFloat64(r, aux); err != nil {
+		return err
+	}
+	for _, v := range [...]*bool{&row.Active, &row.Verified} {
+		if *v, err = readBool(r, aux); err != nil {
+			return err
+		}
+	}
+	for _, s := range [...]*string{&row.Name, &row.Email, &row.Country, &row.Currency,
+		&row.Plan, &row.Region} {
+		if *s, err = ReadString(r, aux); err != nil {
+			return err
+		}
+	}
+
+	mask, err := ReadInt64(r, aux)
+	if err != nil {
+		return err
+	}
+	var bit int
+	for _, v := range [...]**int64{&row.DeletedAt, &row.ParentID, &row.CreditLimit} {
+		if *v, err = readOptional(r, aux, mask, bit, ReadInt64); err != nil {
+			return err
+		}
+		bit++
+	}
+	for _, v := range [...]**int32{&row.Priority, &row.Retries} {
+		if *v, err = readOptional(r, aux, mask, bit, readInt32); err != nil {
+			return err
+		}
+		bit++
+	}
+	if row.Discount, err = readOptional(r, aux, mask, bit, ReadFloat64); err != nil {
+		return err
+	}
+	bit++
+	for _, v := range [...]**bool{&row.Archived, &row.TwoFactor} {
+		if *v, err = readOptional(r, aux, mask, bit, readBool); err != nil {
+			return err
+		}
+		bit++
+	}
+	for _, v := range [...]**string{&row.ExternalID, &row.Phone, &row.Company, &row.Title,
+		&row.Locale, &row.Timezone, &row.Referrer, &row.Notes} {
+		if *v, err = readOptional(r, aux, mask, bit, ReadString); err != nil {
+			return err
+		}
+		bit++
+	}
+	return nil
+}
+
+// WriteRows writes a list of rows, as the number of rows followed by each row.
+func WriteRows(w io.Writer, aux []byte, rows []rpcbench.Row) error {
+	if err := WriteInt64(w, aux, int64(len(rows))); err != nil {
+		return err
+	}
+	for i := range rows {
+		if err := WriteRow(w, aux, &rows[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadRows reads a list of rows written by WriteRows, reusing the storage of
+// rows.
+func ReadRows(r io.Reader, aux []byte, rows []rpcbench.Row) ([]rpcbench.Row, error) {
+	n, err := ReadInt64(r, aux)
+	if err != nil {
+		return nil, err
+	}
+	if n < 0 || n > rpcbench.MaxHexEncodeSize {
+		return nil, fmt.Errorf("row count %d out of bounds", n)
+	}
+	rows = slices.Grow(rows[:0], int(n))[:n]
+	for i := range rows {
+		if err := ReadRow(r, aux, &rows[i]); err != nil {
+			return nil, err
+		}
+	}
+	return rows, nil
+}
+
+// WriteMap writes a map, as the number of entries followed by the key and
+// value of each entry.
+func WriteMap(w io.Writer, aux []byte, m map[string]int64) error {
+	if err := WriteInt64(w, aux, int64(len(m))); err != nil {
+		return err
+	}
+	for k, v := range m {
+		if err := WriteString(w, aux, k); err != nil {
+			return err
+		}
+		if err := WriteInt64(w, aux, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadMap reads a map written by WriteMap into m, which is cleared first.
+func ReadMap(r io.Reader, aux []byte, m map[string]int64) error {
+	n, err := ReadInt64(r, aux)
+	if err != nil {
+		return err
+	}
+	if n < 0 || n > rpcbench.MaxHexEncodeSize {
+		return fmt.Errorf("map size %d out of bounds", n)
+	}
+	clear(m)
+	for range n {
+		k, err := ReadString(r, aux)
+		if err != nil {
+			return err
+		}
+		if m[k], err = ReadInt64(r, aux); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteMetadata writes the metadata of a call, as the number of entries
+// followed by the key and value of each entry.
+func WriteMetadata(w io.Writer, aux []byte, md []rpcbench.MetadataEntry) error {
+	if err := WriteInt64(w, aux, int64(len(md))); err != nil {
+		return err
+	}
+	for _, e := range md {
+		if err := WriteString(w, aux, e.Key); err != nil {
+			return err
+		}
+		if err := WriteString(w, aux, e.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadMetadata reads metadata written by WriteMetadata, reusing the storage of
+// md.
+func ReadMetadata(r io.Reader, aux []byte, md []rpcbench.MetadataEntry) ([]rpcbench.MetadataEntry, error) {
+	n, err := ReadInt64(r, aux)
+	if err != nil {
+		return nil, err
+	}
+	if n < 0 || n > rpcbench.MaxHexEncodeSize {
+		return nil, fmt.Errorf("metadata size %d out of bounds", n)
+	}
+	md = slices.Grow(md[:0], int(n))[:n]
+	for i := range md {
+		if md[i].Key, err = ReadString(r, aux); err != nil {
+			return nil, err
+		}
+		if md[i].Value, err = ReadString(r, aux); err != nil {
+			return nil, err
+		}
+	}
+	return md, nil
+}
+
+// WriteWork writes the work of a Work call, as its duration followed by 1 when
+// it is done on the CPU (or 0 otherwise).
+func WriteWork(w io.Writer, aux []byte, work rpcbench.Work) error {
+	if err := WriteInt64(w, aux, int64(work.Duration)); err != nil {
+		return err
+	}
+	var cpu int64
+	if work.CPU {
+		cpu = 1
+	}
+	return WriteInt64(w, aux, cpu)
+}
+
+// ReadWork reads the work of a Work call written by WriteWork.
+func ReadWork(r io.Reader, aux []byte) (rpcbench.Work, error) {
+	d, err := ReadInt64(r, aux)
+	if err != nil {
+		return rpcbench.Work{}, err
+	}
+	cpu, err := ReadInt64(r, aux)
+	if err != nil {
+		return rpcbench.Work{}, err
+	}
+	return rpcbench.Work{Duration: time.Duration(d), CPU: cpu != 0}, nil
+}
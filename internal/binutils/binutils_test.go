@@ -0,0 +1,309 @@
+// Copyright (c) 2025 Matheus Degiovani
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package binutils
+
+import (
+	"bytes"
+	"fmt"
+	"maps"
+	"math"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/matheusd/gorpcbench/rpcbench"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// testRows returns rows with different sets of optional fields set.
+func testRows() map[string]rpcbench.Row {
+	required := rpcbench.Row{
+		ID: 1, AccountID: -2, CreatedAt: 1 << 60, UpdatedAt: 1<<60 + 5,
+		Balance: -1 << 63, Version: 1<<31 - 1, Status: -1, Score: -0.5,
+		Active: true, Name: "Zoë", Email: "zoe@example.com", Country: "BR",
+		Plan: "pro", Region: "sa-east-1",
+	}
+	allSet := required
+	allSet.DeletedAt, allSet.ParentID, allSet.CreditLimit = ptr(int64(3)), ptr(int64(-4)), ptr(int64(5))
+	allSet.Priority, allSet.Retries = ptr(int32(-6)), ptr(int32(7))
+	allSet.Discount = ptr(0.25)
+	allSet.Archived, allSet.TwoFactor = ptr(true), ptr(false)
+	allSet.ExternalID, allSet.Phone, allSet.Company = ptr("ext"), ptr("+55 11"), ptr("ACME")
+	allSet.Title, allSet.Locale, allSet.Timezone = ptr("CEO"), ptr("pt-BR"), ptr("America/Sao_Paulo")
+	allSet.Referrer, allSet.Notes = ptr("ads"), ptr("multi-byte: ação, 日本")
+
+	// Fields set to their zero value are not the same as unset fields.
+	zeroSet := rpcbench.Row{
+		DeletedAt: ptr(int64(0)), Priority: ptr(int32(0)), Discount: ptr(0.0),
+		Archived: ptr(false), ExternalID: ptr(""), Notes: ptr(""),
+	}
+
+	sparse := required
+	sparse.ParentID, sparse.TwoFactor, sparse.Timezone = ptr(int64(8)), ptr(true), ptr("UTC")
+
+	return map[string]rpcbench.Row{
+		"empty":       {},
+		"required":    required,
+		"all set":     allSet,
+		"set to zero": zeroSet,
+		"sparse":      sparse,
+	}
+}
+
+// TestRowRoundTrip tests that rows are read as they were written, including
+// whether each optional field is set.
+func TestRowRoundTrip(t *testing.T) {
+	aux := make([]byte, 8)
+	for name, row := range testRows() {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := WriteRow(&buf, aux, &row); err != nil {
+				t.Fatal(err)
+			}
+
+			// Read over a row with every field set, to check that
+			// unset fields are cleared.
+			got := testRows()["all set"]
+			if err := ReadRow(&buf, aux, &got); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, row) {
+				t.Fatalf("unexpected row:\ngot  %+v\nwant %+v", got, row)
+			}
+			if buf.Len() != 0 {
+				t.Fatalf("%d bytes left unread", buf.Len())
+			}
+		})
+	}
+}
+
+// TestRowPresence tests the presence mask written before the optional fields
+// of rows.
+func TestRowPresence(t *testing.T) {
+	rows := testRows()
+	tests := []struct {
+		name string
+		want int64
+	}{
+		{name: "empty", want: 0},
+		{name: "required", want: 0},
+		{name: "all set", want: 1<<16 - 1},
+		{name: "set to zero", want: 1<<0 | 1<<3 | 1<<5 | 1<<6 | 1<<8 | 1<<15},
+		{name: "sparse", want: 1<<1 | 1<<7 | 1<<13},
+	}
+	for _, tc := range tests {
+		row := rows[tc.name]
+		if got := rowPresence(&row); got != tc.want {
+			t.Errorf("%s: unexpected presence mask: got %016b, want %016b", tc.name, got, tc.want)
+		}
+	}
+}
+
+// TestRowsRoundTrip tests that lists of rows are read as they were written,
+// reusing the storage of the rows they are read into.
+func TestRowsRoundTrip(t *testing.T) {
+	aux := make([]byte, 8)
+	var rows []rpcbench.Row
+	for _, row := range testRows() {
+		rows = append(rows, row)
+	}
+
+	for _, n := range []int{0, 1, len(rows)} {
+		var buf bytes.Buffer
+		if err := WriteRows(&buf, aux, rows[:n]); err != nil {
+			t.Fatal(err)
+		}
+		dst := make([]rpcbench.Row, 2, len(rows))
+		dst[0] = testRows()["all set"]
+		got, err := ReadRows(&buf, aux, dst)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != n || !reflect.DeepEqual(got, rows[:n]) {
+			t.Fatalf("%d rows: unexpected rows %+v", n, got)
+		}
+		if n > 0 && &got[0] != &dst[0] {
+			t.Fatalf("%d rows: storage was not reused", n)
+		}
+	}
+}
+
+// TestMapRoundTrip tests that maps are read as they were written, replacing
+// the entries of the map they are read into.
+func TestMapRoundTrip(t *testing.T) {
+	big := make(map[string]int64)
+	for i := range 1024 {
+		big[fmt.Sprintf("key-%04d", i)] = int64(i) - 512
+	}
+
+	tests := []struct {
+		name string
+		m    map[string]int64
+	}{
+		{name: "empty", m: map[string]int64{}},
+		{name: "empty key", m: map[string]int64{"": 1}},
+		{name: "extremes", m: map[string]int64{"min": math.MinInt64, "max": math.MaxInt64, "zero": 0}},
+		{name: "multi-byte keys", m: map[string]int64{"ação": 1, "日本": 2, "😀": 3}},
+		{name: "1024 entries", m: big},
+	}
+
+	aux := make([]byte, 8)
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := WriteMap(&buf, aux, tc.m); err != nil {
+				t.Fatal(err)
+			}
+			got := map[string]int64{"stale": 1, "min": 2}
+			if err := ReadMap(&buf, aux, got); err != nil {
+				t.Fatal(err)
+			}
+			if !maps.Equal(got, tc.m) {
+				t.Fatalf("unexpected map: got %v, want %v", got, tc.m)
+			}
+			if buf.Len() != 0 {
+				t.Fatalf("%d bytes left unread", buf.Len())
+			}
+		})
+	}
+}
+
+// TestReadMapBounds tests that maps with an invalid number of entries are not
+// read.
+func TestReadMapBounds(t *testing.T) {
+	aux := make([]byte, 8)
+	for _, n := range []int64{-1, rpcbench.MaxHexEncodeSize + 1} {
+		var buf bytes.Buffer
+		if err := WriteInt64(&buf, aux, n); err != nil {
+			t.Fatal(err)
+		}
+		if err := ReadMap(&buf, aux, map[string]int64{}); err == nil {
+			t.Fatalf("map with %d entries was read", n)
+		}
+	}
+}
+
+// TestFloat64sRoundTrip tests that vectors are read with the same bits they
+// were written with, including NaN, ±Inf and -0 values.
+func TestFloat64sRoundTrip(t *testing.T) {
+	long := make([]float64, 4096)
+	for i := range long {
+		long[i] = float64(i) * -1.5
+	}
+
+	tests := []struct {
+		name string
+		v    []float64
+	}{
+		{name: "empty", v: []float64{}},
+		{name: "single", v: []float64{math.Pi}},
+		{name: "special", v: []float64{math.NaN(), math.Inf(1), math.Inf(-1), math.Copysign(0, -1), 0}},
+		{name: "extremes", v: []float64{math.MaxFloat64, -math.MaxFloat64, math.SmallestNonzeroFloat64}},
+		{name: "nan payload", v: []float64{math.Float64frombits(0x7ff8_0000_dead_beef)}},
+		{name: "4096 elements", v: long},
+	}
+
+	aux := make([]byte, 8)
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := WriteFloat64s(&buf, tc.v); err != nil {
+				t.Fatal(err)
+			}
+
+			// The vector is written as its length followed by
+			// each element, as written by WriteFloat64.
+			var want bytes.Buffer
+			WriteInt64(&want, aux, int64(len(tc.v)))
+			for _, f := range tc.v {
+				WriteFloat64(&want, aux, f)
+			}
+			if !bytes.Equal(buf.Bytes(), want.Bytes()) {
+				t.Fatal("unexpected encoding of the vector")
+			}
+
+			dst := make([]float64, 2, max(2, len(tc.v)))
+			got, err := ReadFloat64s(&buf, aux, dst)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != len(tc.v) {
+				t.Fatalf("unexpected length: got %d, want %d", len(got), len(tc.v))
+			}
+			for i := range got {
+				if math.Float64bits(got[i]) != math.Float64bits(tc.v[i]) {
+					t.Fatalf("element %d: got %v, want %v", i, got[i], tc.v[i])
+				}
+			}
+			if len(got) > 0 && &got[0] != &dst[0] {
+				t.Fatal("storage was not reused")
+			}
+		})
+	}
+}
+
+// TestReadFloat64sTruncated tests that truncated vectors fail to be read.
+func TestReadFloat64sTruncated(t *testing.T) {
+	aux := make([]byte, 8)
+	var buf bytes.Buffer
+	if err := WriteFloat64s(&buf, []float64{1, 2, 3}); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+	for _, n := range []int{0, 4, 8, 12, len(data) - 1} {
+		if _, err := ReadFloat64s(bytes.NewReader(data[:n]), aux, nil); err == nil {
+			t.Fatalf("vector truncated to %d bytes was read", n)
+		}
+	}
+}
+
+// TestMetadataRoundTrip tests that metadata is read as it was written, in
+// order and reusing the storage it is read into.
+func TestMetadataRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		md   []rpcbench.MetadataEntry
+	}{
+		{name: "empty", md: []rpcbench.MetadataEntry{}},
+		{name: "empty value", md: []rpcbench.MetadataEntry{{Key: "x-locale"}}},
+		{name: "repeated keys", md: []rpcbench.MetadataEntry{
+			{Key: "a", Value: "1"}, {Key: "a", Value: "2"},
+		}},
+		{name: "call metadata", md: []rpcbench.MetadataEntry{
+			{Key: rpcbench.MetadataKeyAuth, Value: "Bearer 0123"},
+			{Key: rpcbench.MetadataKeyTenant, Value: "tenant-1"},
+			{Key: rpcbench.MetadataKeyTraceID, Value: "abcd"},
+			{Key: "x-note", Value: "ação 日本"},
+		}},
+	}
+
+	aux := make([]byte, 8)
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := WriteMetadata(&buf, aux, tc.md); err != nil {
+				t.Fatal(err)
+			}
+			dst := make([]rpcbench.MetadataEntry, 1, 8)
+			dst[0] = rpcbench.MetadataEntry{Key: "stale", Value: "stale"}
+			got, err := ReadMetadata(&buf, aux, dst)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(got, tc.md) {
+				t.Fatalf("unexpected metadata: got %v, want %v", got, tc.md)
+			}
+			if len(got) > 0 && &got[0] != &dst[0] {
+				t.Fatal("storage was not reused")
+			}
+			if buf.Len() != 0 {
+				t.Fatalf("%d bytes left unread", buf.Len())
+			}
+		})
+	}
+}
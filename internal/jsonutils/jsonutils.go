@@ -6,6 +6,7 @@ package jsonutils
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/matheusd/gorpcbench/rpcbench"
 )
@@ -17,16 +18,32 @@ const (
 	CmdAdd
 	CmdMultTree
 	CmdToHex
+	CmdServerStream
+	CmdClientStream
+	CmdBidiStream
+	CmdCallback
+	CmdValidate
+	CmdSlow
+	CmdSlowCalls
+	CmdWork
+	CmdLarge
+	CmdRecords
+	CmdRows
+	CmdMap
+	CmdMetadata
+	CmdVector
 )
 
 type Message struct {
-	Command Command         `json:"command"`
-	Payload json.RawMessage `json:"payload,omitempty"`
+	Command  Command                  `json:"command"`
+	Metadata []rpcbench.MetadataEntry `json:"metadata,omitempty"`
+	Payload  json.RawMessage          `json:"payload,omitempty"`
 }
 
 type OutMessage struct {
-	Command Command `json:"command"`
-	Payload any     `json:"payload,omitempty"`
+	Command  Command                  `json:"command"`
+	Metadata []rpcbench.MetadataEntry `json:"metadata,omitempty"`
+	Payload  any                      `json:"payload,omitempty"`
 }
 
 type AddRequest struct {
@@ -42,3 +59,123 @@ type MultTreeRequest struct {
 	Mult int64                  `json:"mult"`
 	Tree *rpcbench.TreeNodeImpl `json:"tree"`
 }
+
+type ServerStreamRequest struct {
+	Start int64 `json:"start"`
+	Count int   `json:"count"`
+}
+
+type CallbackRequest struct {
+	Start int64 `json:"start"`
+	Count int   `json:"count"`
+}
+
+// CallbackMessage is either a callback made by the server, the reply of the
+// client to it or, when Return is set, the result of the callback call.
+type CallbackMessage struct {
+	Value  int64 `json:"value"`
+	Return bool  `json:"return,omitempty"`
+}
+
+type ValidateRequest struct {
+	Value int64                 `json:"value"`
+	Code  rpcbench.AppErrorCode `json:"code,omitempty"`
+}
+
+// ValidateResponse is the response to a validate request, which carries either
+// the value or the error the server failed the call with.
+type ValidateResponse struct {
+	Value int64     `json:"value"`
+	Error *AppError `json:"error,omitempty"`
+}
+
+// SlowRequest is a request for a slow call. Timeout is the time remaining until
+// the deadline of the call, if it has one.
+type SlowRequest struct {
+	Delay   time.Duration `json:"delay"`
+	Timeout time.Duration `json:"timeout,omitempty"`
+}
+
+type SlowResponse struct {
+	Canceled bool `json:"canceled,omitempty"`
+}
+
+type SlowCallsResponse struct {
+	Started  int64 `json:"started"`
+	Canceled int64 `json:"canceled"`
+}
+
+// WorkRequest is a request for a Work call. The server replies to it with the
+// same message it received once the work is done.
+type WorkRequest struct {
+	Duration time.Duration `json:"duration"`
+	CPU      bool          `json:"cpu"`
+}
+
+// LargeMessage is the request and reply of a large call.
+type LargeMessage struct {
+	Data []byte `json:"data"`
+}
+
+// RecordsMessage is the request and reply of a records call.
+type RecordsMessage struct {
+	Records []rpcbench.Record `json:"records"`
+}
+
+// RowsMessage is the request and reply of a rows call.
+type RowsMessage struct {
+	Rows []rpcbench.Row `json:"rows"`
+}
+
+// MapMessage is the request and reply of a map call.
+type MapMessage struct {
+	Values map[string]int64 `json:"values"`
+}
+
+// VectorMessage is the request and reply of a vector call. The scale is only
+// set in requests.
+type VectorMessage struct {
+	Values []float64 `json:"values"`
+	Scale  float64   `json:"scale,omitempty"`
+}
+
+// MetadataResponse is the response to a metadata call (whose metadata is sent
+// in the message), which carries either the echoed value or the error the
+// server failed the call with.
+type MetadataResponse struct {
+	Echo  string    `json:"echo"`
+	Error *AppError `json:"error,omitempty"`
+}
+
+type AppError struct {
+	Code    rpcbench.AppErrorCode `json:"code"`
+	Message string                `json:"message"`
+}
+
+func NewAppError(appErr *rpcbench.AppError) *AppError {
+	return &AppError{Code: appErr.Code, Message: appErr.Message}
+}
+
+func (e *AppError) AppError() *rpcbench.AppError {
+	return &rpcbench.AppError{Code: e.Code, Message: e.Message}
+}
+
+type StreamItem struct {
+	Value int64 `json:"value"`
+}
+
+// StreamChunk is one chunk of a client stream. An empty chunk ends the stream.
+type StreamChunk struct {
+	Data []byte `json:"data"`
+}
+
+type ClientStreamResponse struct {
+	Checksum uint32 `json:"checksum"`
+}
+
+// BidiMessage is one message of a bidi stream. A message with End set ends the
+// stream.
+type BidiMessage struct {
+	Value int64 `json:"value"`
+	End   bool  `json:"end,omitempty"`
+}
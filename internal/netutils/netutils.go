@@ -0,0 +1,109 @@
+// Copyright (c) 2025 Matheus Degiovani
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package netutils contains helpers for the RPC systems that are implemented
+// directly on top of connections.
+package netutils
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"syscall"
+	"time"
+)
+
+// IsConnClosedErr returns true if err was caused by the remote end of a conn
+// having closed it.
+func IsConnClosedErr(err error) bool {
+	return errors.Is(err, net.ErrClosed) || errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, syscall.ECONNRESET)
+}
+
+// CloseBroken closes a conn left in an unknown state, such as one whose I/O was
+// interrupted. The underlying conn of TLS conns is closed directly, given a close
+// notification written after a partially written record would be seen as a
+// corrupt record by the remote end.
+func CloseBroken(c net.Conn) error {
+	if tc, ok := c.(*tls.Conn); ok {
+		return tc.NetConn().Close()
+	}
+	return c.Close()
+}
+
+// Deadliner is a connection that supports I/O deadlines, like net.Conn and
+// websocket conns.
+type Deadliner interface {
+	SetReadDeadline(time.Time) error
+	SetWriteDeadline(time.Time) error
+}
+
+// aLongTimeAgo is a deadline that makes any blocked I/O return immediately.
+var aLongTimeAgo = time.Unix(1, 0)
+
+func setDeadline(c Deadliner, t time.Time) {
+	c.SetReadDeadline(t)
+	c.SetWriteDeadline(t)
+}
+
+// CallWatch makes the I/O of a call on a connection honor the deadline and the
+// cancellation of the call's context.
+//
+// Calls are interrupted by setting a deadline on the connection, so a call
+// may be interrupted half-way through its request or reply. Therefore, the
+// connection cannot be used for other calls after the call is interrupted.
+//
+// Contexts that can never be canceled (and have no deadline) are not watched,
+// so calls made with them do not pay for the watch.
+type CallWatch struct {
+	c        Deadliner
+	ctx      context.Context
+	deadline time.Time
+	stop     func() bool
+}
+
+// Start starts watching the context of a call made on c.
+func (w *CallWatch) Start(ctx context.Context, c Deadliner) {
+	*w = CallWatch{c: c, ctx: ctx}
+	if ctx.Done() == nil {
+		// Context can never be canceled, nor has a deadline.
+		return
+	}
+	if d, ok := ctx.Deadline(); ok {
+		w.deadline = d
+		setDeadline(c, d)
+	}
+	w.stop = context.AfterFunc(ctx, func() { setDeadline(c, aLongTimeAgo) })
+}
+
+// End stops watching the context of the call, given the error returned by its
+// I/O. It returns whether the connection may be used for other calls, and the
+// error of the call, which is the error of the context if the call was
+// interrupted by it.
+func (w *CallWatch) End(err error) (bool, error) {
+	interrupted := w.stop != nil && !w.stop()
+	if err == nil && !interrupted {
+		if !w.deadline.IsZero() {
+			setDeadline(w.c, time.Time{})
+		}
+		return true, nil
+	}
+	if err == nil {
+		// The call completed, but the conn may have been left with an
+		// expired deadline.
+		return false, nil
+	}
+
+	if ctxErr := w.ctx.Err(); ctxErr != nil {
+		return false, ctxErr
+	}
+	if !w.deadline.IsZero() && !time.Now().Before(w.deadline) {
+		// The conn deadline expired right before the context. The
+		// error may have been wrapped without its chain, so it is not
+		// checked.
+		return false, context.DeadlineExceeded
+	}
+	return false, err
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2025 Matheus Degiovani
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package netutils
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testDeadliner records the deadlines set on it.
+type testDeadliner struct {
+	mu     sync.Mutex
+	rd, wd time.Time
+}
+
+func (d *testDeadliner) SetReadDeadline(t time.Time) error {
+	d.mu.Lock()
+	d.rd = t
+	d.mu.Unlock()
+	return nil
+}
+
+func (d *testDeadliner) SetWriteDeadline(t time.Time) error {
+	d.mu.Lock()
+	d.wd = t
+	d.mu.Unlock()
+	return nil
+}
+
+func (d *testDeadliner) deadlines() (rd, wd time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.rd, d.wd
+}
+
+// expiredCtx is a context whose deadline expired, but that is not done yet.
+type expiredCtx struct {
+	context.Context
+	deadline time.Time
+}
+
+func (c expiredCtx) Deadline() (time.Time, bool) { return c.deadline, true }
+
+// TestCallWatch tests whether connections are reused after calls, and the
+// errors returned for the calls.
+func TestCallWatch(t *testing.T) {
+	errIO := errors.New("i/o error")
+	past := time.Now().Add(-time.Second)
+	future := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name string
+
+		// The context of the call can be canceled, and may have a
+		// deadline. With connExpired, its deadline is seen as expired
+		// while it is not done yet (as happens right before its timer
+		// fires).
+		cancelable  bool
+		deadline    time.Time
+		connExpired bool
+
+		// The call is canceled (which does not change the error of an
+		// expired context) before it ends with err.
+		cancel bool
+		err    error
+
+		wantReuse    bool
+		wantErr      error
+		wantDeadline time.Time
+	}{{
+		name:      "no deadline",
+		wantReuse: true,
+	}, {
+		name:    "no deadline with i/o error",
+		err:     errIO,
+		wantErr: errIO,
+	}, {
+		name:      "deadline is reset",
+		deadline:  future,
+		wantReuse: true,
+	}, {
+		name:       "cancelable without deadline",
+		cancelable: true,
+		wantReuse:  true,
+	}, {
+		name:         "canceled during the call",
+		cancelable:   true,
+		cancel:       true,
+		err:          io.ErrUnexpectedEOF,
+		wantErr:      context.Canceled,
+		wantDeadline: aLongTimeAgo,
+	}, {
+		name:         "canceled after the call completed",
+		cancelable:   true,
+		cancel:       true,
+		wantDeadline: aLongTimeAgo,
+	}, {
+		name:         "i/o error before cancellation",
+		deadline:     future,
+		err:          errIO,
+		wantErr:      errIO,
+		wantDeadline: future,
+	}, {
+		name:         "conn deadline expired before context",
+		cancelable:   true,
+		connExpired:  true,
+		err:          errIO,
+		wantErr:      context.DeadlineExceeded,
+		wantDeadline: past,
+	}, {
+		name:         "expired context",
+		deadline:     past,
+		cancel:       true,
+		err:          errIO,
+		wantErr:      context.DeadlineExceeded,
+		wantDeadline: aLongTimeAgo,
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.Background(), func() {}
+			switch {
+			case !tc.deadline.IsZero():
+				ctx, cancel = context.WithDeadline(ctx, tc.deadline)
+			case tc.cancelable:
+				ctx, cancel = context.WithCancel(ctx)
+			}
+			defer cancel()
+			if tc.connExpired {
+				ctx = expiredCtx{Context: ctx, deadline: past}
+			}
+
+			var c testDeadliner
+			var w CallWatch
+			w.Start(ctx, &c)
+			if tc.cancel {
+				cancel()
+				// Wait for the interruption of the conn.
+				for start := time.Now(); time.Since(start) < 5*time.Second; {
+					if rd, _ := c.deadlines(); rd.Equal(aLongTimeAgo) {
+						break
+					}
+					time.Sleep(time.Millisecond)
+				}
+			}
+
+			reuse, err := w.End(tc.err)
+			if reuse != tc.wantReuse {
+				t.Fatalf("unexpected reuse: got %v, want %v", reuse, tc.wantReuse)
+			}
+			if !errors.Is(err, tc.wantErr) || (err == nil) != (tc.wantErr == nil) {
+				t.Fatalf("unexpected error: got %v, want %v", err, tc.wantErr)
+			}
+			rd, wd := c.deadlines()
+			if !rd.Equal(tc.wantDeadline) || !wd.Equal(tc.wantDeadline) {
+				t.Fatalf("unexpected conn deadlines: got %v and %v, want %v",
+					rd, wd, tc.wantDeadline)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2025 Matheus Degiovani
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package gocapnp
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"capnproto.org/go/capnp/v3/exc"
+	"github.com/matheusd/gorpcbench/rpcbench"
+)
+
+// appErrorPrefix starts the reason of exceptions that carry app errors, which
+// is followed by the code and message of the error, as in
+// "apperr 5: value 10 rejected". CapNProto exceptions only have a few types
+// (unrelated to the application), so the code is encoded in the reason.
+const appErrorPrefix = "apperr "
+
+// appErrorException returns the exception servers fail calls with, when they
+// fail with an app error.
+func appErrorException(appErr *rpcbench.AppError) error {
+	reason := fmt.Sprintf("%s%d: %s", appErrorPrefix, appErr.Code, appErr.Message)
+	return exc.New(exc.Failed, "", reason)
+}
+
+// exceptionAppError returns the app error carried by an exception returned to
+// the client, or err itself if it does not carry one.
+func exceptionAppError(err error) error {
+	var e *exc.Exception
+	if !errors.As(err, &e) {
+		return err
+	}
+
+	// Exceptions may be annotated (prefixed) along the way.
+	reason := e.Error()
+	i := strings.Index(reason, appErrorPrefix)
+	if i < 0 {
+		return err
+	}
+	codeStr, msg, ok := strings.Cut(reason[i+len(appErrorPrefix):], ": ")
+	if !ok {
+		return err
+	}
+	code, convErr := strconv.ParseUint(codeStr, 10, 32)
+	if convErr != nil {
+		return err
+	}
+	return &rpcbench.AppError{Code: rpcbench.AppErrorCode(code), Message: msg}
+}
@@ -6,14 +6,21 @@ package gocapnp
 
 import (
 	context "context"
-	"net"
+	"fmt"
+	"sync"
+	"time"
 
+	capnp "capnproto.org/go/capnp/v3"
 	"capnproto.org/go/capnp/v3/rpc"
 	"github.com/matheusd/gorpcbench/rpcbench"
 )
 
 type gocapnpClient struct {
 	api API
+
+	// closeConn releases the bootstrap cap and closes the conn. It is
+	// called once ctx is done, unless the client is closed before that.
+	closeConn func()
 }
 
 func (c *gocapnpClient) Nop(ctx context.Context) error {
@@ -96,16 +103,402 @@ func (c *gocapnpClient) ToHex(ctx context.Context, in []byte, out []byte) error
 	return nil
 }
 
-func newGoCapnpClient(ctx context.Context, addr string) (*gocapnpClient, error) {
+func (c *gocapnpClient) Echo(ctx context.Context, in, out []byte) error {
+	echoFuture, release := c.api.Echo(ctx, func(args API_echo_Params) error {
+		return args.SetData(in)
+	})
+	defer release()
+
+	res, err := echoFuture.Struct()
+	if err != nil {
+		return err
+	}
+	data, err := res.Data()
+	if err != nil {
+		return err
+	}
+	if len(data) != len(out) {
+		return fmt.Errorf("unexpected reply size %d", len(data))
+	}
+	copy(out, data)
+	return nil
+}
+
+func (c *gocapnpClient) UpperRecords(ctx context.Context, in, out []rpcbench.Record) error {
+	recordsFuture, release := c.api.UpperRecords(ctx, func(args API_upperRecords_Params) error {
+		records, err := args.NewRecords(int32(len(in)))
+		if err != nil {
+			return err
+		}
+		for i := range in {
+			if err := recordToCapnp(&in[i], records.At(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	defer release()
+
+	res, err := recordsFuture.Struct()
+	if err != nil {
+		return err
+	}
+	records, err := res.Records()
+	if err != nil {
+		return err
+	}
+	if records.Len() != len(out) {
+		return fmt.Errorf("unexpected number of records %d", records.Len())
+	}
+	for i := range out {
+		if err := capnpToRecord(records.At(i), &out[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *gocapnpClient) UpdateRows(ctx context.Context, in, out []rpcbench.Row) error {
+	rowsFuture, release := c.api.UpdateRows(ctx, func(args API_updateRows_Params) error {
+		rows, err := args.NewRows(int32(len(in)))
+		if err != nil {
+			return err
+		}
+		for i := range in {
+			if err := rowToCapnp(&in[i], rows.At(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	defer release()
+
+	res, err := rowsFuture.Struct()
+	if err != nil {
+		return err
+	}
+	rows, err := res.Rows()
+	if err != nil {
+		return err
+	}
+	if rows.Len() != len(out) {
+		return fmt.Errorf("unexpected number of rows %d", rows.Len())
+	}
+	for i := range out {
+		if err := capnpToRow(rows.At(i), &out[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *gocapnpClient) IncrementMap(ctx context.Context, in, out map[string]int64) error {
+	mapFuture, release := c.api.IncrementMap(ctx, func(args API_incrementMap_Params) error {
+		entries, err := args.NewEntries(int32(len(in)))
+		if err != nil {
+			return err
+		}
+		var i int
+		for k, v := range in {
+			if err := entries.At(i).SetKey(k); err != nil {
+				return err
+			}
+			entries.At(i).SetValue(v)
+			i++
+		}
+		return nil
+	})
+	defer release()
+
+	res, err := mapFuture.Struct()
+	if err != nil {
+		return err
+	}
+	entries, err := res.Entries()
+	if err != nil {
+		return err
+	}
+	for i := range entries.Len() {
+		key, err := entries.At(i).Key()
+		if err != nil {
+			return err
+		}
+		out[key] = entries.At(i).Value()
+	}
+	return nil
+}
+
+func (c *gocapnpClient) ScaleVector(ctx context.Context, in []float64, scale float64, out []float64) error {
+	vectorFuture, release := c.api.ScaleVector(ctx, func(args API_scaleVector_Params) error {
+		values, err := args.NewValues(int32(len(in)))
+		if err != nil {
+			return err
+		}
+		for i, v := range in {
+			values.Set(i, v)
+		}
+		args.SetScale(scale)
+		return nil
+	})
+	defer release()
+
+	res, err := vectorFuture.Struct()
+	if err != nil {
+		return err
+	}
+	values, err := res.Values()
+	if err != nil {
+		return err
+	}
+	if values.Len() != len(out) {
+		return fmt.Errorf("unexpected vector size %d", values.Len())
+	}
+	for i := range out {
+		out[i] = values.At(i)
+	}
+	return nil
+}
+
+func (c *gocapnpClient) NopWithMetadata(ctx context.Context, md []rpcbench.MetadataEntry) (string, error) {
+	mdFuture, release := c.api.NopWithMetadata(ctx, func(args API_nopWithMetadata_Params) error {
+		capMd, err := args.NewMetadata()
+		if err != nil {
+			return err
+		}
+		return metadataToCapnp(md, capMd)
+	})
+	defer release()
+
+	res, err := mdFuture.Struct()
+	if err != nil {
+		return "", exceptionAppError(err)
+	}
+	return res.Echo()
+}
+
+// itemSink is the capability passed to the server on ServerStream calls, which
+// receives the streamed items.
+type itemSink struct {
+	onItem func(int64) error
+}
+
+func (s itemSink) Item(_ context.Context, call ItemSink_item) error {
+	return s.onItem(call.Args().Value())
+}
+
+func (c *gocapnpClient) ServerStream(ctx context.Context, start int64, count int, onItem func(int64) error) error {
+	sink := ItemSink_ServerToClient(itemSink{onItem: onItem})
+	defer sink.Release()
+
+	streamFuture, release := c.api.ServerStream(ctx, func(args API_serverStream_Params) error {
+		args.SetStart(start)
+		args.SetCount(int64(count))
+		return args.SetSink(sink.AddRef())
+	})
+	defer release()
+
+	_, err := streamFuture.Struct()
+	return err
+}
+
+// callbackTarget is the capability passed to the server on Callback calls, which
+// replies to the callbacks.
+type callbackTarget struct {
+	onCall func(int64) (int64, error)
+}
+
+func (t callbackTarget) Call(_ context.Context, call CallbackTarget_call) error {
+	v, err := t.onCall(call.Args().Value())
+	if err != nil {
+		return err
+	}
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	res.SetRes(v)
+	return nil
+}
+
+func (c *gocapnpClient) Callback(ctx context.Context, start int64, count int, onCall func(int64) (int64, error)) (int64, error) {
+	target := CallbackTarget_ServerToClient(callbackTarget{onCall: onCall})
+	defer target.Release()
+
+	callFuture, release := c.api.Callback(ctx, func(args API_callback_Params) error {
+		args.SetStart(start)
+		args.SetCount(int64(count))
+		return args.SetTarget(target.AddRef())
+	})
+	defer release()
+
+	res, err := callFuture.Struct()
+	if err != nil {
+		return 0, err
+	}
+	return res.Sum(), nil
+}
+
+func (c *gocapnpClient) Validate(ctx context.Context, v int64, code rpcbench.AppErrorCode) (int64, error) {
+	validateFuture, release := c.api.Validate(ctx, func(args API_validate_Params) error {
+		args.SetValue(v)
+		args.SetCode(uint32(code))
+		return nil
+	})
+	defer release()
+
+	res, err := validateFuture.Struct()
+	if err != nil {
+		return 0, exceptionAppError(err)
+	}
+	return res.Value(), nil
+}
+
+func (c *gocapnpClient) Slow(ctx context.Context, delay time.Duration) error {
+	slowFuture, release := c.api.Slow(ctx, func(args API_slow_Params) error {
+		args.SetDelay(int64(delay))
+		return nil
+	})
+
+	// Releasing the call before it returns sends a Finish message to the
+	// server, which cancels it.
+	defer release()
+	select {
+	case <-slowFuture.Done():
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	_, err := slowFuture.Struct()
+	return err
+}
+
+func (c *gocapnpClient) SlowCalls(ctx context.Context) (started, canceled int64, err error) {
+	slowFuture, release := c.api.SlowCalls(ctx, nil)
+	defer release()
+
+	res, err := slowFuture.Struct()
+	if err != nil {
+		return 0, 0, err
+	}
+	return res.Started(), res.Canceled(), nil
+}
+
+func (c *gocapnpClient) WorkWithNops(ctx context.Context, work rpcbench.Work, nops int, onNop func(i int)) error {
+	workFuture, release := c.api.Work(ctx, func(args API_work_Params) error {
+		args.SetDuration(int64(work.Duration))
+		args.SetCpu(work.CPU)
+		return nil
+	})
+	defer release()
+
+	// The Nop calls are sent without waiting for the Work call (or for
+	// each other) to return.
+	futures := make([]API_nop_Results_Future, nops)
+	releases := make([]capnp.ReleaseFunc, nops)
+	defer func() {
+		for _, release := range releases {
+			if release != nil {
+				release()
+			}
+		}
+	}()
+	for i := range futures {
+		futures[i], releases[i] = c.api.Nop(ctx, nil)
+	}
+	for i, future := range futures {
+		if _, err := future.Struct(); err != nil {
+			return err
+		}
+		onNop(i)
+	}
+	_, err := workFuture.Struct()
+	return err
+}
+
+func (c *gocapnpClient) ClientStream(ctx context.Context, chunks [][]byte) (uint32, error) {
+	streamFuture, release := c.api.ClientStream(ctx, nil)
+	defer release()
+
+	// The chunks are sent through the (pipelined) sink, without waiting for
+	// the stream to be opened or for previous chunks to return.
+	sink := streamFuture.Sink()
+	futures := make([]ChunkSink_chunk_Results_Future, len(chunks))
+	releases := make([]capnp.ReleaseFunc, len(chunks))
+	defer func() {
+		for _, release := range releases {
+			if release != nil {
+				release()
+			}
+		}
+	}()
+	for i, chunk := range chunks {
+		futures[i], releases[i] = sink.Chunk(ctx, func(args ChunkSink_chunk_Params) error {
+			return args.SetData(chunk)
+		})
+	}
+
+	doneFuture, releaseDone := sink.Done(ctx, nil)
+	defer releaseDone()
+	res, err := doneFuture.Struct()
+	if err != nil {
+		return 0, err
+	}
+	for _, f := range futures {
+		if _, err := f.Struct(); err != nil {
+			return 0, err
+		}
+	}
+	return res.Checksum(), nil
+}
+
+func (c *gocapnpClient) AddChain(ctx context.Context, start int64, deltas []int64) (int64, error) {
+	releases := make([]capnp.ReleaseFunc, 0, len(deltas)+2)
+	defer func() {
+		for _, release := range releases {
+			release()
+		}
+	}()
+
+	// Every call is made on the (pipelined) result of the previous one,
+	// therefore the whole chain takes a single round trip.
+	numFuture, release := c.api.NewNumber(ctx, func(args API_newNumber_Params) error {
+		args.SetValue(start)
+		return nil
+	})
+	releases = append(releases, release)
+	num := numFuture.Res()
+	for _, delta := range deltas {
+		addFuture, release := num.Add(ctx, func(args Number_add_Params) error {
+			args.SetDelta(delta)
+			return nil
+		})
+		releases = append(releases, release)
+		num = addFuture.Res()
+	}
+
+	getFuture, release := num.Get(ctx, nil)
+	releases = append(releases, release)
+	res, err := getFuture.Struct()
+	if err != nil {
+		return 0, err
+	}
+	return res.Value(), nil
+}
+
+// Close releases the bootstrap cap and closes the conn of the client.
+func (c *gocapnpClient) Close() error {
+	c.closeConn()
+	return nil
+}
+
+func newGoCapnpClient(ctx context.Context, cfg rpcbench.ClientConfig) (*gocapnpClient, error) {
 	// Try to connect.
-	var dc net.Dialer
-	c, err := dc.DialContext(ctx, "tcp", addr)
+	c, err := cfg.Dial(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert net.Conn into a capnp Conn.
-	capConn := rpc.NewConn(rpc.NewStreamTransport(c), nil) // nil sets defau
+	capConn := rpc.NewConn(newStreamTransport(c), nil) // nil sets defau
 
 	// Get the "bootstrap" interface. This is an instance of an API server.
 	// Wait until the server returns the cap (this is the "handshake" with
@@ -116,14 +509,15 @@ func newGoCapnpClient(ctx context.Context, addr string) (*gocapnpClient, error)
 		return nil, err
 	}
 
-	go func() {
-		<-ctx.Done()
+	closeConn := sync.OnceFunc(func() {
 		api.Release()
 		capConn.Close()
 		c.Close()
-	}()
+	})
+	context.AfterFunc(ctx, closeConn)
 
 	return &gocapnpClient{
-		api: api,
+		api:       api,
+		closeConn: closeConn,
 	}, nil
 }
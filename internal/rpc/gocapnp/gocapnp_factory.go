@@ -23,8 +23,8 @@ func (g gocapnpFactory) NewServer(l net.Listener) (rpcbench.Server, error) {
 	return newGoCapnpServer(l)
 }
 
-func (g gocapnpFactory) NewClient(ctx context.Context, addr string) (rpcbench.Client, error) {
-	return newGoCapnpClient(ctx, addr)
+func (g gocapnpFactory) NewClient(ctx context.Context, cfg rpcbench.ClientConfig) (rpcbench.Client, error) {
+	return newGoCapnpClient(ctx, cfg)
 }
 
 func GoCapnpIniter() rpcbench.RPCFactory {
@@ -35,6 +35,209 @@ func copyTree(src, tgt TreeNode) error {
 	return nil
 }
 
+func recordToCapnp(r *rpcbench.Record, tgt Record) error {
+	tgt.SetId(r.ID)
+	if err := tgt.SetName(r.Name); err != nil {
+		return err
+	}
+	if err := tgt.SetEmail(r.Email); err != nil {
+		return err
+	}
+	return tgt.SetBio(r.Bio)
+}
+
+func capnpToRecord(src Record, r *rpcbench.Record) error {
+	var err error
+	r.ID = src.Id()
+	if r.Name, err = src.Name(); err != nil {
+		return err
+	}
+	if r.Email, err = src.Email(); err != nil {
+		return err
+	}
+	r.Bio, err = src.Bio()
+	return err
+}
+
+func metadataToCapnp(md []rpcbench.MetadataEntry, tgt Metadata) error {
+	entries, err := tgt.NewEntries(int32(len(md)))
+	if err != nil {
+		return err
+	}
+	for i, e := range md {
+		if err := entries.At(i).SetKey(e.Key); err != nil {
+			return err
+		}
+		if err := entries.At(i).SetValue(e.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func capnpToMetadata(src Metadata) ([]rpcbench.MetadataEntry, error) {
+	entries, err := src.Entries()
+	if err != nil {
+		return nil, err
+	}
+	md := make([]rpcbench.MetadataEntry, entries.Len())
+	for i := range md {
+		if md[i].Key, err = entries.At(i).Key(); err != nil {
+			return nil, err
+		}
+		if md[i].Value, err = entries.At(i).Value(); err != nil {
+			return nil, err
+		}
+	}
+	return md, nil
+}
+
+// rowTextOptionalBit is the optionalSet bit of the first optional text field of
+// rows, after the ones of the optional scalar fields.
+const rowTextOptionalBit = 8
+
+func rowToCapnp(r *rpcbench.Row, tgt Row) error {
+	tgt.SetId(r.ID)
+	tgt.SetAccountId(r.AccountID)
+	tgt.SetCreatedAt(r.CreatedAt)
+	tgt.SetUpdatedAt(r.UpdatedAt)
+	tgt.SetBalance(r.Balance)
+	tgt.SetVersion(r.Version)
+	tgt.SetStatus(r.Status)
+	tgt.SetScore(r.Score)
+	tgt.SetActive(r.Active)
+	tgt.SetVerified(r.Verified)
+	for _, f := range [...]struct {
+		v   string
+		set func(string) error
+	}{
+		{r.Name, tgt.SetName}, {r.Email, tgt.SetEmail},
+		{r.Country, tgt.SetCountry}, {r.Currency, tgt.SetCurrency},
+		{r.Plan, tgt.SetPlan}, {r.Region, tgt.SetRegion},
+	} {
+		if err := f.set(f.v); err != nil {
+			return err
+		}
+	}
+
+	var set uint16
+	if r.DeletedAt != nil {
+		tgt.SetDeletedAt(*r.DeletedAt)
+		set |= 1 << 0
+	}
+	if r.ParentID != nil {
+		tgt.SetParentId(*r.ParentID)
+		set |= 1 << 1
+	}
+	if r.CreditLimit != nil {
+		tgt.SetCreditLimit(*r.CreditLimit)
+		set |= 1 << 2
+	}
+	if r.Priority != nil {
+		tgt.SetPriority(*r.Priority)
+		set |= 1 << 3
+	}
+	if r.Retries != nil {
+		tgt.SetRetries(*r.Retries)
+		set |= 1 << 4
+	}
+	if r.Discount != nil {
+		tgt.SetDiscount(*r.Discount)
+		set |= 1 << 5
+	}
+	if r.Archived != nil {
+		tgt.SetArchived(*r.Archived)
+		set |= 1 << 6
+	}
+	if r.TwoFactor != nil {
+		tgt.SetTwoFactor(*r.TwoFactor)
+		set |= 1 << 7
+	}
+	for i, f := range [...]struct {
+		v   *string
+		set func(string) error
+	}{
+		{r.ExternalID, tgt.SetExternalId}, {r.Phone, tgt.SetPhone},
+		{r.Company, tgt.SetCompany}, {r.Title, tgt.SetTitle},
+		{r.Locale, tgt.SetLocale}, {r.Timezone, tgt.SetTimezone},
+		{r.Referrer, tgt.SetReferrer}, {r.Notes, tgt.SetNotes},
+	} {
+		if f.v == nil {
+			continue
+		}
+		if err := f.set(*f.v); err != nil {
+			return err
+		}
+		set |= 1 << (rowTextOptionalBit + i)
+	}
+	tgt.SetOptionalSet(set)
+	return nil
+}
+
+// optionalValue returns a pointer to v, or nil when its bit is not set.
+func optionalValue[T any](set uint16, bit int, v T) *T {
+	if set&(1<<bit) == 0 {
+		return nil
+	}
+	return &v
+}
+
+func capnpToRow(src Row, r *rpcbench.Row) error {
+	r.ID = src.Id()
+	r.AccountID = src.AccountId()
+	r.CreatedAt = src.CreatedAt()
+	r.UpdatedAt = src.UpdatedAt()
+	r.Balance = src.Balance()
+	r.Version = src.Version()
+	r.Status = src.Status()
+	r.Score = src.Score()
+	r.Active = src.Active()
+	r.Verified = src.Verified()
+	for _, f := range [...]struct {
+		v   *string
+		get func() (string, error)
+	}{
+		{&r.Name, src.Name}, {&r.Email, src.Email},
+		{&r.Country, src.Country}, {&r.Currency, src.Currency},
+		{&r.Plan, src.Plan}, {&r.Region, src.Region},
+	} {
+		var err error
+		if *f.v, err = f.get(); err != nil {
+			return err
+		}
+	}
+
+	set := src.OptionalSet()
+	r.DeletedAt = optionalValue(set, 0, src.DeletedAt())
+	r.ParentID = optionalValue(set, 1, src.ParentId())
+	r.CreditLimit = optionalValue(set, 2, src.CreditLimit())
+	r.Priority = optionalValue(set, 3, src.Priority())
+	r.Retries = optionalValue(set, 4, src.Retries())
+	r.Discount = optionalValue(set, 5, src.Discount())
+	r.Archived = optionalValue(set, 6, src.Archived())
+	r.TwoFactor = optionalValue(set, 7, src.TwoFactor())
+	for i, f := range [...]struct {
+		v   **string
+		get func() (string, error)
+	}{
+		{&r.ExternalID, src.ExternalId}, {&r.Phone, src.Phone},
+		{&r.Company, src.Company}, {&r.Title, src.Title},
+		{&r.Locale, src.Locale}, {&r.Timezone, src.Timezone},
+		{&r.Referrer, src.Referrer}, {&r.Notes, src.Notes},
+	} {
+		*f.v = nil
+		if set&(1<<(rowTextOptionalBit+i)) == 0 {
+			continue
+		}
+		v, err := f.get()
+		if err != nil {
+			return err
+		}
+		*f.v = &v
+	}
+	return nil
+}
+
 // The following functions are handwritten adapters necessary by the interfaces
 // in rpcbench.
 //
@@ -9,17 +9,21 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"hash/crc32"
 	"log"
 	"net"
+	"time"
 
 	capnp "capnproto.org/go/capnp/v3"
 	"capnproto.org/go/capnp/v3/rpc"
+	"github.com/matheusd/gorpcbench/rpcbench"
 	"github.com/sourcegraph/conc/pool"
 )
 
 type gocapnpServer struct {
 	l       net.Listener
 	skipLog bool
+	slow    rpcbench.SlowHandler
 }
 
 func (s *gocapnpServer) Nop(context.Context, API_nop) error {
@@ -90,12 +94,305 @@ func (s *gocapnpServer) ToHex(_ context.Context, call API_toHex) error {
 	return nil
 }
 
+func (s *gocapnpServer) ServerStream(ctx context.Context, call API_serverStream) error {
+	sink := call.Args().Sink()
+	start, count := call.Args().Start(), call.Args().Count()
+
+	// Calls on the sink are delivered in order, therefore every item is
+	// sent without waiting for the previous ones to return.
+	futures := make([]ItemSink_item_Results_Future, count)
+	releases := make([]capnp.ReleaseFunc, count)
+	defer func() {
+		for _, release := range releases {
+			if release != nil {
+				release()
+			}
+		}
+	}()
+	for i := range count {
+		futures[i], releases[i] = sink.Item(ctx, func(args ItemSink_item_Params) error {
+			args.SetValue(start + i)
+			return nil
+		})
+	}
+
+	for _, f := range futures {
+		if _, err := f.Struct(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// chunkSink is the capability returned by ClientStream calls, which receives
+// the streamed chunks and computes their checksum.
+type chunkSink struct {
+	sum uint32
+}
+
+func (s *chunkSink) Chunk(_ context.Context, call ChunkSink_chunk) error {
+	data, err := call.Args().Data()
+	if err != nil {
+		return err
+	}
+	s.sum = crc32.Update(s.sum, crc32.IEEETable, data)
+	return nil
+}
+
+func (s *chunkSink) Done(_ context.Context, call ChunkSink_done) error {
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	res.SetChecksum(s.sum)
+	return nil
+}
+
+func (s *gocapnpServer) ClientStream(_ context.Context, call API_clientStream) error {
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	return res.SetSink(ChunkSink_ServerToClient(&chunkSink{}))
+}
+
+// number is the capability returned by NewNumber calls. Adding to a number
+// returns a new one, such that calls may be chained.
+type number struct {
+	value int64
+}
+
+func (n *number) Add(_ context.Context, call Number_add) error {
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	return res.SetRes(Number_ServerToClient(&number{value: n.value + call.Args().Delta()}))
+}
+
+func (n *number) Get(_ context.Context, call Number_get) error {
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	res.SetValue(n.value)
+	return nil
+}
+
+func (s *gocapnpServer) NewNumber(_ context.Context, call API_newNumber) error {
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	return res.SetRes(Number_ServerToClient(&number{value: call.Args().Value()}))
+}
+
+func (s *gocapnpServer) Callback(ctx context.Context, call API_callback) error {
+	target := call.Args().Target()
+	start, count := call.Args().Start(), call.Args().Count()
+
+	// Every callback waits for the reply of the previous one.
+	var sum int64
+	for i := range count {
+		future, release := target.Call(ctx, func(args CallbackTarget_call_Params) error {
+			args.SetValue(start + i)
+			return nil
+		})
+		res, err := future.Struct()
+		if err != nil {
+			release()
+			return err
+		}
+		sum += res.Res()
+		release()
+	}
+
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	res.SetSum(sum)
+	return nil
+}
+
+func (s *gocapnpServer) Validate(_ context.Context, call API_validate) error {
+	if code := call.Args().Code(); code != 0 {
+		appErr := rpcbench.RejectValue(rpcbench.AppErrorCode(code), call.Args().Value())
+		return appErrorException(appErr)
+	}
+
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	res.SetValue(call.Args().Value())
+	return nil
+}
+
+// Slow handles the call until its context is canceled, which happens once the
+// client sends a Finish message for it.
+func (s *gocapnpServer) Slow(ctx context.Context, call API_slow) error {
+	// Do not block other calls while this one is handled.
+	call.Go()
+	return s.slow.Handle(ctx, time.Duration(call.Args().Delay()))
+}
+
+func (s *gocapnpServer) Work(_ context.Context, call API_work) error {
+	// Do not block other calls while this one is handled.
+	call.Go()
+	rpcbench.Work{Duration: time.Duration(call.Args().Duration()), CPU: call.Args().Cpu()}.Do()
+	return nil
+}
+
+func (s *gocapnpServer) Echo(_ context.Context, call API_echo) error {
+	data, err := call.Args().Data()
+	if err != nil {
+		return err
+	}
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	return res.SetData(data)
+}
+
+func (s *gocapnpServer) UpperRecords(_ context.Context, call API_upperRecords) error {
+	in, err := call.Args().Records()
+	if err != nil {
+		return err
+	}
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	out, err := res.NewRecords(int32(in.Len()))
+	if err != nil {
+		return err
+	}
+	var r rpcbench.Record
+	for i := range in.Len() {
+		if err := capnpToRecord(in.At(i), &r); err != nil {
+			return err
+		}
+		r.Name = rpcbench.UpperName(r.Name)
+		if err := recordToCapnp(&r, out.At(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *gocapnpServer) IncrementMap(_ context.Context, call API_incrementMap) error {
+	in, err := call.Args().Entries()
+	if err != nil {
+		return err
+	}
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	out, err := res.NewEntries(int32(in.Len()))
+	if err != nil {
+		return err
+	}
+	for i := range in.Len() {
+		key, err := in.At(i).Key()
+		if err != nil {
+			return err
+		}
+		if err := out.At(i).SetKey(key); err != nil {
+			return err
+		}
+		out.At(i).SetValue(in.At(i).Value() + 1)
+	}
+	return nil
+}
+
+func (s *gocapnpServer) ScaleVector(_ context.Context, call API_scaleVector) error {
+	in, err := call.Args().Values()
+	if err != nil {
+		return err
+	}
+	scale := call.Args().Scale()
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	out, err := res.NewValues(int32(in.Len()))
+	if err != nil {
+		return err
+	}
+	for i := range in.Len() {
+		out.Set(i, in.At(i)*scale)
+	}
+	return nil
+}
+
+func (s *gocapnpServer) NopWithMetadata(_ context.Context, call API_nopWithMetadata) error {
+	capMd, err := call.Args().Metadata()
+	if err != nil {
+		return err
+	}
+	md, err := capnpToMetadata(capMd)
+	if err != nil {
+		return err
+	}
+	echo, appErr := rpcbench.CheckMetadata(func(key string) string {
+		return rpcbench.MetadataValue(md, key)
+	})
+	if appErr != nil {
+		return appErrorException(appErr)
+	}
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	return res.SetEcho(echo)
+}
+
+func (s *gocapnpServer) UpdateRows(_ context.Context, call API_updateRows) error {
+	in, err := call.Args().Rows()
+	if err != nil {
+		return err
+	}
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	out, err := res.NewRows(int32(in.Len()))
+	if err != nil {
+		return err
+	}
+	var r rpcbench.Row
+	for i := range in.Len() {
+		if err := capnpToRow(in.At(i), &r); err != nil {
+			return err
+		}
+		r.Version++
+		if err := rowToCapnp(&r, out.At(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *gocapnpServer) SlowCalls(_ context.Context, call API_slowCalls) error {
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	started, canceled := s.slow.Counts()
+	res.SetStarted(started)
+	res.SetCanceled(canceled)
+	return nil
+}
+
 func (s *gocapnpServer) runConn(ctx context.Context, c net.Conn) error {
 	// Cast the server as a capability that can be served through bootstrap.
 	client := API_ServerToClient(s)
 
 	// Convert net.Conn into a capnp Conn.
-	conn := rpc.NewConn(rpc.NewStreamTransport(c), &rpc.Options{
+	conn := rpc.NewConn(newStreamTransport(c), &rpc.Options{
 		BootstrapClient: capnp.Client(client),
 	})
 	defer conn.Close()
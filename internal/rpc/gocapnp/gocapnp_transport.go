@@ -0,0 +1,47 @@
+// Copyright (c) 2025 Matheus Degiovani
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package gocapnp
+
+import (
+	"io"
+
+	capnp "capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/rpc"
+	"github.com/matheusd/gorpcbench/rpcbench"
+)
+
+// streamCodec is the same codec used by rpc.NewStreamTransport, except that it
+// accepts (and allows traversing) messages that fit a payload of the max
+// message size, which may be larger than the default limits of go-capnp.
+type streamCodec struct {
+	*capnp.Decoder
+	*capnp.Encoder
+	io.Closer
+
+	limit uint64
+}
+
+func (c *streamCodec) Decode() (*capnp.Message, error) {
+	msg, err := c.Decoder.Decode()
+	if err != nil {
+		return nil, err
+	}
+	msg.ResetReadLimit(c.limit)
+	return msg, nil
+}
+
+// newStreamTransport returns a transport that uses rwc to send and receive
+// messages.
+func newStreamTransport(rwc io.ReadWriteCloser) rpc.Transport {
+	limit := uint64(rpcbench.MaxMessageSize() + rpcbench.MaxMessageOverhead)
+	dec := capnp.NewDecoder(rwc)
+	dec.MaxMessageSize = limit
+	return rpc.NewTransport(&streamCodec{
+		Decoder: dec,
+		Encoder: capnp.NewEncoder(rwc),
+		Closer:  rwc,
+		limit:   limit,
+	})
+}
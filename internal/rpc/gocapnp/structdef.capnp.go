@@ -9,6 +9,7 @@ import (
 	schemas "capnproto.org/go/capnp/v3/schemas"
 	server "capnproto.org/go/capnp/v3/server"
 	context "context"
+	math "math"
 )
 
 type TreeNode capnp.Struct
@@ -107,998 +108,6687 @@ func (f TreeNode_Future) Struct() (TreeNode, error) {
 	return TreeNode(p.Struct()), err
 }
 
+type Record capnp.Struct
+
+// Record_TypeID is the unique identifier for the type Record.
+const Record_TypeID = 0xeeed81671d01c322
+
+func NewRecord(s *capnp.Segment) (Record, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3})
+	return Record(st), err
+}
+
+func NewRootRecord(s *capnp.Segment) (Record, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3})
+	return Record(st), err
+}
+
+func ReadRootRecord(msg *capnp.Message) (Record, error) {
+	root, err := msg.Root()
+	return Record(root.Struct()), err
+}
+
+func (s Record) String() string {
+	str, _ := text.Marshal(0xeeed81671d01c322, capnp.Struct(s))
+	return str
+}
+
+func (s Record) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Record) DecodeFromPtr(p capnp.Ptr) Record {
+	return Record(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Record) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Record) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Record) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Record) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Record) Id() int64 {
+	return int64(capnp.Struct(s).Uint64(0))
+}
+
+func (s Record) SetId(v int64) {
+	capnp.Struct(s).SetUint64(0, uint64(v))
+}
+
+func (s Record) Name() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s Record) HasName() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Record) NameBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Record) SetName(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s Record) Email() (string, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return p.Text(), err
+}
+
+func (s Record) HasEmail() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s Record) EmailBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s Record) SetEmail(v string) error {
+	return capnp.Struct(s).SetText(1, v)
+}
+
+func (s Record) Bio() (string, error) {
+	p, err := capnp.Struct(s).Ptr(2)
+	return p.Text(), err
+}
+
+func (s Record) HasBio() bool {
+	return capnp.Struct(s).HasPtr(2)
+}
+
+func (s Record) BioBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(2)
+	return p.TextBytes(), err
+}
+
+func (s Record) SetBio(v string) error {
+	return capnp.Struct(s).SetText(2, v)
+}
+
+// Record_List is a list of Record.
+type Record_List = capnp.StructList[Record]
+
+// NewRecord creates a new list of Record.
+func NewRecord_List(s *capnp.Segment, sz int32) (Record_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3}, sz)
+	return capnp.StructList[Record](l), err
+}
+
+// Record_Future is a wrapper for a Record promised by a client call.
+type Record_Future struct{ *capnp.Future }
+
+func (f Record_Future) Struct() (Record, error) {
+	p, err := f.Future.Ptr()
+	return Record(p.Struct()), err
+}
+
+type Row capnp.Struct
+
+// Row_TypeID is the unique identifier for the type Row.
+const Row_TypeID = 0x9a5f0f1a66a15ab4
+
+func NewRow(s *capnp.Segment) (Row, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 104, PointerCount: 14})
+	return Row(st), err
+}
+
+func NewRootRow(s *capnp.Segment) (Row, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 104, PointerCount: 14})
+	return Row(st), err
+}
+
+func ReadRootRow(msg *capnp.Message) (Row, error) {
+	root, err := msg.Root()
+	return Row(root.Struct()), err
+}
+
+func (s Row) String() string {
+	str, _ := text.Marshal(0x9a5f0f1a66a15ab4, capnp.Struct(s))
+	return str
+}
+
+func (s Row) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Row) DecodeFromPtr(p capnp.Ptr) Row {
+	return Row(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Row) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Row) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Row) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Row) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Row) Id() int64 {
+	return int64(capnp.Struct(s).Uint64(0))
+}
+
+func (s Row) SetId(v int64) {
+	capnp.Struct(s).SetUint64(0, uint64(v))
+}
+
+func (s Row) AccountId() int64 {
+	return int64(capnp.Struct(s).Uint64(8))
+}
+
+func (s Row) SetAccountId(v int64) {
+	capnp.Struct(s).SetUint64(8, uint64(v))
+}
+
+func (s Row) CreatedAt() int64 {
+	return int64(capnp.Struct(s).Uint64(16))
+}
+
+func (s Row) SetCreatedAt(v int64) {
+	capnp.Struct(s).SetUint64(16, uint64(v))
+}
+
+func (s Row) UpdatedAt() int64 {
+	return int64(capnp.Struct(s).Uint64(24))
+}
+
+func (s Row) SetUpdatedAt(v int64) {
+	capnp.Struct(s).SetUint64(24, uint64(v))
+}
+
+func (s Row) Balance() int64 {
+	return int64(capnp.Struct(s).Uint64(32))
+}
+
+func (s Row) SetBalance(v int64) {
+	capnp.Struct(s).SetUint64(32, uint64(v))
+}
+
+func (s Row) Version() int32 {
+	return int32(capnp.Struct(s).Uint32(40))
+}
+
+func (s Row) SetVersion(v int32) {
+	capnp.Struct(s).SetUint32(40, uint32(v))
+}
+
+func (s Row) Status() int32 {
+	return int32(capnp.Struct(s).Uint32(44))
+}
+
+func (s Row) SetStatus(v int32) {
+	capnp.Struct(s).SetUint32(44, uint32(v))
+}
+
+func (s Row) Score() float64 {
+	return math.Float64frombits(capnp.Struct(s).Uint64(48))
+}
+
+func (s Row) SetScore(v float64) {
+	capnp.Struct(s).SetUint64(48, math.Float64bits(v))
+}
+
+func (s Row) Active() bool {
+	return capnp.Struct(s).Bit(448)
+}
+
+func (s Row) SetActive(v bool) {
+	capnp.Struct(s).SetBit(448, v)
+}
+
+func (s Row) Verified() bool {
+	return capnp.Struct(s).Bit(449)
+}
+
+func (s Row) SetVerified(v bool) {
+	capnp.Struct(s).SetBit(449, v)
+}
+
+func (s Row) Name() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s Row) HasName() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Row) NameBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Row) SetName(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s Row) Email() (string, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return p.Text(), err
+}
+
+func (s Row) HasEmail() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s Row) EmailBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s Row) SetEmail(v string) error {
+	return capnp.Struct(s).SetText(1, v)
+}
+
+func (s Row) Country() (string, error) {
+	p, err := capnp.Struct(s).Ptr(2)
+	return p.Text(), err
+}
+
+func (s Row) HasCountry() bool {
+	return capnp.Struct(s).HasPtr(2)
+}
+
+func (s Row) CountryBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(2)
+	return p.TextBytes(), err
+}
+
+func (s Row) SetCountry(v string) error {
+	return capnp.Struct(s).SetText(2, v)
+}
+
+func (s Row) Currency() (string, error) {
+	p, err := capnp.Struct(s).Ptr(3)
+	return p.Text(), err
+}
+
+func (s Row) HasCurrency() bool {
+	return capnp.Struct(s).HasPtr(3)
+}
+
+func (s Row) CurrencyBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(3)
+	return p.TextBytes(), err
+}
+
+func (s Row) SetCurrency(v string) error {
+	return capnp.Struct(s).SetText(3, v)
+}
+
+func (s Row) Plan() (string, error) {
+	p, err := capnp.Struct(s).Ptr(4)
+	return p.Text(), err
+}
+
+func (s Row) HasPlan() bool {
+	return capnp.Struct(s).HasPtr(4)
+}
+
+func (s Row) PlanBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(4)
+	return p.TextBytes(), err
+}
+
+func (s Row) SetPlan(v string) error {
+	return capnp.Struct(s).SetText(4, v)
+}
+
+func (s Row) Region() (string, error) {
+	p, err := capnp.Struct(s).Ptr(5)
+	return p.Text(), err
+}
+
+func (s Row) HasRegion() bool {
+	return capnp.Struct(s).HasPtr(5)
+}
+
+func (s Row) RegionBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(5)
+	return p.TextBytes(), err
+}
+
+func (s Row) SetRegion(v string) error {
+	return capnp.Struct(s).SetText(5, v)
+}
+
+func (s Row) OptionalSet() uint16 {
+	return capnp.Struct(s).Uint16(58)
+}
+
+func (s Row) SetOptionalSet(v uint16) {
+	capnp.Struct(s).SetUint16(58, v)
+}
+
+func (s Row) DeletedAt() int64 {
+	return int64(capnp.Struct(s).Uint64(64))
+}
+
+func (s Row) SetDeletedAt(v int64) {
+	capnp.Struct(s).SetUint64(64, uint64(v))
+}
+
+func (s Row) ParentId() int64 {
+	return int64(capnp.Struct(s).Uint64(72))
+}
+
+func (s Row) SetParentId(v int64) {
+	capnp.Struct(s).SetUint64(72, uint64(v))
+}
+
+func (s Row) CreditLimit() int64 {
+	return int64(capnp.Struct(s).Uint64(80))
+}
+
+func (s Row) SetCreditLimit(v int64) {
+	capnp.Struct(s).SetUint64(80, uint64(v))
+}
+
+func (s Row) Priority() int32 {
+	return int32(capnp.Struct(s).Uint32(60))
+}
+
+func (s Row) SetPriority(v int32) {
+	capnp.Struct(s).SetUint32(60, uint32(v))
+}
+
+func (s Row) Retries() int32 {
+	return int32(capnp.Struct(s).Uint32(88))
+}
+
+func (s Row) SetRetries(v int32) {
+	capnp.Struct(s).SetUint32(88, uint32(v))
+}
+
+func (s Row) Discount() float64 {
+	return math.Float64frombits(capnp.Struct(s).Uint64(96))
+}
+
+func (s Row) SetDiscount(v float64) {
+	capnp.Struct(s).SetUint64(96, math.Float64bits(v))
+}
+
+func (s Row) Archived() bool {
+	return capnp.Struct(s).Bit(450)
+}
+
+func (s Row) SetArchived(v bool) {
+	capnp.Struct(s).SetBit(450, v)
+}
+
+func (s Row) TwoFactor() bool {
+	return capnp.Struct(s).Bit(451)
+}
+
+func (s Row) SetTwoFactor(v bool) {
+	capnp.Struct(s).SetBit(451, v)
+}
+
+func (s Row) ExternalId() (string, error) {
+	p, err := capnp.Struct(s).Ptr(6)
+	return p.Text(), err
+}
+
+func (s Row) HasExternalId() bool {
+	return capnp.Struct(s).HasPtr(6)
+}
+
+func (s Row) ExternalIdBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(6)
+	return p.TextBytes(), err
+}
+
+func (s Row) SetExternalId(v string) error {
+	return capnp.Struct(s).SetText(6, v)
+}
+
+func (s Row) Phone() (string, error) {
+	p, err := capnp.Struct(s).Ptr(7)
+	return p.Text(), err
+}
+
+func (s Row) HasPhone() bool {
+	return capnp.Struct(s).HasPtr(7)
+}
+
+func (s Row) PhoneBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(7)
+	return p.TextBytes(), err
+}
+
+func (s Row) SetPhone(v string) error {
+	return capnp.Struct(s).SetText(7, v)
+}
+
+func (s Row) Company() (string, error) {
+	p, err := capnp.Struct(s).Ptr(8)
+	return p.Text(), err
+}
+
+func (s Row) HasCompany() bool {
+	return capnp.Struct(s).HasPtr(8)
+}
+
+func (s Row) CompanyBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(8)
+	return p.TextBytes(), err
+}
+
+func (s Row) SetCompany(v string) error {
+	return capnp.Struct(s).SetText(8, v)
+}
+
+func (s Row) Title() (string, error) {
+	p, err := capnp.Struct(s).Ptr(9)
+	return p.Text(), err
+}
+
+func (s Row) HasTitle() bool {
+	return capnp.Struct(s).HasPtr(9)
+}
+
+func (s Row) TitleBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(9)
+	return p.TextBytes(), err
+}
+
+func (s Row) SetTitle(v string) error {
+	return capnp.Struct(s).SetText(9, v)
+}
+
+func (s Row) Locale() (string, error) {
+	p, err := capnp.Struct(s).Ptr(10)
+	return p.Text(), err
+}
+
+func (s Row) HasLocale() bool {
+	return capnp.Struct(s).HasPtr(10)
+}
+
+func (s Row) LocaleBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(10)
+	return p.TextBytes(), err
+}
+
+func (s Row) SetLocale(v string) error {
+	return capnp.Struct(s).SetText(10, v)
+}
+
+func (s Row) Timezone() (string, error) {
+	p, err := capnp.Struct(s).Ptr(11)
+	return p.Text(), err
+}
+
+func (s Row) HasTimezone() bool {
+	return capnp.Struct(s).HasPtr(11)
+}
+
+func (s Row) TimezoneBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(11)
+	return p.TextBytes(), err
+}
+
+func (s Row) SetTimezone(v string) error {
+	return capnp.Struct(s).SetText(11, v)
+}
+
+func (s Row) Referrer() (string, error) {
+	p, err := capnp.Struct(s).Ptr(12)
+	return p.Text(), err
+}
+
+func (s Row) HasReferrer() bool {
+	return capnp.Struct(s).HasPtr(12)
+}
+
+func (s Row) ReferrerBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(12)
+	return p.TextBytes(), err
+}
+
+func (s Row) SetReferrer(v string) error {
+	return capnp.Struct(s).SetText(12, v)
+}
+
+func (s Row) Notes() (string, error) {
+	p, err := capnp.Struct(s).Ptr(13)
+	return p.Text(), err
+}
+
+func (s Row) HasNotes() bool {
+	return capnp.Struct(s).HasPtr(13)
+}
+
+func (s Row) NotesBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(13)
+	return p.TextBytes(), err
+}
+
+func (s Row) SetNotes(v string) error {
+	return capnp.Struct(s).SetText(13, v)
+}
+
+// Row_List is a list of Row.
+type Row_List = capnp.StructList[Row]
+
+// NewRow creates a new list of Row.
+func NewRow_List(s *capnp.Segment, sz int32) (Row_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 104, PointerCount: 14}, sz)
+	return capnp.StructList[Row](l), err
+}
+
+// Row_Future is a wrapper for a Row promised by a client call.
+type Row_Future struct{ *capnp.Future }
+
+func (f Row_Future) Struct() (Row, error) {
+	p, err := f.Future.Ptr()
+	return Row(p.Struct()), err
+}
+
+type MapEntry capnp.Struct
+
+// MapEntry_TypeID is the unique identifier for the type MapEntry.
+const MapEntry_TypeID = 0xe5ac83af5a1b01cc
+
+func NewMapEntry(s *capnp.Segment) (MapEntry, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return MapEntry(st), err
+}
+
+func NewRootMapEntry(s *capnp.Segment) (MapEntry, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return MapEntry(st), err
+}
+
+func ReadRootMapEntry(msg *capnp.Message) (MapEntry, error) {
+	root, err := msg.Root()
+	return MapEntry(root.Struct()), err
+}
+
+func (s MapEntry) String() string {
+	str, _ := text.Marshal(0xe5ac83af5a1b01cc, capnp.Struct(s))
+	return str
+}
+
+func (s MapEntry) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (MapEntry) DecodeFromPtr(p capnp.Ptr) MapEntry {
+	return MapEntry(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s MapEntry) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s MapEntry) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s MapEntry) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s MapEntry) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s MapEntry) Key() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s MapEntry) HasKey() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s MapEntry) KeyBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s MapEntry) SetKey(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s MapEntry) Value() int64 {
+	return int64(capnp.Struct(s).Uint64(0))
+}
+
+func (s MapEntry) SetValue(v int64) {
+	capnp.Struct(s).SetUint64(0, uint64(v))
+}
+
+// MapEntry_List is a list of MapEntry.
+type MapEntry_List = capnp.StructList[MapEntry]
+
+// NewMapEntry creates a new list of MapEntry.
+func NewMapEntry_List(s *capnp.Segment, sz int32) (MapEntry_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[MapEntry](l), err
+}
+
+// MapEntry_Future is a wrapper for a MapEntry promised by a client call.
+type MapEntry_Future struct{ *capnp.Future }
+
+func (f MapEntry_Future) Struct() (MapEntry, error) {
+	p, err := f.Future.Ptr()
+	return MapEntry(p.Struct()), err
+}
+
+type MetadataEntry capnp.Struct
+
+// MetadataEntry_TypeID is the unique identifier for the type MetadataEntry.
+const MetadataEntry_TypeID = 0xd4143bbe94d9586e
+
+func NewMetadataEntry(s *capnp.Segment) (MetadataEntry, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return MetadataEntry(st), err
+}
+
+func NewRootMetadataEntry(s *capnp.Segment) (MetadataEntry, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return MetadataEntry(st), err
+}
+
+func ReadRootMetadataEntry(msg *capnp.Message) (MetadataEntry, error) {
+	root, err := msg.Root()
+	return MetadataEntry(root.Struct()), err
+}
+
+func (s MetadataEntry) String() string {
+	str, _ := text.Marshal(0xd4143bbe94d9586e, capnp.Struct(s))
+	return str
+}
+
+func (s MetadataEntry) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (MetadataEntry) DecodeFromPtr(p capnp.Ptr) MetadataEntry {
+	return MetadataEntry(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s MetadataEntry) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s MetadataEntry) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s MetadataEntry) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s MetadataEntry) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s MetadataEntry) Key() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s MetadataEntry) HasKey() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s MetadataEntry) KeyBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s MetadataEntry) SetKey(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s MetadataEntry) Value() (string, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return p.Text(), err
+}
+
+func (s MetadataEntry) HasValue() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s MetadataEntry) ValueBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s MetadataEntry) SetValue(v string) error {
+	return capnp.Struct(s).SetText(1, v)
+}
+
+// MetadataEntry_List is a list of MetadataEntry.
+type MetadataEntry_List = capnp.StructList[MetadataEntry]
+
+// NewMetadataEntry creates a new list of MetadataEntry.
+func NewMetadataEntry_List(s *capnp.Segment, sz int32) (MetadataEntry_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	return capnp.StructList[MetadataEntry](l), err
+}
+
+// MetadataEntry_Future is a wrapper for a MetadataEntry promised by a client call.
+type MetadataEntry_Future struct{ *capnp.Future }
+
+func (f MetadataEntry_Future) Struct() (MetadataEntry, error) {
+	p, err := f.Future.Ptr()
+	return MetadataEntry(p.Struct()), err
+}
+
+type Metadata capnp.Struct
+
+// Metadata_TypeID is the unique identifier for the type Metadata.
+const Metadata_TypeID = 0xd97a318917194c77
+
+func NewMetadata(s *capnp.Segment) (Metadata, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Metadata(st), err
+}
+
+func NewRootMetadata(s *capnp.Segment) (Metadata, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Metadata(st), err
+}
+
+func ReadRootMetadata(msg *capnp.Message) (Metadata, error) {
+	root, err := msg.Root()
+	return Metadata(root.Struct()), err
+}
+
+func (s Metadata) String() string {
+	str, _ := text.Marshal(0xd97a318917194c77, capnp.Struct(s))
+	return str
+}
+
+func (s Metadata) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Metadata) DecodeFromPtr(p capnp.Ptr) Metadata {
+	return Metadata(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Metadata) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Metadata) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Metadata) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Metadata) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Metadata) Entries() (MetadataEntry_List, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return MetadataEntry_List(p.List()), err
+}
+
+func (s Metadata) HasEntries() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Metadata) SetEntries(v MetadataEntry_List) error {
+	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+}
+
+// NewEntries sets the entries field to a newly
+// allocated MetadataEntry_List, preferring placement in s's segment.
+func (s Metadata) NewEntries(n int32) (MetadataEntry_List, error) {
+	l, err := NewMetadataEntry_List(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return MetadataEntry_List{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
+	return l, err
+}
+
+// Metadata_List is a list of Metadata.
+type Metadata_List = capnp.StructList[Metadata]
+
+// NewMetadata creates a new list of Metadata.
+func NewMetadata_List(s *capnp.Segment, sz int32) (Metadata_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Metadata](l), err
+}
+
+// Metadata_Future is a wrapper for a Metadata promised by a client call.
+type Metadata_Future struct{ *capnp.Future }
+
+func (f Metadata_Future) Struct() (Metadata, error) {
+	p, err := f.Future.Ptr()
+	return Metadata(p.Struct()), err
+}
+
+type ItemSink capnp.Client
+
+// ItemSink_TypeID is the unique identifier for the type ItemSink.
+const ItemSink_TypeID = 0xef341c9d7e2df6e4
+
+func (c ItemSink) Item(ctx context.Context, params func(ItemSink_item_Params) error) (ItemSink_item_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xef341c9d7e2df6e4,
+			MethodID:      0,
+			InterfaceName: "structdef.capnp:ItemSink",
+			MethodName:    "item",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(ItemSink_item_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return ItemSink_item_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c ItemSink) WaitStreaming() error {
+	return capnp.Client(c).WaitStreaming()
+}
+
+// String returns a string that identifies this capability for debugging
+// purposes.  Its format should not be depended on: in particular, it
+// should not be used to compare clients.  Use IsSame to compare clients
+// for equality.
+func (c ItemSink) String() string {
+	return "ItemSink(" + capnp.Client(c).String() + ")"
+}
+
+// AddRef creates a new Client that refers to the same capability as c.
+// If c is nil or has resolved to null, then AddRef returns nil.
+func (c ItemSink) AddRef() ItemSink {
+	return ItemSink(capnp.Client(c).AddRef())
+}
+
+// Release releases a capability reference.  If this is the last
+// reference to the capability, then the underlying resources associated
+// with the capability will be released.
+//
+// Release will panic if c has already been released, but not if c is
+// nil or resolved to null.
+func (c ItemSink) Release() {
+	capnp.Client(c).Release()
+}
+
+// Resolve blocks until the capability is fully resolved or the Context
+// expires.
+func (c ItemSink) Resolve(ctx context.Context) error {
+	return capnp.Client(c).Resolve(ctx)
+}
+
+func (c ItemSink) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Client(c).EncodeAsPtr(seg)
+}
+
+func (ItemSink) DecodeFromPtr(p capnp.Ptr) ItemSink {
+	return ItemSink(capnp.Client{}.DecodeFromPtr(p))
+}
+
+// IsValid reports whether c is a valid reference to a capability.
+// A reference is invalid if it is nil, has resolved to null, or has
+// been released.
+func (c ItemSink) IsValid() bool {
+	return capnp.Client(c).IsValid()
+}
+
+// IsSame reports whether c and other refer to a capability created by the
+// same call to NewClient.  This can return false negatives if c or other
+// are not fully resolved: use Resolve if this is an issue.  If either
+// c or other are released, then IsSame panics.
+func (c ItemSink) IsSame(other ItemSink) bool {
+	return capnp.Client(c).IsSame(capnp.Client(other))
+}
+
+// Update the flowcontrol.FlowLimiter used to manage flow control for
+// this client. This affects all future calls, but not calls already
+// waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
+// which is also the default.
+func (c ItemSink) SetFlowLimiter(lim fc.FlowLimiter) {
+	capnp.Client(c).SetFlowLimiter(lim)
+}
+
+// Get the current flowcontrol.FlowLimiter used to manage flow control
+// for this client.
+func (c ItemSink) GetFlowLimiter() fc.FlowLimiter {
+	return capnp.Client(c).GetFlowLimiter()
+}
+
+// A ItemSink_Server is a ItemSink with a local implementation.
+type ItemSink_Server interface {
+	Item(context.Context, ItemSink_item) error
+}
+
+// ItemSink_NewServer creates a new Server from an implementation of ItemSink_Server.
+func ItemSink_NewServer(s ItemSink_Server) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(ItemSink_Methods(nil, s), s, c)
+}
+
+// ItemSink_ServerToClient creates a new Client from an implementation of ItemSink_Server.
+// The caller is responsible for calling Release on the returned Client.
+func ItemSink_ServerToClient(s ItemSink_Server) ItemSink {
+	return ItemSink(capnp.NewClient(ItemSink_NewServer(s)))
+}
+
+// ItemSink_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func ItemSink_Methods(methods []server.Method, s ItemSink_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 1)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xef341c9d7e2df6e4,
+			MethodID:      0,
+			InterfaceName: "structdef.capnp:ItemSink",
+			MethodName:    "item",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Item(ctx, ItemSink_item{call})
+		},
+	})
+
+	return methods
+}
+
+// ItemSink_item holds the state for a server call to ItemSink.item.
+// See server.Call for documentation.
+type ItemSink_item struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c ItemSink_item) Args() ItemSink_item_Params {
+	return ItemSink_item_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c ItemSink_item) AllocResults() (ItemSink_item_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return ItemSink_item_Results(r), err
+}
+
+// ItemSink_List is a list of ItemSink.
+type ItemSink_List = capnp.CapList[ItemSink]
+
+// NewItemSink creates a new list of ItemSink.
+func NewItemSink_List(s *capnp.Segment, sz int32) (ItemSink_List, error) {
+	l, err := capnp.NewPointerList(s, sz)
+	return capnp.CapList[ItemSink](l), err
+}
+
+type ItemSink_item_Params capnp.Struct
+
+// ItemSink_item_Params_TypeID is the unique identifier for the type ItemSink_item_Params.
+const ItemSink_item_Params_TypeID = 0x860407578e6ff6eb
+
+func NewItemSink_item_Params(s *capnp.Segment) (ItemSink_item_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return ItemSink_item_Params(st), err
+}
+
+func NewRootItemSink_item_Params(s *capnp.Segment) (ItemSink_item_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return ItemSink_item_Params(st), err
+}
+
+func ReadRootItemSink_item_Params(msg *capnp.Message) (ItemSink_item_Params, error) {
+	root, err := msg.Root()
+	return ItemSink_item_Params(root.Struct()), err
+}
+
+func (s ItemSink_item_Params) String() string {
+	str, _ := text.Marshal(0x860407578e6ff6eb, capnp.Struct(s))
+	return str
+}
+
+func (s ItemSink_item_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (ItemSink_item_Params) DecodeFromPtr(p capnp.Ptr) ItemSink_item_Params {
+	return ItemSink_item_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s ItemSink_item_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s ItemSink_item_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s ItemSink_item_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s ItemSink_item_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s ItemSink_item_Params) Value() int64 {
+	return int64(capnp.Struct(s).Uint64(0))
+}
+
+func (s ItemSink_item_Params) SetValue(v int64) {
+	capnp.Struct(s).SetUint64(0, uint64(v))
+}
+
+// ItemSink_item_Params_List is a list of ItemSink_item_Params.
+type ItemSink_item_Params_List = capnp.StructList[ItemSink_item_Params]
+
+// NewItemSink_item_Params creates a new list of ItemSink_item_Params.
+func NewItemSink_item_Params_List(s *capnp.Segment, sz int32) (ItemSink_item_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[ItemSink_item_Params](l), err
+}
+
+// ItemSink_item_Params_Future is a wrapper for a ItemSink_item_Params promised by a client call.
+type ItemSink_item_Params_Future struct{ *capnp.Future }
+
+func (f ItemSink_item_Params_Future) Struct() (ItemSink_item_Params, error) {
+	p, err := f.Future.Ptr()
+	return ItemSink_item_Params(p.Struct()), err
+}
+
+type ItemSink_item_Results capnp.Struct
+
+// ItemSink_item_Results_TypeID is the unique identifier for the type ItemSink_item_Results.
+const ItemSink_item_Results_TypeID = 0xb123c1604e506c87
+
+func NewItemSink_item_Results(s *capnp.Segment) (ItemSink_item_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return ItemSink_item_Results(st), err
+}
+
+func NewRootItemSink_item_Results(s *capnp.Segment) (ItemSink_item_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return ItemSink_item_Results(st), err
+}
+
+func ReadRootItemSink_item_Results(msg *capnp.Message) (ItemSink_item_Results, error) {
+	root, err := msg.Root()
+	return ItemSink_item_Results(root.Struct()), err
+}
+
+func (s ItemSink_item_Results) String() string {
+	str, _ := text.Marshal(0xb123c1604e506c87, capnp.Struct(s))
+	return str
+}
+
+func (s ItemSink_item_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (ItemSink_item_Results) DecodeFromPtr(p capnp.Ptr) ItemSink_item_Results {
+	return ItemSink_item_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s ItemSink_item_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s ItemSink_item_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s ItemSink_item_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s ItemSink_item_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// ItemSink_item_Results_List is a list of ItemSink_item_Results.
+type ItemSink_item_Results_List = capnp.StructList[ItemSink_item_Results]
+
+// NewItemSink_item_Results creates a new list of ItemSink_item_Results.
+func NewItemSink_item_Results_List(s *capnp.Segment, sz int32) (ItemSink_item_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[ItemSink_item_Results](l), err
+}
+
+// ItemSink_item_Results_Future is a wrapper for a ItemSink_item_Results promised by a client call.
+type ItemSink_item_Results_Future struct{ *capnp.Future }
+
+func (f ItemSink_item_Results_Future) Struct() (ItemSink_item_Results, error) {
+	p, err := f.Future.Ptr()
+	return ItemSink_item_Results(p.Struct()), err
+}
+
+type ChunkSink capnp.Client
+
+// ChunkSink_TypeID is the unique identifier for the type ChunkSink.
+const ChunkSink_TypeID = 0xe435a9ad5572e0fd
+
+func (c ChunkSink) Chunk(ctx context.Context, params func(ChunkSink_chunk_Params) error) (ChunkSink_chunk_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xe435a9ad5572e0fd,
+			MethodID:      0,
+			InterfaceName: "structdef.capnp:ChunkSink",
+			MethodName:    "chunk",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(ChunkSink_chunk_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return ChunkSink_chunk_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c ChunkSink) Done(ctx context.Context, params func(ChunkSink_done_Params) error) (ChunkSink_done_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xe435a9ad5572e0fd,
+			MethodID:      1,
+			InterfaceName: "structdef.capnp:ChunkSink",
+			MethodName:    "done",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(ChunkSink_done_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return ChunkSink_done_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c ChunkSink) WaitStreaming() error {
+	return capnp.Client(c).WaitStreaming()
+}
+
+// String returns a string that identifies this capability for debugging
+// purposes.  Its format should not be depended on: in particular, it
+// should not be used to compare clients.  Use IsSame to compare clients
+// for equality.
+func (c ChunkSink) String() string {
+	return "ChunkSink(" + capnp.Client(c).String() + ")"
+}
+
+// AddRef creates a new Client that refers to the same capability as c.
+// If c is nil or has resolved to null, then AddRef returns nil.
+func (c ChunkSink) AddRef() ChunkSink {
+	return ChunkSink(capnp.Client(c).AddRef())
+}
+
+// Release releases a capability reference.  If this is the last
+// reference to the capability, then the underlying resources associated
+// with the capability will be released.
+//
+// Release will panic if c has already been released, but not if c is
+// nil or resolved to null.
+func (c ChunkSink) Release() {
+	capnp.Client(c).Release()
+}
+
+// Resolve blocks until the capability is fully resolved or the Context
+// expires.
+func (c ChunkSink) Resolve(ctx context.Context) error {
+	return capnp.Client(c).Resolve(ctx)
+}
+
+func (c ChunkSink) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Client(c).EncodeAsPtr(seg)
+}
+
+func (ChunkSink) DecodeFromPtr(p capnp.Ptr) ChunkSink {
+	return ChunkSink(capnp.Client{}.DecodeFromPtr(p))
+}
+
+// IsValid reports whether c is a valid reference to a capability.
+// A reference is invalid if it is nil, has resolved to null, or has
+// been released.
+func (c ChunkSink) IsValid() bool {
+	return capnp.Client(c).IsValid()
+}
+
+// IsSame reports whether c and other refer to a capability created by the
+// same call to NewClient.  This can return false negatives if c or other
+// are not fully resolved: use Resolve if this is an issue.  If either
+// c or other are released, then IsSame panics.
+func (c ChunkSink) IsSame(other ChunkSink) bool {
+	return capnp.Client(c).IsSame(capnp.Client(other))
+}
+
+// Update the flowcontrol.FlowLimiter used to manage flow control for
+// this client. This affects all future calls, but not calls already
+// waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
+// which is also the default.
+func (c ChunkSink) SetFlowLimiter(lim fc.FlowLimiter) {
+	capnp.Client(c).SetFlowLimiter(lim)
+}
+
+// Get the current flowcontrol.FlowLimiter used to manage flow control
+// for this client.
+func (c ChunkSink) GetFlowLimiter() fc.FlowLimiter {
+	return capnp.Client(c).GetFlowLimiter()
+}
+
+// A ChunkSink_Server is a ChunkSink with a local implementation.
+type ChunkSink_Server interface {
+	Chunk(context.Context, ChunkSink_chunk) error
+
+	Done(context.Context, ChunkSink_done) error
+}
+
+// ChunkSink_NewServer creates a new Server from an implementation of ChunkSink_Server.
+func ChunkSink_NewServer(s ChunkSink_Server) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(ChunkSink_Methods(nil, s), s, c)
+}
+
+// ChunkSink_ServerToClient creates a new Client from an implementation of ChunkSink_Server.
+// The caller is responsible for calling Release on the returned Client.
+func ChunkSink_ServerToClient(s ChunkSink_Server) ChunkSink {
+	return ChunkSink(capnp.NewClient(ChunkSink_NewServer(s)))
+}
+
+// ChunkSink_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func ChunkSink_Methods(methods []server.Method, s ChunkSink_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 2)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xe435a9ad5572e0fd,
+			MethodID:      0,
+			InterfaceName: "structdef.capnp:ChunkSink",
+			MethodName:    "chunk",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Chunk(ctx, ChunkSink_chunk{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xe435a9ad5572e0fd,
+			MethodID:      1,
+			InterfaceName: "structdef.capnp:ChunkSink",
+			MethodName:    "done",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Done(ctx, ChunkSink_done{call})
+		},
+	})
+
+	return methods
+}
+
+// ChunkSink_chunk holds the state for a server call to ChunkSink.chunk.
+// See server.Call for documentation.
+type ChunkSink_chunk struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c ChunkSink_chunk) Args() ChunkSink_chunk_Params {
+	return ChunkSink_chunk_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c ChunkSink_chunk) AllocResults() (ChunkSink_chunk_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return ChunkSink_chunk_Results(r), err
+}
+
+// ChunkSink_done holds the state for a server call to ChunkSink.done.
+// See server.Call for documentation.
+type ChunkSink_done struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c ChunkSink_done) Args() ChunkSink_done_Params {
+	return ChunkSink_done_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c ChunkSink_done) AllocResults() (ChunkSink_done_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return ChunkSink_done_Results(r), err
+}
+
+// ChunkSink_List is a list of ChunkSink.
+type ChunkSink_List = capnp.CapList[ChunkSink]
+
+// NewChunkSink creates a new list of ChunkSink.
+func NewChunkSink_List(s *capnp.Segment, sz int32) (ChunkSink_List, error) {
+	l, err := capnp.NewPointerList(s, sz)
+	return capnp.CapList[ChunkSink](l), err
+}
+
+type ChunkSink_chunk_Params capnp.Struct
+
+// ChunkSink_chunk_Params_TypeID is the unique identifier for the type ChunkSink_chunk_Params.
+const ChunkSink_chunk_Params_TypeID = 0xdd570102b7c93d0d
+
+func NewChunkSink_chunk_Params(s *capnp.Segment) (ChunkSink_chunk_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ChunkSink_chunk_Params(st), err
+}
+
+func NewRootChunkSink_chunk_Params(s *capnp.Segment) (ChunkSink_chunk_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ChunkSink_chunk_Params(st), err
+}
+
+func ReadRootChunkSink_chunk_Params(msg *capnp.Message) (ChunkSink_chunk_Params, error) {
+	root, err := msg.Root()
+	return ChunkSink_chunk_Params(root.Struct()), err
+}
+
+func (s ChunkSink_chunk_Params) String() string {
+	str, _ := text.Marshal(0xdd570102b7c93d0d, capnp.Struct(s))
+	return str
+}
+
+func (s ChunkSink_chunk_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (ChunkSink_chunk_Params) DecodeFromPtr(p capnp.Ptr) ChunkSink_chunk_Params {
+	return ChunkSink_chunk_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s ChunkSink_chunk_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s ChunkSink_chunk_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s ChunkSink_chunk_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s ChunkSink_chunk_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s ChunkSink_chunk_Params) Data() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return []byte(p.Data()), err
+}
+
+func (s ChunkSink_chunk_Params) HasData() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s ChunkSink_chunk_Params) SetData(v []byte) error {
+	return capnp.Struct(s).SetData(0, v)
+}
+
+// ChunkSink_chunk_Params_List is a list of ChunkSink_chunk_Params.
+type ChunkSink_chunk_Params_List = capnp.StructList[ChunkSink_chunk_Params]
+
+// NewChunkSink_chunk_Params creates a new list of ChunkSink_chunk_Params.
+func NewChunkSink_chunk_Params_List(s *capnp.Segment, sz int32) (ChunkSink_chunk_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[ChunkSink_chunk_Params](l), err
+}
+
+// ChunkSink_chunk_Params_Future is a wrapper for a ChunkSink_chunk_Params promised by a client call.
+type ChunkSink_chunk_Params_Future struct{ *capnp.Future }
+
+func (f ChunkSink_chunk_Params_Future) Struct() (ChunkSink_chunk_Params, error) {
+	p, err := f.Future.Ptr()
+	return ChunkSink_chunk_Params(p.Struct()), err
+}
+
+type ChunkSink_chunk_Results capnp.Struct
+
+// ChunkSink_chunk_Results_TypeID is the unique identifier for the type ChunkSink_chunk_Results.
+const ChunkSink_chunk_Results_TypeID = 0xfb0978b325f6c254
+
+func NewChunkSink_chunk_Results(s *capnp.Segment) (ChunkSink_chunk_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return ChunkSink_chunk_Results(st), err
+}
+
+func NewRootChunkSink_chunk_Results(s *capnp.Segment) (ChunkSink_chunk_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return ChunkSink_chunk_Results(st), err
+}
+
+func ReadRootChunkSink_chunk_Results(msg *capnp.Message) (ChunkSink_chunk_Results, error) {
+	root, err := msg.Root()
+	return ChunkSink_chunk_Results(root.Struct()), err
+}
+
+func (s ChunkSink_chunk_Results) String() string {
+	str, _ := text.Marshal(0xfb0978b325f6c254, capnp.Struct(s))
+	return str
+}
+
+func (s ChunkSink_chunk_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (ChunkSink_chunk_Results) DecodeFromPtr(p capnp.Ptr) ChunkSink_chunk_Results {
+	return ChunkSink_chunk_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s ChunkSink_chunk_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s ChunkSink_chunk_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s ChunkSink_chunk_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s ChunkSink_chunk_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// ChunkSink_chunk_Results_List is a list of ChunkSink_chunk_Results.
+type ChunkSink_chunk_Results_List = capnp.StructList[ChunkSink_chunk_Results]
+
+// NewChunkSink_chunk_Results creates a new list of ChunkSink_chunk_Results.
+func NewChunkSink_chunk_Results_List(s *capnp.Segment, sz int32) (ChunkSink_chunk_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[ChunkSink_chunk_Results](l), err
+}
+
+// ChunkSink_chunk_Results_Future is a wrapper for a ChunkSink_chunk_Results promised by a client call.
+type ChunkSink_chunk_Results_Future struct{ *capnp.Future }
+
+func (f ChunkSink_chunk_Results_Future) Struct() (ChunkSink_chunk_Results, error) {
+	p, err := f.Future.Ptr()
+	return ChunkSink_chunk_Results(p.Struct()), err
+}
+
+type ChunkSink_done_Params capnp.Struct
+
+// ChunkSink_done_Params_TypeID is the unique identifier for the type ChunkSink_done_Params.
+const ChunkSink_done_Params_TypeID = 0xf9d5a351169432c4
+
+func NewChunkSink_done_Params(s *capnp.Segment) (ChunkSink_done_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return ChunkSink_done_Params(st), err
+}
+
+func NewRootChunkSink_done_Params(s *capnp.Segment) (ChunkSink_done_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return ChunkSink_done_Params(st), err
+}
+
+func ReadRootChunkSink_done_Params(msg *capnp.Message) (ChunkSink_done_Params, error) {
+	root, err := msg.Root()
+	return ChunkSink_done_Params(root.Struct()), err
+}
+
+func (s ChunkSink_done_Params) String() string {
+	str, _ := text.Marshal(0xf9d5a351169432c4, capnp.Struct(s))
+	return str
+}
+
+func (s ChunkSink_done_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (ChunkSink_done_Params) DecodeFromPtr(p capnp.Ptr) ChunkSink_done_Params {
+	return ChunkSink_done_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s ChunkSink_done_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s ChunkSink_done_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s ChunkSink_done_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s ChunkSink_done_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// ChunkSink_done_Params_List is a list of ChunkSink_done_Params.
+type ChunkSink_done_Params_List = capnp.StructList[ChunkSink_done_Params]
+
+// NewChunkSink_done_Params creates a new list of ChunkSink_done_Params.
+func NewChunkSink_done_Params_List(s *capnp.Segment, sz int32) (ChunkSink_done_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[ChunkSink_done_Params](l), err
+}
+
+// ChunkSink_done_Params_Future is a wrapper for a ChunkSink_done_Params promised by a client call.
+type ChunkSink_done_Params_Future struct{ *capnp.Future }
+
+func (f ChunkSink_done_Params_Future) Struct() (ChunkSink_done_Params, error) {
+	p, err := f.Future.Ptr()
+	return ChunkSink_done_Params(p.Struct()), err
+}
+
+type ChunkSink_done_Results capnp.Struct
+
+// ChunkSink_done_Results_TypeID is the unique identifier for the type ChunkSink_done_Results.
+const ChunkSink_done_Results_TypeID = 0xa1af930fc7b95e1d
+
+func NewChunkSink_done_Results(s *capnp.Segment) (ChunkSink_done_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return ChunkSink_done_Results(st), err
+}
+
+func NewRootChunkSink_done_Results(s *capnp.Segment) (ChunkSink_done_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return ChunkSink_done_Results(st), err
+}
+
+func ReadRootChunkSink_done_Results(msg *capnp.Message) (ChunkSink_done_Results, error) {
+	root, err := msg.Root()
+	return ChunkSink_done_Results(root.Struct()), err
+}
+
+func (s ChunkSink_done_Results) String() string {
+	str, _ := text.Marshal(0xa1af930fc7b95e1d, capnp.Struct(s))
+	return str
+}
+
+func (s ChunkSink_done_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (ChunkSink_done_Results) DecodeFromPtr(p capnp.Ptr) ChunkSink_done_Results {
+	return ChunkSink_done_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s ChunkSink_done_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s ChunkSink_done_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s ChunkSink_done_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s ChunkSink_done_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s ChunkSink_done_Results) Checksum() uint32 {
+	return capnp.Struct(s).Uint32(0)
+}
+
+func (s ChunkSink_done_Results) SetChecksum(v uint32) {
+	capnp.Struct(s).SetUint32(0, v)
+}
+
+// ChunkSink_done_Results_List is a list of ChunkSink_done_Results.
+type ChunkSink_done_Results_List = capnp.StructList[ChunkSink_done_Results]
+
+// NewChunkSink_done_Results creates a new list of ChunkSink_done_Results.
+func NewChunkSink_done_Results_List(s *capnp.Segment, sz int32) (ChunkSink_done_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[ChunkSink_done_Results](l), err
+}
+
+// ChunkSink_done_Results_Future is a wrapper for a ChunkSink_done_Results promised by a client call.
+type ChunkSink_done_Results_Future struct{ *capnp.Future }
+
+func (f ChunkSink_done_Results_Future) Struct() (ChunkSink_done_Results, error) {
+	p, err := f.Future.Ptr()
+	return ChunkSink_done_Results(p.Struct()), err
+}
+
+type Number capnp.Client
+
+// Number_TypeID is the unique identifier for the type Number.
+const Number_TypeID = 0xf178dbcbafbf3396
+
+func (c Number) Add(ctx context.Context, params func(Number_add_Params) error) (Number_add_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xf178dbcbafbf3396,
+			MethodID:      0,
+			InterfaceName: "structdef.capnp:Number",
+			MethodName:    "add",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Number_add_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return Number_add_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c Number) Get(ctx context.Context, params func(Number_get_Params) error) (Number_get_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xf178dbcbafbf3396,
+			MethodID:      1,
+			InterfaceName: "structdef.capnp:Number",
+			MethodName:    "get",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Number_get_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return Number_get_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c Number) WaitStreaming() error {
+	return capnp.Client(c).WaitStreaming()
+}
+
+// String returns a string that identifies this capability for debugging
+// purposes.  Its format should not be depended on: in particular, it
+// should not be used to compare clients.  Use IsSame to compare clients
+// for equality.
+func (c Number) String() string {
+	return "Number(" + capnp.Client(c).String() + ")"
+}
+
+// AddRef creates a new Client that refers to the same capability as c.
+// If c is nil or has resolved to null, then AddRef returns nil.
+func (c Number) AddRef() Number {
+	return Number(capnp.Client(c).AddRef())
+}
+
+// Release releases a capability reference.  If this is the last
+// reference to the capability, then the underlying resources associated
+// with the capability will be released.
+//
+// Release will panic if c has already been released, but not if c is
+// nil or resolved to null.
+func (c Number) Release() {
+	capnp.Client(c).Release()
+}
+
+// Resolve blocks until the capability is fully resolved or the Context
+// expires.
+func (c Number) Resolve(ctx context.Context) error {
+	return capnp.Client(c).Resolve(ctx)
+}
+
+func (c Number) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Client(c).EncodeAsPtr(seg)
+}
+
+func (Number) DecodeFromPtr(p capnp.Ptr) Number {
+	return Number(capnp.Client{}.DecodeFromPtr(p))
+}
+
+// IsValid reports whether c is a valid reference to a capability.
+// A reference is invalid if it is nil, has resolved to null, or has
+// been released.
+func (c Number) IsValid() bool {
+	return capnp.Client(c).IsValid()
+}
+
+// IsSame reports whether c and other refer to a capability created by the
+// same call to NewClient.  This can return false negatives if c or other
+// are not fully resolved: use Resolve if this is an issue.  If either
+// c or other are released, then IsSame panics.
+func (c Number) IsSame(other Number) bool {
+	return capnp.Client(c).IsSame(capnp.Client(other))
+}
+
+// Update the flowcontrol.FlowLimiter used to manage flow control for
+// this client. This affects all future calls, but not calls already
+// waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
+// which is also the default.
+func (c Number) SetFlowLimiter(lim fc.FlowLimiter) {
+	capnp.Client(c).SetFlowLimiter(lim)
+}
+
+// Get the current flowcontrol.FlowLimiter used to manage flow control
+// for this client.
+func (c Number) GetFlowLimiter() fc.FlowLimiter {
+	return capnp.Client(c).GetFlowLimiter()
+}
+
+// A Number_Server is a Number with a local implementation.
+type Number_Server interface {
+	Add(context.Context, Number_add) error
+
+	Get(context.Context, Number_get) error
+}
+
+// Number_NewServer creates a new Server from an implementation of Number_Server.
+func Number_NewServer(s Number_Server) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(Number_Methods(nil, s), s, c)
+}
+
+// Number_ServerToClient creates a new Client from an implementation of Number_Server.
+// The caller is responsible for calling Release on the returned Client.
+func Number_ServerToClient(s Number_Server) Number {
+	return Number(capnp.NewClient(Number_NewServer(s)))
+}
+
+// Number_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func Number_Methods(methods []server.Method, s Number_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 2)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xf178dbcbafbf3396,
+			MethodID:      0,
+			InterfaceName: "structdef.capnp:Number",
+			MethodName:    "add",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Add(ctx, Number_add{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xf178dbcbafbf3396,
+			MethodID:      1,
+			InterfaceName: "structdef.capnp:Number",
+			MethodName:    "get",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Get(ctx, Number_get{call})
+		},
+	})
+
+	return methods
+}
+
+// Number_add holds the state for a server call to Number.add.
+// See server.Call for documentation.
+type Number_add struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Number_add) Args() Number_add_Params {
+	return Number_add_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c Number_add) AllocResults() (Number_add_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Number_add_Results(r), err
+}
+
+// Number_get holds the state for a server call to Number.get.
+// See server.Call for documentation.
+type Number_get struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Number_get) Args() Number_get_Params {
+	return Number_get_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c Number_get) AllocResults() (Number_get_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Number_get_Results(r), err
+}
+
+// Number_List is a list of Number.
+type Number_List = capnp.CapList[Number]
+
+// NewNumber creates a new list of Number.
+func NewNumber_List(s *capnp.Segment, sz int32) (Number_List, error) {
+	l, err := capnp.NewPointerList(s, sz)
+	return capnp.CapList[Number](l), err
+}
+
+type Number_add_Params capnp.Struct
+
+// Number_add_Params_TypeID is the unique identifier for the type Number_add_Params.
+const Number_add_Params_TypeID = 0x8d595cc0fafc7904
+
+func NewNumber_add_Params(s *capnp.Segment) (Number_add_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Number_add_Params(st), err
+}
+
+func NewRootNumber_add_Params(s *capnp.Segment) (Number_add_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Number_add_Params(st), err
+}
+
+func ReadRootNumber_add_Params(msg *capnp.Message) (Number_add_Params, error) {
+	root, err := msg.Root()
+	return Number_add_Params(root.Struct()), err
+}
+
+func (s Number_add_Params) String() string {
+	str, _ := text.Marshal(0x8d595cc0fafc7904, capnp.Struct(s))
+	return str
+}
+
+func (s Number_add_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Number_add_Params) DecodeFromPtr(p capnp.Ptr) Number_add_Params {
+	return Number_add_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Number_add_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Number_add_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Number_add_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Number_add_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Number_add_Params) Delta() int64 {
+	return int64(capnp.Struct(s).Uint64(0))
+}
+
+func (s Number_add_Params) SetDelta(v int64) {
+	capnp.Struct(s).SetUint64(0, uint64(v))
+}
+
+// Number_add_Params_List is a list of Number_add_Params.
+type Number_add_Params_List = capnp.StructList[Number_add_Params]
+
+// NewNumber_add_Params creates a new list of Number_add_Params.
+func NewNumber_add_Params_List(s *capnp.Segment, sz int32) (Number_add_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[Number_add_Params](l), err
+}
+
+// Number_add_Params_Future is a wrapper for a Number_add_Params promised by a client call.
+type Number_add_Params_Future struct{ *capnp.Future }
+
+func (f Number_add_Params_Future) Struct() (Number_add_Params, error) {
+	p, err := f.Future.Ptr()
+	return Number_add_Params(p.Struct()), err
+}
+
+type Number_add_Results capnp.Struct
+
+// Number_add_Results_TypeID is the unique identifier for the type Number_add_Results.
+const Number_add_Results_TypeID = 0xbe2c4cfda89bdaf7
+
+func NewNumber_add_Results(s *capnp.Segment) (Number_add_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Number_add_Results(st), err
+}
+
+func NewRootNumber_add_Results(s *capnp.Segment) (Number_add_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Number_add_Results(st), err
+}
+
+func ReadRootNumber_add_Results(msg *capnp.Message) (Number_add_Results, error) {
+	root, err := msg.Root()
+	return Number_add_Results(root.Struct()), err
+}
+
+func (s Number_add_Results) String() string {
+	str, _ := text.Marshal(0xbe2c4cfda89bdaf7, capnp.Struct(s))
+	return str
+}
+
+func (s Number_add_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Number_add_Results) DecodeFromPtr(p capnp.Ptr) Number_add_Results {
+	return Number_add_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Number_add_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Number_add_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Number_add_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Number_add_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Number_add_Results) Res() Number {
+	p, _ := capnp.Struct(s).Ptr(0)
+	return Number(p.Interface().Client())
+}
+
+func (s Number_add_Results) HasRes() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Number_add_Results) SetRes(v Number) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(0, in.ToPtr())
+}
+
+// Number_add_Results_List is a list of Number_add_Results.
+type Number_add_Results_List = capnp.StructList[Number_add_Results]
+
+// NewNumber_add_Results creates a new list of Number_add_Results.
+func NewNumber_add_Results_List(s *capnp.Segment, sz int32) (Number_add_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Number_add_Results](l), err
+}
+
+// Number_add_Results_Future is a wrapper for a Number_add_Results promised by a client call.
+type Number_add_Results_Future struct{ *capnp.Future }
+
+func (f Number_add_Results_Future) Struct() (Number_add_Results, error) {
+	p, err := f.Future.Ptr()
+	return Number_add_Results(p.Struct()), err
+}
+func (p Number_add_Results_Future) Res() Number {
+	return Number(p.Future.Field(0, nil).Client())
+}
+
+type Number_get_Params capnp.Struct
+
+// Number_get_Params_TypeID is the unique identifier for the type Number_get_Params.
+const Number_get_Params_TypeID = 0xbe76139b636fab9d
+
+func NewNumber_get_Params(s *capnp.Segment) (Number_get_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Number_get_Params(st), err
+}
+
+func NewRootNumber_get_Params(s *capnp.Segment) (Number_get_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Number_get_Params(st), err
+}
+
+func ReadRootNumber_get_Params(msg *capnp.Message) (Number_get_Params, error) {
+	root, err := msg.Root()
+	return Number_get_Params(root.Struct()), err
+}
+
+func (s Number_get_Params) String() string {
+	str, _ := text.Marshal(0xbe76139b636fab9d, capnp.Struct(s))
+	return str
+}
+
+func (s Number_get_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Number_get_Params) DecodeFromPtr(p capnp.Ptr) Number_get_Params {
+	return Number_get_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Number_get_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Number_get_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Number_get_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Number_get_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// Number_get_Params_List is a list of Number_get_Params.
+type Number_get_Params_List = capnp.StructList[Number_get_Params]
+
+// NewNumber_get_Params creates a new list of Number_get_Params.
+func NewNumber_get_Params_List(s *capnp.Segment, sz int32) (Number_get_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Number_get_Params](l), err
+}
+
+// Number_get_Params_Future is a wrapper for a Number_get_Params promised by a client call.
+type Number_get_Params_Future struct{ *capnp.Future }
+
+func (f Number_get_Params_Future) Struct() (Number_get_Params, error) {
+	p, err := f.Future.Ptr()
+	return Number_get_Params(p.Struct()), err
+}
+
+type Number_get_Results capnp.Struct
+
+// Number_get_Results_TypeID is the unique identifier for the type Number_get_Results.
+const Number_get_Results_TypeID = 0xf28e1730db398e92
+
+func NewNumber_get_Results(s *capnp.Segment) (Number_get_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Number_get_Results(st), err
+}
+
+func NewRootNumber_get_Results(s *capnp.Segment) (Number_get_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Number_get_Results(st), err
+}
+
+func ReadRootNumber_get_Results(msg *capnp.Message) (Number_get_Results, error) {
+	root, err := msg.Root()
+	return Number_get_Results(root.Struct()), err
+}
+
+func (s Number_get_Results) String() string {
+	str, _ := text.Marshal(0xf28e1730db398e92, capnp.Struct(s))
+	return str
+}
+
+func (s Number_get_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Number_get_Results) DecodeFromPtr(p capnp.Ptr) Number_get_Results {
+	return Number_get_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Number_get_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Number_get_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Number_get_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Number_get_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Number_get_Results) Value() int64 {
+	return int64(capnp.Struct(s).Uint64(0))
+}
+
+func (s Number_get_Results) SetValue(v int64) {
+	capnp.Struct(s).SetUint64(0, uint64(v))
+}
+
+// Number_get_Results_List is a list of Number_get_Results.
+type Number_get_Results_List = capnp.StructList[Number_get_Results]
+
+// NewNumber_get_Results creates a new list of Number_get_Results.
+func NewNumber_get_Results_List(s *capnp.Segment, sz int32) (Number_get_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[Number_get_Results](l), err
+}
+
+// Number_get_Results_Future is a wrapper for a Number_get_Results promised by a client call.
+type Number_get_Results_Future struct{ *capnp.Future }
+
+func (f Number_get_Results_Future) Struct() (Number_get_Results, error) {
+	p, err := f.Future.Ptr()
+	return Number_get_Results(p.Struct()), err
+}
+
+type CallbackTarget capnp.Client
+
+// CallbackTarget_TypeID is the unique identifier for the type CallbackTarget.
+const CallbackTarget_TypeID = 0xeb01eaec40fc3353
+
+func (c CallbackTarget) Call(ctx context.Context, params func(CallbackTarget_call_Params) error) (CallbackTarget_call_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xeb01eaec40fc3353,
+			MethodID:      0,
+			InterfaceName: "structdef.capnp:CallbackTarget",
+			MethodName:    "call",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(CallbackTarget_call_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return CallbackTarget_call_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c CallbackTarget) WaitStreaming() error {
+	return capnp.Client(c).WaitStreaming()
+}
+
+// String returns a string that identifies this capability for debugging
+// purposes.  Its format should not be depended on: in particular, it
+// should not be used to compare clients.  Use IsSame to compare clients
+// for equality.
+func (c CallbackTarget) String() string {
+	return "CallbackTarget(" + capnp.Client(c).String() + ")"
+}
+
+// AddRef creates a new Client that refers to the same capability as c.
+// If c is nil or has resolved to null, then AddRef returns nil.
+func (c CallbackTarget) AddRef() CallbackTarget {
+	return CallbackTarget(capnp.Client(c).AddRef())
+}
+
+// Release releases a capability reference.  If this is the last
+// reference to the capability, then the underlying resources associated
+// with the capability will be released.
+//
+// Release will panic if c has already been released, but not if c is
+// nil or resolved to null.
+func (c CallbackTarget) Release() {
+	capnp.Client(c).Release()
+}
+
+// Resolve blocks until the capability is fully resolved or the Context
+// expires.
+func (c CallbackTarget) Resolve(ctx context.Context) error {
+	return capnp.Client(c).Resolve(ctx)
+}
+
+func (c CallbackTarget) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Client(c).EncodeAsPtr(seg)
+}
+
+func (CallbackTarget) DecodeFromPtr(p capnp.Ptr) CallbackTarget {
+	return CallbackTarget(capnp.Client{}.DecodeFromPtr(p))
+}
+
+// IsValid reports whether c is a valid reference to a capability.
+// A reference is invalid if it is nil, has resolved to null, or has
+// been released.
+func (c CallbackTarget) IsValid() bool {
+	return capnp.Client(c).IsValid()
+}
+
+// IsSame reports whether c and other refer to a capability created by the
+// same call to NewClient.  This can return false negatives if c or other
+// are not fully resolved: use Resolve if this is an issue.  If either
+// c or other are released, then IsSame panics.
+func (c CallbackTarget) IsSame(other CallbackTarget) bool {
+	return capnp.Client(c).IsSame(capnp.Client(other))
+}
+
+// Update the flowcontrol.FlowLimiter used to manage flow control for
+// this client. This affects all future calls, but not calls already
+// waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
+// which is also the default.
+func (c CallbackTarget) SetFlowLimiter(lim fc.FlowLimiter) {
+	capnp.Client(c).SetFlowLimiter(lim)
+}
+
+// Get the current flowcontrol.FlowLimiter used to manage flow control
+// for this client.
+func (c CallbackTarget) GetFlowLimiter() fc.FlowLimiter {
+	return capnp.Client(c).GetFlowLimiter()
+}
+
+// A CallbackTarget_Server is a CallbackTarget with a local implementation.
+type CallbackTarget_Server interface {
+	Call(context.Context, CallbackTarget_call) error
+}
+
+// CallbackTarget_NewServer creates a new Server from an implementation of CallbackTarget_Server.
+func CallbackTarget_NewServer(s CallbackTarget_Server) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(CallbackTarget_Methods(nil, s), s, c)
+}
+
+// CallbackTarget_ServerToClient creates a new Client from an implementation of CallbackTarget_Server.
+// The caller is responsible for calling Release on the returned Client.
+func CallbackTarget_ServerToClient(s CallbackTarget_Server) CallbackTarget {
+	return CallbackTarget(capnp.NewClient(CallbackTarget_NewServer(s)))
+}
+
+// CallbackTarget_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func CallbackTarget_Methods(methods []server.Method, s CallbackTarget_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 1)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xeb01eaec40fc3353,
+			MethodID:      0,
+			InterfaceName: "structdef.capnp:CallbackTarget",
+			MethodName:    "call",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Call(ctx, CallbackTarget_call{call})
+		},
+	})
+
+	return methods
+}
+
+// CallbackTarget_call holds the state for a server call to CallbackTarget.call.
+// See server.Call for documentation.
+type CallbackTarget_call struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c CallbackTarget_call) Args() CallbackTarget_call_Params {
+	return CallbackTarget_call_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c CallbackTarget_call) AllocResults() (CallbackTarget_call_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return CallbackTarget_call_Results(r), err
+}
+
+// CallbackTarget_List is a list of CallbackTarget.
+type CallbackTarget_List = capnp.CapList[CallbackTarget]
+
+// NewCallbackTarget creates a new list of CallbackTarget.
+func NewCallbackTarget_List(s *capnp.Segment, sz int32) (CallbackTarget_List, error) {
+	l, err := capnp.NewPointerList(s, sz)
+	return capnp.CapList[CallbackTarget](l), err
+}
+
+type CallbackTarget_call_Params capnp.Struct
+
+// CallbackTarget_call_Params_TypeID is the unique identifier for the type CallbackTarget_call_Params.
+const CallbackTarget_call_Params_TypeID = 0xb6abb79158c9c1ef
+
+func NewCallbackTarget_call_Params(s *capnp.Segment) (CallbackTarget_call_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return CallbackTarget_call_Params(st), err
+}
+
+func NewRootCallbackTarget_call_Params(s *capnp.Segment) (CallbackTarget_call_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return CallbackTarget_call_Params(st), err
+}
+
+func ReadRootCallbackTarget_call_Params(msg *capnp.Message) (CallbackTarget_call_Params, error) {
+	root, err := msg.Root()
+	return CallbackTarget_call_Params(root.Struct()), err
+}
+
+func (s CallbackTarget_call_Params) String() string {
+	str, _ := text.Marshal(0xb6abb79158c9c1ef, capnp.Struct(s))
+	return str
+}
+
+func (s CallbackTarget_call_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (CallbackTarget_call_Params) DecodeFromPtr(p capnp.Ptr) CallbackTarget_call_Params {
+	return CallbackTarget_call_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s CallbackTarget_call_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s CallbackTarget_call_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s CallbackTarget_call_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s CallbackTarget_call_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s CallbackTarget_call_Params) Value() int64 {
+	return int64(capnp.Struct(s).Uint64(0))
+}
+
+func (s CallbackTarget_call_Params) SetValue(v int64) {
+	capnp.Struct(s).SetUint64(0, uint64(v))
+}
+
+// CallbackTarget_call_Params_List is a list of CallbackTarget_call_Params.
+type CallbackTarget_call_Params_List = capnp.StructList[CallbackTarget_call_Params]
+
+// NewCallbackTarget_call_Params creates a new list of CallbackTarget_call_Params.
+func NewCallbackTarget_call_Params_List(s *capnp.Segment, sz int32) (CallbackTarget_call_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[CallbackTarget_call_Params](l), err
+}
+
+// CallbackTarget_call_Params_Future is a wrapper for a CallbackTarget_call_Params promised by a client call.
+type CallbackTarget_call_Params_Future struct{ *capnp.Future }
+
+func (f CallbackTarget_call_Params_Future) Struct() (CallbackTarget_call_Params, error) {
+	p, err := f.Future.Ptr()
+	return CallbackTarget_call_Params(p.Struct()), err
+}
+
+type CallbackTarget_call_Results capnp.Struct
+
+// CallbackTarget_call_Results_TypeID is the unique identifier for the type CallbackTarget_call_Results.
+const CallbackTarget_call_Results_TypeID = 0x88e76758377ffbbb
+
+func NewCallbackTarget_call_Results(s *capnp.Segment) (CallbackTarget_call_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return CallbackTarget_call_Results(st), err
+}
+
+func NewRootCallbackTarget_call_Results(s *capnp.Segment) (CallbackTarget_call_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return CallbackTarget_call_Results(st), err
+}
+
+func ReadRootCallbackTarget_call_Results(msg *capnp.Message) (CallbackTarget_call_Results, error) {
+	root, err := msg.Root()
+	return CallbackTarget_call_Results(root.Struct()), err
+}
+
+func (s CallbackTarget_call_Results) String() string {
+	str, _ := text.Marshal(0x88e76758377ffbbb, capnp.Struct(s))
+	return str
+}
+
+func (s CallbackTarget_call_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (CallbackTarget_call_Results) DecodeFromPtr(p capnp.Ptr) CallbackTarget_call_Results {
+	return CallbackTarget_call_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s CallbackTarget_call_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s CallbackTarget_call_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s CallbackTarget_call_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s CallbackTarget_call_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s CallbackTarget_call_Results) Res() int64 {
+	return int64(capnp.Struct(s).Uint64(0))
+}
+
+func (s CallbackTarget_call_Results) SetRes(v int64) {
+	capnp.Struct(s).SetUint64(0, uint64(v))
+}
+
+// CallbackTarget_call_Results_List is a list of CallbackTarget_call_Results.
+type CallbackTarget_call_Results_List = capnp.StructList[CallbackTarget_call_Results]
+
+// NewCallbackTarget_call_Results creates a new list of CallbackTarget_call_Results.
+func NewCallbackTarget_call_Results_List(s *capnp.Segment, sz int32) (CallbackTarget_call_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[CallbackTarget_call_Results](l), err
+}
+
+// CallbackTarget_call_Results_Future is a wrapper for a CallbackTarget_call_Results promised by a client call.
+type CallbackTarget_call_Results_Future struct{ *capnp.Future }
+
+func (f CallbackTarget_call_Results_Future) Struct() (CallbackTarget_call_Results, error) {
+	p, err := f.Future.Ptr()
+	return CallbackTarget_call_Results(p.Struct()), err
+}
+
 type API capnp.Client
 
-// API_TypeID is the unique identifier for the type API.
-const API_TypeID = 0xfea5a1579f2ffa9e
+// API_TypeID is the unique identifier for the type API.
+const API_TypeID = 0xfea5a1579f2ffa9e
+
+func (c API) Nop(ctx context.Context, params func(API_nop_Params) error) (API_nop_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xfea5a1579f2ffa9e,
+			MethodID:      0,
+			InterfaceName: "structdef.capnp:API",
+			MethodName:    "nop",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(API_nop_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return API_nop_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c API) Add(ctx context.Context, params func(API_add_Params) error) (API_add_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xfea5a1579f2ffa9e,
+			MethodID:      1,
+			InterfaceName: "structdef.capnp:API",
+			MethodName:    "add",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 16, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(API_add_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return API_add_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c API) MultTree(ctx context.Context, params func(API_multTree_Params) error) (API_multTree_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xfea5a1579f2ffa9e,
+			MethodID:      2,
+			InterfaceName: "structdef.capnp:API",
+			MethodName:    "multTree",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(API_multTree_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return API_multTree_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c API) ToHex(ctx context.Context, params func(API_toHex_Params) error) (API_toHex_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xfea5a1579f2ffa9e,
+			MethodID:      3,
+			InterfaceName: "structdef.capnp:API",
+			MethodName:    "toHex",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(API_toHex_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return API_toHex_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c API) ServerStream(ctx context.Context, params func(API_serverStream_Params) error) (API_serverStream_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xfea5a1579f2ffa9e,
+			MethodID:      4,
+			InterfaceName: "structdef.capnp:API",
+			MethodName:    "serverStream",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 16, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(API_serverStream_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return API_serverStream_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c API) ClientStream(ctx context.Context, params func(API_clientStream_Params) error) (API_clientStream_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xfea5a1579f2ffa9e,
+			MethodID:      5,
+			InterfaceName: "structdef.capnp:API",
+			MethodName:    "clientStream",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(API_clientStream_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return API_clientStream_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c API) NewNumber(ctx context.Context, params func(API_newNumber_Params) error) (API_newNumber_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xfea5a1579f2ffa9e,
+			MethodID:      6,
+			InterfaceName: "structdef.capnp:API",
+			MethodName:    "newNumber",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(API_newNumber_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return API_newNumber_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c API) Callback(ctx context.Context, params func(API_callback_Params) error) (API_callback_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xfea5a1579f2ffa9e,
+			MethodID:      7,
+			InterfaceName: "structdef.capnp:API",
+			MethodName:    "callback",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 16, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(API_callback_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return API_callback_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c API) Validate(ctx context.Context, params func(API_validate_Params) error) (API_validate_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xfea5a1579f2ffa9e,
+			MethodID:      8,
+			InterfaceName: "structdef.capnp:API",
+			MethodName:    "validate",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 16, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(API_validate_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return API_validate_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c API) Slow(ctx context.Context, params func(API_slow_Params) error) (API_slow_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xfea5a1579f2ffa9e,
+			MethodID:      9,
+			InterfaceName: "structdef.capnp:API",
+			MethodName:    "slow",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(API_slow_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return API_slow_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c API) SlowCalls(ctx context.Context, params func(API_slowCalls_Params) error) (API_slowCalls_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xfea5a1579f2ffa9e,
+			MethodID:      10,
+			InterfaceName: "structdef.capnp:API",
+			MethodName:    "slowCalls",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(API_slowCalls_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return API_slowCalls_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c API) Work(ctx context.Context, params func(API_work_Params) error) (API_work_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xfea5a1579f2ffa9e,
+			MethodID:      11,
+			InterfaceName: "structdef.capnp:API",
+			MethodName:    "work",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 16, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(API_work_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return API_work_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c API) Echo(ctx context.Context, params func(API_echo_Params) error) (API_echo_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xfea5a1579f2ffa9e,
+			MethodID:      12,
+			InterfaceName: "structdef.capnp:API",
+			MethodName:    "echo",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(API_echo_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return API_echo_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c API) UpperRecords(ctx context.Context, params func(API_upperRecords_Params) error) (API_upperRecords_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xfea5a1579f2ffa9e,
+			MethodID:      13,
+			InterfaceName: "structdef.capnp:API",
+			MethodName:    "upperRecords",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(API_upperRecords_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return API_upperRecords_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c API) UpdateRows(ctx context.Context, params func(API_updateRows_Params) error) (API_updateRows_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xfea5a1579f2ffa9e,
+			MethodID:      14,
+			InterfaceName: "structdef.capnp:API",
+			MethodName:    "updateRows",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(API_updateRows_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return API_updateRows_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c API) IncrementMap(ctx context.Context, params func(API_incrementMap_Params) error) (API_incrementMap_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xfea5a1579f2ffa9e,
+			MethodID:      15,
+			InterfaceName: "structdef.capnp:API",
+			MethodName:    "incrementMap",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(API_incrementMap_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return API_incrementMap_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c API) ScaleVector(ctx context.Context, params func(API_scaleVector_Params) error) (API_scaleVector_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xfea5a1579f2ffa9e,
+			MethodID:      16,
+			InterfaceName: "structdef.capnp:API",
+			MethodName:    "scaleVector",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(API_scaleVector_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return API_scaleVector_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c API) NopWithMetadata(ctx context.Context, params func(API_nopWithMetadata_Params) error) (API_nopWithMetadata_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xfea5a1579f2ffa9e,
+			MethodID:      17,
+			InterfaceName: "structdef.capnp:API",
+			MethodName:    "nopWithMetadata",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(API_nopWithMetadata_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return API_nopWithMetadata_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c API) WaitStreaming() error {
+	return capnp.Client(c).WaitStreaming()
+}
+
+// String returns a string that identifies this capability for debugging
+// purposes.  Its format should not be depended on: in particular, it
+// should not be used to compare clients.  Use IsSame to compare clients
+// for equality.
+func (c API) String() string {
+	return "API(" + capnp.Client(c).String() + ")"
+}
+
+// AddRef creates a new Client that refers to the same capability as c.
+// If c is nil or has resolved to null, then AddRef returns nil.
+func (c API) AddRef() API {
+	return API(capnp.Client(c).AddRef())
+}
+
+// Release releases a capability reference.  If this is the last
+// reference to the capability, then the underlying resources associated
+// with the capability will be released.
+//
+// Release will panic if c has already been released, but not if c is
+// nil or resolved to null.
+func (c API) Release() {
+	capnp.Client(c).Release()
+}
+
+// Resolve blocks until the capability is fully resolved or the Context
+// expires.
+func (c API) Resolve(ctx context.Context) error {
+	return capnp.Client(c).Resolve(ctx)
+}
+
+func (c API) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Client(c).EncodeAsPtr(seg)
+}
+
+func (API) DecodeFromPtr(p capnp.Ptr) API {
+	return API(capnp.Client{}.DecodeFromPtr(p))
+}
+
+// IsValid reports whether c is a valid reference to a capability.
+// A reference is invalid if it is nil, has resolved to null, or has
+// been released.
+func (c API) IsValid() bool {
+	return capnp.Client(c).IsValid()
+}
+
+// IsSame reports whether c and other refer to a capability created by the
+// same call to NewClient.  This can return false negatives if c or other
+// are not fully resolved: use Resolve if this is an issue.  If either
+// c or other are released, then IsSame panics.
+func (c API) IsSame(other API) bool {
+	return capnp.Client(c).IsSame(capnp.Client(other))
+}
+
+// Update the flowcontrol.FlowLimiter used to manage flow control for
+// this client. This affects all future calls, but not calls already
+// waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
+// which is also the default.
+func (c API) SetFlowLimiter(lim fc.FlowLimiter) {
+	capnp.Client(c).SetFlowLimiter(lim)
+}
+
+// Get the current flowcontrol.FlowLimiter used to manage flow control
+// for this client.
+func (c API) GetFlowLimiter() fc.FlowLimiter {
+	return capnp.Client(c).GetFlowLimiter()
+}
+
+// A API_Server is a API with a local implementation.
+type API_Server interface {
+	Nop(context.Context, API_nop) error
+
+	Add(context.Context, API_add) error
+
+	MultTree(context.Context, API_multTree) error
+
+	ToHex(context.Context, API_toHex) error
+
+	ServerStream(context.Context, API_serverStream) error
+
+	ClientStream(context.Context, API_clientStream) error
+
+	NewNumber(context.Context, API_newNumber) error
+
+	Callback(context.Context, API_callback) error
+
+	Validate(context.Context, API_validate) error
+
+	Slow(context.Context, API_slow) error
+
+	SlowCalls(context.Context, API_slowCalls) error
+
+	Work(context.Context, API_work) error
+
+	Echo(context.Context, API_echo) error
+
+	UpperRecords(context.Context, API_upperRecords) error
+
+	UpdateRows(context.Context, API_updateRows) error
+
+	IncrementMap(context.Context, API_incrementMap) error
+
+	ScaleVector(context.Context, API_scaleVector) error
+
+	NopWithMetadata(context.Context, API_nopWithMetadata) error
+}
+
+// API_NewServer creates a new Server from an implementation of API_Server.
+func API_NewServer(s API_Server) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(API_Methods(nil, s), s, c)
+}
+
+// API_ServerToClient creates a new Client from an implementation of API_Server.
+// The caller is responsible for calling Release on the returned Client.
+func API_ServerToClient(s API_Server) API {
+	return API(capnp.NewClient(API_NewServer(s)))
+}
+
+// API_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func API_Methods(methods []server.Method, s API_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 18)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xfea5a1579f2ffa9e,
+			MethodID:      0,
+			InterfaceName: "structdef.capnp:API",
+			MethodName:    "nop",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Nop(ctx, API_nop{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xfea5a1579f2ffa9e,
+			MethodID:      1,
+			InterfaceName: "structdef.capnp:API",
+			MethodName:    "add",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Add(ctx, API_add{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xfea5a1579f2ffa9e,
+			MethodID:      2,
+			InterfaceName: "structdef.capnp:API",
+			MethodName:    "multTree",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.MultTree(ctx, API_multTree{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xfea5a1579f2ffa9e,
+			MethodID:      3,
+			InterfaceName: "structdef.capnp:API",
+			MethodName:    "toHex",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.ToHex(ctx, API_toHex{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xfea5a1579f2ffa9e,
+			MethodID:      4,
+			InterfaceName: "structdef.capnp:API",
+			MethodName:    "serverStream",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.ServerStream(ctx, API_serverStream{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xfea5a1579f2ffa9e,
+			MethodID:      5,
+			InterfaceName: "structdef.capnp:API",
+			MethodName:    "clientStream",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.ClientStream(ctx, API_clientStream{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xfea5a1579f2ffa9e,
+			MethodID:      6,
+			InterfaceName: "structdef.capnp:API",
+			MethodName:    "newNumber",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.NewNumber(ctx, API_newNumber{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xfea5a1579f2ffa9e,
+			MethodID:      7,
+			InterfaceName: "structdef.capnp:API",
+			MethodName:    "callback",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Callback(ctx, API_callback{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xfea5a1579f2ffa9e,
+			MethodID:      8,
+			InterfaceName: "structdef.capnp:API",
+			MethodName:    "validate",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Validate(ctx, API_validate{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xfea5a1579f2ffa9e,
+			MethodID:      9,
+			InterfaceName: "structdef.capnp:API",
+			MethodName:    "slow",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Slow(ctx, API_slow{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xfea5a1579f2ffa9e,
+			MethodID:      10,
+			InterfaceName: "structdef.capnp:API",
+			MethodName:    "slowCalls",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.SlowCalls(ctx, API_slowCalls{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xfea5a1579f2ffa9e,
+			MethodID:      11,
+			InterfaceName: "structdef.capnp:API",
+			MethodName:    "work",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Work(ctx, API_work{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xfea5a1579f2ffa9e,
+			MethodID:      12,
+			InterfaceName: "structdef.capnp:API",
+			MethodName:    "echo",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Echo(ctx, API_echo{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xfea5a1579f2ffa9e,
+			MethodID:      13,
+			InterfaceName: "structdef.capnp:API",
+			MethodName:    "upperRecords",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.UpperRecords(ctx, API_upperRecords{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xfea5a1579f2ffa9e,
+			MethodID:      14,
+			InterfaceName: "structdef.capnp:API",
+			MethodName:    "updateRows",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.UpdateRows(ctx, API_updateRows{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xfea5a1579f2ffa9e,
+			MethodID:      15,
+			InterfaceName: "structdef.capnp:API",
+			MethodName:    "incrementMap",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.IncrementMap(ctx, API_incrementMap{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xfea5a1579f2ffa9e,
+			MethodID:      16,
+			InterfaceName: "structdef.capnp:API",
+			MethodName:    "scaleVector",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.ScaleVector(ctx, API_scaleVector{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xfea5a1579f2ffa9e,
+			MethodID:      17,
+			InterfaceName: "structdef.capnp:API",
+			MethodName:    "nopWithMetadata",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.NopWithMetadata(ctx, API_nopWithMetadata{call})
+		},
+	})
+
+	return methods
+}
+
+// API_nop holds the state for a server call to API.nop.
+// See server.Call for documentation.
+type API_nop struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c API_nop) Args() API_nop_Params {
+	return API_nop_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c API_nop) AllocResults() (API_nop_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return API_nop_Results(r), err
+}
+
+// API_add holds the state for a server call to API.add.
+// See server.Call for documentation.
+type API_add struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c API_add) Args() API_add_Params {
+	return API_add_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c API_add) AllocResults() (API_add_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return API_add_Results(r), err
+}
+
+// API_multTree holds the state for a server call to API.multTree.
+// See server.Call for documentation.
+type API_multTree struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c API_multTree) Args() API_multTree_Params {
+	return API_multTree_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c API_multTree) AllocResults() (API_multTree_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return API_multTree_Results(r), err
+}
+
+// API_toHex holds the state for a server call to API.toHex.
+// See server.Call for documentation.
+type API_toHex struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c API_toHex) Args() API_toHex_Params {
+	return API_toHex_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c API_toHex) AllocResults() (API_toHex_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return API_toHex_Results(r), err
+}
+
+// API_serverStream holds the state for a server call to API.serverStream.
+// See server.Call for documentation.
+type API_serverStream struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c API_serverStream) Args() API_serverStream_Params {
+	return API_serverStream_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c API_serverStream) AllocResults() (API_serverStream_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return API_serverStream_Results(r), err
+}
+
+// API_clientStream holds the state for a server call to API.clientStream.
+// See server.Call for documentation.
+type API_clientStream struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c API_clientStream) Args() API_clientStream_Params {
+	return API_clientStream_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c API_clientStream) AllocResults() (API_clientStream_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return API_clientStream_Results(r), err
+}
+
+// API_newNumber holds the state for a server call to API.newNumber.
+// See server.Call for documentation.
+type API_newNumber struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c API_newNumber) Args() API_newNumber_Params {
+	return API_newNumber_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c API_newNumber) AllocResults() (API_newNumber_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return API_newNumber_Results(r), err
+}
+
+// API_callback holds the state for a server call to API.callback.
+// See server.Call for documentation.
+type API_callback struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c API_callback) Args() API_callback_Params {
+	return API_callback_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c API_callback) AllocResults() (API_callback_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return API_callback_Results(r), err
+}
+
+// API_validate holds the state for a server call to API.validate.
+// See server.Call for documentation.
+type API_validate struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c API_validate) Args() API_validate_Params {
+	return API_validate_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c API_validate) AllocResults() (API_validate_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return API_validate_Results(r), err
+}
+
+// API_slow holds the state for a server call to API.slow.
+// See server.Call for documentation.
+type API_slow struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c API_slow) Args() API_slow_Params {
+	return API_slow_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c API_slow) AllocResults() (API_slow_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return API_slow_Results(r), err
+}
+
+// API_slowCalls holds the state for a server call to API.slowCalls.
+// See server.Call for documentation.
+type API_slowCalls struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c API_slowCalls) Args() API_slowCalls_Params {
+	return API_slowCalls_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c API_slowCalls) AllocResults() (API_slowCalls_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return API_slowCalls_Results(r), err
+}
+
+// API_work holds the state for a server call to API.work.
+// See server.Call for documentation.
+type API_work struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c API_work) Args() API_work_Params {
+	return API_work_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c API_work) AllocResults() (API_work_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return API_work_Results(r), err
+}
+
+// API_echo holds the state for a server call to API.echo.
+// See server.Call for documentation.
+type API_echo struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c API_echo) Args() API_echo_Params {
+	return API_echo_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c API_echo) AllocResults() (API_echo_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return API_echo_Results(r), err
+}
+
+// API_upperRecords holds the state for a server call to API.upperRecords.
+// See server.Call for documentation.
+type API_upperRecords struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c API_upperRecords) Args() API_upperRecords_Params {
+	return API_upperRecords_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c API_upperRecords) AllocResults() (API_upperRecords_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return API_upperRecords_Results(r), err
+}
+
+// API_updateRows holds the state for a server call to API.updateRows.
+// See server.Call for documentation.
+type API_updateRows struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c API_updateRows) Args() API_updateRows_Params {
+	return API_updateRows_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c API_updateRows) AllocResults() (API_updateRows_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return API_updateRows_Results(r), err
+}
+
+// API_incrementMap holds the state for a server call to API.incrementMap.
+// See server.Call for documentation.
+type API_incrementMap struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c API_incrementMap) Args() API_incrementMap_Params {
+	return API_incrementMap_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c API_incrementMap) AllocResults() (API_incrementMap_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return API_incrementMap_Results(r), err
+}
+
+// API_scaleVector holds the state for a server call to API.scaleVector.
+// See server.Call for documentation.
+type API_scaleVector struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c API_scaleVector) Args() API_scaleVector_Params {
+	return API_scaleVector_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c API_scaleVector) AllocResults() (API_scaleVector_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return API_scaleVector_Results(r), err
+}
+
+// API_nopWithMetadata holds the state for a server call to API.nopWithMetadata.
+// See server.Call for documentation.
+type API_nopWithMetadata struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c API_nopWithMetadata) Args() API_nopWithMetadata_Params {
+	return API_nopWithMetadata_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c API_nopWithMetadata) AllocResults() (API_nopWithMetadata_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return API_nopWithMetadata_Results(r), err
+}
+
+// API_List is a list of API.
+type API_List = capnp.CapList[API]
+
+// NewAPI creates a new list of API.
+func NewAPI_List(s *capnp.Segment, sz int32) (API_List, error) {
+	l, err := capnp.NewPointerList(s, sz)
+	return capnp.CapList[API](l), err
+}
+
+type API_nop_Params capnp.Struct
+
+// API_nop_Params_TypeID is the unique identifier for the type API_nop_Params.
+const API_nop_Params_TypeID = 0xf9d58e206a93eb9c
+
+func NewAPI_nop_Params(s *capnp.Segment) (API_nop_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return API_nop_Params(st), err
+}
+
+func NewRootAPI_nop_Params(s *capnp.Segment) (API_nop_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return API_nop_Params(st), err
+}
+
+func ReadRootAPI_nop_Params(msg *capnp.Message) (API_nop_Params, error) {
+	root, err := msg.Root()
+	return API_nop_Params(root.Struct()), err
+}
+
+func (s API_nop_Params) String() string {
+	str, _ := text.Marshal(0xf9d58e206a93eb9c, capnp.Struct(s))
+	return str
+}
+
+func (s API_nop_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (API_nop_Params) DecodeFromPtr(p capnp.Ptr) API_nop_Params {
+	return API_nop_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s API_nop_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s API_nop_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s API_nop_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s API_nop_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// API_nop_Params_List is a list of API_nop_Params.
+type API_nop_Params_List = capnp.StructList[API_nop_Params]
+
+// NewAPI_nop_Params creates a new list of API_nop_Params.
+func NewAPI_nop_Params_List(s *capnp.Segment, sz int32) (API_nop_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[API_nop_Params](l), err
+}
+
+// API_nop_Params_Future is a wrapper for a API_nop_Params promised by a client call.
+type API_nop_Params_Future struct{ *capnp.Future }
+
+func (f API_nop_Params_Future) Struct() (API_nop_Params, error) {
+	p, err := f.Future.Ptr()
+	return API_nop_Params(p.Struct()), err
+}
+
+type API_nop_Results capnp.Struct
+
+// API_nop_Results_TypeID is the unique identifier for the type API_nop_Results.
+const API_nop_Results_TypeID = 0xfddd3379466d7927
+
+func NewAPI_nop_Results(s *capnp.Segment) (API_nop_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return API_nop_Results(st), err
+}
+
+func NewRootAPI_nop_Results(s *capnp.Segment) (API_nop_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return API_nop_Results(st), err
+}
+
+func ReadRootAPI_nop_Results(msg *capnp.Message) (API_nop_Results, error) {
+	root, err := msg.Root()
+	return API_nop_Results(root.Struct()), err
+}
+
+func (s API_nop_Results) String() string {
+	str, _ := text.Marshal(0xfddd3379466d7927, capnp.Struct(s))
+	return str
+}
+
+func (s API_nop_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (API_nop_Results) DecodeFromPtr(p capnp.Ptr) API_nop_Results {
+	return API_nop_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s API_nop_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s API_nop_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s API_nop_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s API_nop_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// API_nop_Results_List is a list of API_nop_Results.
+type API_nop_Results_List = capnp.StructList[API_nop_Results]
+
+// NewAPI_nop_Results creates a new list of API_nop_Results.
+func NewAPI_nop_Results_List(s *capnp.Segment, sz int32) (API_nop_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[API_nop_Results](l), err
+}
+
+// API_nop_Results_Future is a wrapper for a API_nop_Results promised by a client call.
+type API_nop_Results_Future struct{ *capnp.Future }
+
+func (f API_nop_Results_Future) Struct() (API_nop_Results, error) {
+	p, err := f.Future.Ptr()
+	return API_nop_Results(p.Struct()), err
+}
+
+type API_add_Params capnp.Struct
+
+// API_add_Params_TypeID is the unique identifier for the type API_add_Params.
+const API_add_Params_TypeID = 0xfbf5e01c6a8344e7
+
+func NewAPI_add_Params(s *capnp.Segment) (API_add_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return API_add_Params(st), err
+}
+
+func NewRootAPI_add_Params(s *capnp.Segment) (API_add_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return API_add_Params(st), err
+}
+
+func ReadRootAPI_add_Params(msg *capnp.Message) (API_add_Params, error) {
+	root, err := msg.Root()
+	return API_add_Params(root.Struct()), err
+}
+
+func (s API_add_Params) String() string {
+	str, _ := text.Marshal(0xfbf5e01c6a8344e7, capnp.Struct(s))
+	return str
+}
+
+func (s API_add_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (API_add_Params) DecodeFromPtr(p capnp.Ptr) API_add_Params {
+	return API_add_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s API_add_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s API_add_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s API_add_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s API_add_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s API_add_Params) A() int64 {
+	return int64(capnp.Struct(s).Uint64(0))
+}
+
+func (s API_add_Params) SetA(v int64) {
+	capnp.Struct(s).SetUint64(0, uint64(v))
+}
+
+func (s API_add_Params) B() int64 {
+	return int64(capnp.Struct(s).Uint64(8))
+}
+
+func (s API_add_Params) SetB(v int64) {
+	capnp.Struct(s).SetUint64(8, uint64(v))
+}
+
+// API_add_Params_List is a list of API_add_Params.
+type API_add_Params_List = capnp.StructList[API_add_Params]
+
+// NewAPI_add_Params creates a new list of API_add_Params.
+func NewAPI_add_Params_List(s *capnp.Segment, sz int32) (API_add_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0}, sz)
+	return capnp.StructList[API_add_Params](l), err
+}
+
+// API_add_Params_Future is a wrapper for a API_add_Params promised by a client call.
+type API_add_Params_Future struct{ *capnp.Future }
+
+func (f API_add_Params_Future) Struct() (API_add_Params, error) {
+	p, err := f.Future.Ptr()
+	return API_add_Params(p.Struct()), err
+}
+
+type API_add_Results capnp.Struct
+
+// API_add_Results_TypeID is the unique identifier for the type API_add_Results.
+const API_add_Results_TypeID = 0xce72004cadd1cdc5
+
+func NewAPI_add_Results(s *capnp.Segment) (API_add_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return API_add_Results(st), err
+}
+
+func NewRootAPI_add_Results(s *capnp.Segment) (API_add_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return API_add_Results(st), err
+}
+
+func ReadRootAPI_add_Results(msg *capnp.Message) (API_add_Results, error) {
+	root, err := msg.Root()
+	return API_add_Results(root.Struct()), err
+}
+
+func (s API_add_Results) String() string {
+	str, _ := text.Marshal(0xce72004cadd1cdc5, capnp.Struct(s))
+	return str
+}
+
+func (s API_add_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (API_add_Results) DecodeFromPtr(p capnp.Ptr) API_add_Results {
+	return API_add_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s API_add_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s API_add_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s API_add_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s API_add_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s API_add_Results) Res() int64 {
+	return int64(capnp.Struct(s).Uint64(0))
+}
+
+func (s API_add_Results) SetRes(v int64) {
+	capnp.Struct(s).SetUint64(0, uint64(v))
+}
+
+// API_add_Results_List is a list of API_add_Results.
+type API_add_Results_List = capnp.StructList[API_add_Results]
+
+// NewAPI_add_Results creates a new list of API_add_Results.
+func NewAPI_add_Results_List(s *capnp.Segment, sz int32) (API_add_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[API_add_Results](l), err
+}
+
+// API_add_Results_Future is a wrapper for a API_add_Results promised by a client call.
+type API_add_Results_Future struct{ *capnp.Future }
+
+func (f API_add_Results_Future) Struct() (API_add_Results, error) {
+	p, err := f.Future.Ptr()
+	return API_add_Results(p.Struct()), err
+}
+
+type API_multTree_Params capnp.Struct
+
+// API_multTree_Params_TypeID is the unique identifier for the type API_multTree_Params.
+const API_multTree_Params_TypeID = 0xb91ace4c4a633a57
+
+func NewAPI_multTree_Params(s *capnp.Segment) (API_multTree_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return API_multTree_Params(st), err
+}
+
+func NewRootAPI_multTree_Params(s *capnp.Segment) (API_multTree_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return API_multTree_Params(st), err
+}
+
+func ReadRootAPI_multTree_Params(msg *capnp.Message) (API_multTree_Params, error) {
+	root, err := msg.Root()
+	return API_multTree_Params(root.Struct()), err
+}
+
+func (s API_multTree_Params) String() string {
+	str, _ := text.Marshal(0xb91ace4c4a633a57, capnp.Struct(s))
+	return str
+}
+
+func (s API_multTree_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (API_multTree_Params) DecodeFromPtr(p capnp.Ptr) API_multTree_Params {
+	return API_multTree_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s API_multTree_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s API_multTree_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s API_multTree_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s API_multTree_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s API_multTree_Params) Mult() int64 {
+	return int64(capnp.Struct(s).Uint64(0))
+}
+
+func (s API_multTree_Params) SetMult(v int64) {
+	capnp.Struct(s).SetUint64(0, uint64(v))
+}
+
+func (s API_multTree_Params) Tree() (TreeNode, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return TreeNode(p.Struct()), err
+}
+
+func (s API_multTree_Params) HasTree() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s API_multTree_Params) SetTree(v TreeNode) error {
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+}
+
+// NewTree sets the tree field to a newly
+// allocated TreeNode struct, preferring placement in s's segment.
+func (s API_multTree_Params) NewTree() (TreeNode, error) {
+	ss, err := NewTreeNode(capnp.Struct(s).Segment())
+	if err != nil {
+		return TreeNode{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+// API_multTree_Params_List is a list of API_multTree_Params.
+type API_multTree_Params_List = capnp.StructList[API_multTree_Params]
+
+// NewAPI_multTree_Params creates a new list of API_multTree_Params.
+func NewAPI_multTree_Params_List(s *capnp.Segment, sz int32) (API_multTree_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[API_multTree_Params](l), err
+}
+
+// API_multTree_Params_Future is a wrapper for a API_multTree_Params promised by a client call.
+type API_multTree_Params_Future struct{ *capnp.Future }
+
+func (f API_multTree_Params_Future) Struct() (API_multTree_Params, error) {
+	p, err := f.Future.Ptr()
+	return API_multTree_Params(p.Struct()), err
+}
+func (p API_multTree_Params_Future) Tree() TreeNode_Future {
+	return TreeNode_Future{Future: p.Future.Field(0, nil)}
+}
+
+type API_multTree_Results capnp.Struct
+
+// API_multTree_Results_TypeID is the unique identifier for the type API_multTree_Results.
+const API_multTree_Results_TypeID = 0x983900eb0fa214ee
+
+func NewAPI_multTree_Results(s *capnp.Segment) (API_multTree_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return API_multTree_Results(st), err
+}
+
+func NewRootAPI_multTree_Results(s *capnp.Segment) (API_multTree_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return API_multTree_Results(st), err
+}
+
+func ReadRootAPI_multTree_Results(msg *capnp.Message) (API_multTree_Results, error) {
+	root, err := msg.Root()
+	return API_multTree_Results(root.Struct()), err
+}
+
+func (s API_multTree_Results) String() string {
+	str, _ := text.Marshal(0x983900eb0fa214ee, capnp.Struct(s))
+	return str
+}
+
+func (s API_multTree_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (API_multTree_Results) DecodeFromPtr(p capnp.Ptr) API_multTree_Results {
+	return API_multTree_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s API_multTree_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s API_multTree_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s API_multTree_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s API_multTree_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s API_multTree_Results) Res() (TreeNode, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return TreeNode(p.Struct()), err
+}
+
+func (s API_multTree_Results) HasRes() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s API_multTree_Results) SetRes(v TreeNode) error {
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+}
+
+// NewRes sets the res field to a newly
+// allocated TreeNode struct, preferring placement in s's segment.
+func (s API_multTree_Results) NewRes() (TreeNode, error) {
+	ss, err := NewTreeNode(capnp.Struct(s).Segment())
+	if err != nil {
+		return TreeNode{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+// API_multTree_Results_List is a list of API_multTree_Results.
+type API_multTree_Results_List = capnp.StructList[API_multTree_Results]
+
+// NewAPI_multTree_Results creates a new list of API_multTree_Results.
+func NewAPI_multTree_Results_List(s *capnp.Segment, sz int32) (API_multTree_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[API_multTree_Results](l), err
+}
+
+// API_multTree_Results_Future is a wrapper for a API_multTree_Results promised by a client call.
+type API_multTree_Results_Future struct{ *capnp.Future }
+
+func (f API_multTree_Results_Future) Struct() (API_multTree_Results, error) {
+	p, err := f.Future.Ptr()
+	return API_multTree_Results(p.Struct()), err
+}
+func (p API_multTree_Results_Future) Res() TreeNode_Future {
+	return TreeNode_Future{Future: p.Future.Field(0, nil)}
+}
+
+type API_toHex_Params capnp.Struct
+
+// API_toHex_Params_TypeID is the unique identifier for the type API_toHex_Params.
+const API_toHex_Params_TypeID = 0x880f4d13f4a8eb97
+
+func NewAPI_toHex_Params(s *capnp.Segment) (API_toHex_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return API_toHex_Params(st), err
+}
+
+func NewRootAPI_toHex_Params(s *capnp.Segment) (API_toHex_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return API_toHex_Params(st), err
+}
+
+func ReadRootAPI_toHex_Params(msg *capnp.Message) (API_toHex_Params, error) {
+	root, err := msg.Root()
+	return API_toHex_Params(root.Struct()), err
+}
+
+func (s API_toHex_Params) String() string {
+	str, _ := text.Marshal(0x880f4d13f4a8eb97, capnp.Struct(s))
+	return str
+}
+
+func (s API_toHex_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (API_toHex_Params) DecodeFromPtr(p capnp.Ptr) API_toHex_Params {
+	return API_toHex_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s API_toHex_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s API_toHex_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s API_toHex_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s API_toHex_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s API_toHex_Params) In() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return []byte(p.Data()), err
+}
+
+func (s API_toHex_Params) HasIn() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s API_toHex_Params) SetIn(v []byte) error {
+	return capnp.Struct(s).SetData(0, v)
+}
+
+// API_toHex_Params_List is a list of API_toHex_Params.
+type API_toHex_Params_List = capnp.StructList[API_toHex_Params]
+
+// NewAPI_toHex_Params creates a new list of API_toHex_Params.
+func NewAPI_toHex_Params_List(s *capnp.Segment, sz int32) (API_toHex_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[API_toHex_Params](l), err
+}
+
+// API_toHex_Params_Future is a wrapper for a API_toHex_Params promised by a client call.
+type API_toHex_Params_Future struct{ *capnp.Future }
+
+func (f API_toHex_Params_Future) Struct() (API_toHex_Params, error) {
+	p, err := f.Future.Ptr()
+	return API_toHex_Params(p.Struct()), err
+}
+
+type API_toHex_Results capnp.Struct
+
+// API_toHex_Results_TypeID is the unique identifier for the type API_toHex_Results.
+const API_toHex_Results_TypeID = 0x890d0dbe87503908
+
+func NewAPI_toHex_Results(s *capnp.Segment) (API_toHex_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return API_toHex_Results(st), err
+}
+
+func NewRootAPI_toHex_Results(s *capnp.Segment) (API_toHex_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return API_toHex_Results(st), err
+}
+
+func ReadRootAPI_toHex_Results(msg *capnp.Message) (API_toHex_Results, error) {
+	root, err := msg.Root()
+	return API_toHex_Results(root.Struct()), err
+}
+
+func (s API_toHex_Results) String() string {
+	str, _ := text.Marshal(0x890d0dbe87503908, capnp.Struct(s))
+	return str
+}
+
+func (s API_toHex_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (API_toHex_Results) DecodeFromPtr(p capnp.Ptr) API_toHex_Results {
+	return API_toHex_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s API_toHex_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s API_toHex_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s API_toHex_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s API_toHex_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s API_toHex_Results) Out() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return []byte(p.Data()), err
+}
+
+func (s API_toHex_Results) HasOut() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s API_toHex_Results) SetOut(v []byte) error {
+	return capnp.Struct(s).SetData(0, v)
+}
+
+// API_toHex_Results_List is a list of API_toHex_Results.
+type API_toHex_Results_List = capnp.StructList[API_toHex_Results]
+
+// NewAPI_toHex_Results creates a new list of API_toHex_Results.
+func NewAPI_toHex_Results_List(s *capnp.Segment, sz int32) (API_toHex_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[API_toHex_Results](l), err
+}
+
+// API_toHex_Results_Future is a wrapper for a API_toHex_Results promised by a client call.
+type API_toHex_Results_Future struct{ *capnp.Future }
+
+func (f API_toHex_Results_Future) Struct() (API_toHex_Results, error) {
+	p, err := f.Future.Ptr()
+	return API_toHex_Results(p.Struct()), err
+}
+
+type API_serverStream_Params capnp.Struct
+
+// API_serverStream_Params_TypeID is the unique identifier for the type API_serverStream_Params.
+const API_serverStream_Params_TypeID = 0xa6fcd5e4b08574cc
+
+func NewAPI_serverStream_Params(s *capnp.Segment) (API_serverStream_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return API_serverStream_Params(st), err
+}
+
+func NewRootAPI_serverStream_Params(s *capnp.Segment) (API_serverStream_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return API_serverStream_Params(st), err
+}
+
+func ReadRootAPI_serverStream_Params(msg *capnp.Message) (API_serverStream_Params, error) {
+	root, err := msg.Root()
+	return API_serverStream_Params(root.Struct()), err
+}
+
+func (s API_serverStream_Params) String() string {
+	str, _ := text.Marshal(0xa6fcd5e4b08574cc, capnp.Struct(s))
+	return str
+}
+
+func (s API_serverStream_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (API_serverStream_Params) DecodeFromPtr(p capnp.Ptr) API_serverStream_Params {
+	return API_serverStream_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s API_serverStream_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s API_serverStream_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s API_serverStream_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s API_serverStream_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s API_serverStream_Params) Start() int64 {
+	return int64(capnp.Struct(s).Uint64(0))
+}
+
+func (s API_serverStream_Params) SetStart(v int64) {
+	capnp.Struct(s).SetUint64(0, uint64(v))
+}
+
+func (s API_serverStream_Params) Count() int64 {
+	return int64(capnp.Struct(s).Uint64(8))
+}
+
+func (s API_serverStream_Params) SetCount(v int64) {
+	capnp.Struct(s).SetUint64(8, uint64(v))
+}
+
+func (s API_serverStream_Params) Sink() ItemSink {
+	p, _ := capnp.Struct(s).Ptr(0)
+	return ItemSink(p.Interface().Client())
+}
+
+func (s API_serverStream_Params) HasSink() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s API_serverStream_Params) SetSink(v ItemSink) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(0, in.ToPtr())
+}
+
+// API_serverStream_Params_List is a list of API_serverStream_Params.
+type API_serverStream_Params_List = capnp.StructList[API_serverStream_Params]
+
+// NewAPI_serverStream_Params creates a new list of API_serverStream_Params.
+func NewAPI_serverStream_Params_List(s *capnp.Segment, sz int32) (API_serverStream_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1}, sz)
+	return capnp.StructList[API_serverStream_Params](l), err
+}
+
+// API_serverStream_Params_Future is a wrapper for a API_serverStream_Params promised by a client call.
+type API_serverStream_Params_Future struct{ *capnp.Future }
+
+func (f API_serverStream_Params_Future) Struct() (API_serverStream_Params, error) {
+	p, err := f.Future.Ptr()
+	return API_serverStream_Params(p.Struct()), err
+}
+func (p API_serverStream_Params_Future) Sink() ItemSink {
+	return ItemSink(p.Future.Field(0, nil).Client())
+}
+
+type API_serverStream_Results capnp.Struct
+
+// API_serverStream_Results_TypeID is the unique identifier for the type API_serverStream_Results.
+const API_serverStream_Results_TypeID = 0xc6c114585fc2411c
+
+func NewAPI_serverStream_Results(s *capnp.Segment) (API_serverStream_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return API_serverStream_Results(st), err
+}
+
+func NewRootAPI_serverStream_Results(s *capnp.Segment) (API_serverStream_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return API_serverStream_Results(st), err
+}
+
+func ReadRootAPI_serverStream_Results(msg *capnp.Message) (API_serverStream_Results, error) {
+	root, err := msg.Root()
+	return API_serverStream_Results(root.Struct()), err
+}
+
+func (s API_serverStream_Results) String() string {
+	str, _ := text.Marshal(0xc6c114585fc2411c, capnp.Struct(s))
+	return str
+}
+
+func (s API_serverStream_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (API_serverStream_Results) DecodeFromPtr(p capnp.Ptr) API_serverStream_Results {
+	return API_serverStream_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s API_serverStream_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s API_serverStream_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s API_serverStream_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s API_serverStream_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// API_serverStream_Results_List is a list of API_serverStream_Results.
+type API_serverStream_Results_List = capnp.StructList[API_serverStream_Results]
+
+// NewAPI_serverStream_Results creates a new list of API_serverStream_Results.
+func NewAPI_serverStream_Results_List(s *capnp.Segment, sz int32) (API_serverStream_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[API_serverStream_Results](l), err
+}
+
+// API_serverStream_Results_Future is a wrapper for a API_serverStream_Results promised by a client call.
+type API_serverStream_Results_Future struct{ *capnp.Future }
+
+func (f API_serverStream_Results_Future) Struct() (API_serverStream_Results, error) {
+	p, err := f.Future.Ptr()
+	return API_serverStream_Results(p.Struct()), err
+}
+
+type API_clientStream_Params capnp.Struct
+
+// API_clientStream_Params_TypeID is the unique identifier for the type API_clientStream_Params.
+const API_clientStream_Params_TypeID = 0x95e80707e2033b23
+
+func NewAPI_clientStream_Params(s *capnp.Segment) (API_clientStream_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return API_clientStream_Params(st), err
+}
+
+func NewRootAPI_clientStream_Params(s *capnp.Segment) (API_clientStream_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return API_clientStream_Params(st), err
+}
+
+func ReadRootAPI_clientStream_Params(msg *capnp.Message) (API_clientStream_Params, error) {
+	root, err := msg.Root()
+	return API_clientStream_Params(root.Struct()), err
+}
+
+func (s API_clientStream_Params) String() string {
+	str, _ := text.Marshal(0x95e80707e2033b23, capnp.Struct(s))
+	return str
+}
+
+func (s API_clientStream_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (API_clientStream_Params) DecodeFromPtr(p capnp.Ptr) API_clientStream_Params {
+	return API_clientStream_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s API_clientStream_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s API_clientStream_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s API_clientStream_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s API_clientStream_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// API_clientStream_Params_List is a list of API_clientStream_Params.
+type API_clientStream_Params_List = capnp.StructList[API_clientStream_Params]
+
+// NewAPI_clientStream_Params creates a new list of API_clientStream_Params.
+func NewAPI_clientStream_Params_List(s *capnp.Segment, sz int32) (API_clientStream_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[API_clientStream_Params](l), err
+}
+
+// API_clientStream_Params_Future is a wrapper for a API_clientStream_Params promised by a client call.
+type API_clientStream_Params_Future struct{ *capnp.Future }
+
+func (f API_clientStream_Params_Future) Struct() (API_clientStream_Params, error) {
+	p, err := f.Future.Ptr()
+	return API_clientStream_Params(p.Struct()), err
+}
+
+type API_clientStream_Results capnp.Struct
+
+// API_clientStream_Results_TypeID is the unique identifier for the type API_clientStream_Results.
+const API_clientStream_Results_TypeID = 0xd687e0a9507a74b9
+
+func NewAPI_clientStream_Results(s *capnp.Segment) (API_clientStream_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return API_clientStream_Results(st), err
+}
+
+func NewRootAPI_clientStream_Results(s *capnp.Segment) (API_clientStream_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return API_clientStream_Results(st), err
+}
+
+func ReadRootAPI_clientStream_Results(msg *capnp.Message) (API_clientStream_Results, error) {
+	root, err := msg.Root()
+	return API_clientStream_Results(root.Struct()), err
+}
+
+func (s API_clientStream_Results) String() string {
+	str, _ := text.Marshal(0xd687e0a9507a74b9, capnp.Struct(s))
+	return str
+}
+
+func (s API_clientStream_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (API_clientStream_Results) DecodeFromPtr(p capnp.Ptr) API_clientStream_Results {
+	return API_clientStream_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s API_clientStream_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s API_clientStream_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s API_clientStream_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s API_clientStream_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s API_clientStream_Results) Sink() ChunkSink {
+	p, _ := capnp.Struct(s).Ptr(0)
+	return ChunkSink(p.Interface().Client())
+}
+
+func (s API_clientStream_Results) HasSink() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s API_clientStream_Results) SetSink(v ChunkSink) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(0, in.ToPtr())
+}
+
+// API_clientStream_Results_List is a list of API_clientStream_Results.
+type API_clientStream_Results_List = capnp.StructList[API_clientStream_Results]
+
+// NewAPI_clientStream_Results creates a new list of API_clientStream_Results.
+func NewAPI_clientStream_Results_List(s *capnp.Segment, sz int32) (API_clientStream_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[API_clientStream_Results](l), err
+}
+
+// API_clientStream_Results_Future is a wrapper for a API_clientStream_Results promised by a client call.
+type API_clientStream_Results_Future struct{ *capnp.Future }
+
+func (f API_clientStream_Results_Future) Struct() (API_clientStream_Results, error) {
+	p, err := f.Future.Ptr()
+	return API_clientStream_Results(p.Struct()), err
+}
+func (p API_clientStream_Results_Future) Sink() ChunkSink {
+	return ChunkSink(p.Future.Field(0, nil).Client())
+}
+
+type API_newNumber_Params capnp.Struct
+
+// API_newNumber_Params_TypeID is the unique identifier for the type API_newNumber_Params.
+const API_newNumber_Params_TypeID = 0xc41869ada1fb3893
+
+func NewAPI_newNumber_Params(s *capnp.Segment) (API_newNumber_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return API_newNumber_Params(st), err
+}
+
+func NewRootAPI_newNumber_Params(s *capnp.Segment) (API_newNumber_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return API_newNumber_Params(st), err
+}
+
+func ReadRootAPI_newNumber_Params(msg *capnp.Message) (API_newNumber_Params, error) {
+	root, err := msg.Root()
+	return API_newNumber_Params(root.Struct()), err
+}
+
+func (s API_newNumber_Params) String() string {
+	str, _ := text.Marshal(0xc41869ada1fb3893, capnp.Struct(s))
+	return str
+}
+
+func (s API_newNumber_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (API_newNumber_Params) DecodeFromPtr(p capnp.Ptr) API_newNumber_Params {
+	return API_newNumber_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s API_newNumber_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s API_newNumber_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s API_newNumber_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s API_newNumber_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s API_newNumber_Params) Value() int64 {
+	return int64(capnp.Struct(s).Uint64(0))
+}
+
+func (s API_newNumber_Params) SetValue(v int64) {
+	capnp.Struct(s).SetUint64(0, uint64(v))
+}
+
+// API_newNumber_Params_List is a list of API_newNumber_Params.
+type API_newNumber_Params_List = capnp.StructList[API_newNumber_Params]
+
+// NewAPI_newNumber_Params creates a new list of API_newNumber_Params.
+func NewAPI_newNumber_Params_List(s *capnp.Segment, sz int32) (API_newNumber_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[API_newNumber_Params](l), err
+}
+
+// API_newNumber_Params_Future is a wrapper for a API_newNumber_Params promised by a client call.
+type API_newNumber_Params_Future struct{ *capnp.Future }
+
+func (f API_newNumber_Params_Future) Struct() (API_newNumber_Params, error) {
+	p, err := f.Future.Ptr()
+	return API_newNumber_Params(p.Struct()), err
+}
+
+type API_newNumber_Results capnp.Struct
+
+// API_newNumber_Results_TypeID is the unique identifier for the type API_newNumber_Results.
+const API_newNumber_Results_TypeID = 0x90edbe18d23be59b
+
+func NewAPI_newNumber_Results(s *capnp.Segment) (API_newNumber_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return API_newNumber_Results(st), err
+}
+
+func NewRootAPI_newNumber_Results(s *capnp.Segment) (API_newNumber_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return API_newNumber_Results(st), err
+}
+
+func ReadRootAPI_newNumber_Results(msg *capnp.Message) (API_newNumber_Results, error) {
+	root, err := msg.Root()
+	return API_newNumber_Results(root.Struct()), err
+}
+
+func (s API_newNumber_Results) String() string {
+	str, _ := text.Marshal(0x90edbe18d23be59b, capnp.Struct(s))
+	return str
+}
+
+func (s API_newNumber_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (API_newNumber_Results) DecodeFromPtr(p capnp.Ptr) API_newNumber_Results {
+	return API_newNumber_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s API_newNumber_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s API_newNumber_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s API_newNumber_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s API_newNumber_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s API_newNumber_Results) Res() Number {
+	p, _ := capnp.Struct(s).Ptr(0)
+	return Number(p.Interface().Client())
+}
+
+func (s API_newNumber_Results) HasRes() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s API_newNumber_Results) SetRes(v Number) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(0, in.ToPtr())
+}
+
+// API_newNumber_Results_List is a list of API_newNumber_Results.
+type API_newNumber_Results_List = capnp.StructList[API_newNumber_Results]
+
+// NewAPI_newNumber_Results creates a new list of API_newNumber_Results.
+func NewAPI_newNumber_Results_List(s *capnp.Segment, sz int32) (API_newNumber_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[API_newNumber_Results](l), err
+}
+
+// API_newNumber_Results_Future is a wrapper for a API_newNumber_Results promised by a client call.
+type API_newNumber_Results_Future struct{ *capnp.Future }
+
+func (f API_newNumber_Results_Future) Struct() (API_newNumber_Results, error) {
+	p, err := f.Future.Ptr()
+	return API_newNumber_Results(p.Struct()), err
+}
+func (p API_newNumber_Results_Future) Res() Number {
+	return Number(p.Future.Field(0, nil).Client())
+}
+
+type API_callback_Params capnp.Struct
+
+// API_callback_Params_TypeID is the unique identifier for the type API_callback_Params.
+const API_callback_Params_TypeID = 0x9024c1ec2a59bdb5
+
+func NewAPI_callback_Params(s *capnp.Segment) (API_callback_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return API_callback_Params(st), err
+}
+
+func NewRootAPI_callback_Params(s *capnp.Segment) (API_callback_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return API_callback_Params(st), err
+}
+
+func ReadRootAPI_callback_Params(msg *capnp.Message) (API_callback_Params, error) {
+	root, err := msg.Root()
+	return API_callback_Params(root.Struct()), err
+}
+
+func (s API_callback_Params) String() string {
+	str, _ := text.Marshal(0x9024c1ec2a59bdb5, capnp.Struct(s))
+	return str
+}
+
+func (s API_callback_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (API_callback_Params) DecodeFromPtr(p capnp.Ptr) API_callback_Params {
+	return API_callback_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s API_callback_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s API_callback_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s API_callback_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s API_callback_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s API_callback_Params) Start() int64 {
+	return int64(capnp.Struct(s).Uint64(0))
+}
+
+func (s API_callback_Params) SetStart(v int64) {
+	capnp.Struct(s).SetUint64(0, uint64(v))
+}
+
+func (s API_callback_Params) Count() int64 {
+	return int64(capnp.Struct(s).Uint64(8))
+}
+
+func (s API_callback_Params) SetCount(v int64) {
+	capnp.Struct(s).SetUint64(8, uint64(v))
+}
+
+func (s API_callback_Params) Target() CallbackTarget {
+	p, _ := capnp.Struct(s).Ptr(0)
+	return CallbackTarget(p.Interface().Client())
+}
+
+func (s API_callback_Params) HasTarget() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s API_callback_Params) SetTarget(v CallbackTarget) error {
+	if !v.IsValid() {
+		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
+	return capnp.Struct(s).SetPtr(0, in.ToPtr())
+}
+
+// API_callback_Params_List is a list of API_callback_Params.
+type API_callback_Params_List = capnp.StructList[API_callback_Params]
+
+// NewAPI_callback_Params creates a new list of API_callback_Params.
+func NewAPI_callback_Params_List(s *capnp.Segment, sz int32) (API_callback_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1}, sz)
+	return capnp.StructList[API_callback_Params](l), err
+}
+
+// API_callback_Params_Future is a wrapper for a API_callback_Params promised by a client call.
+type API_callback_Params_Future struct{ *capnp.Future }
+
+func (f API_callback_Params_Future) Struct() (API_callback_Params, error) {
+	p, err := f.Future.Ptr()
+	return API_callback_Params(p.Struct()), err
+}
+func (p API_callback_Params_Future) Target() CallbackTarget {
+	return CallbackTarget(p.Future.Field(0, nil).Client())
+}
+
+type API_callback_Results capnp.Struct
+
+// API_callback_Results_TypeID is the unique identifier for the type API_callback_Results.
+const API_callback_Results_TypeID = 0xe6a15092c3ec2b96
+
+func NewAPI_callback_Results(s *capnp.Segment) (API_callback_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return API_callback_Results(st), err
+}
+
+func NewRootAPI_callback_Results(s *capnp.Segment) (API_callback_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return API_callback_Results(st), err
+}
+
+func ReadRootAPI_callback_Results(msg *capnp.Message) (API_callback_Results, error) {
+	root, err := msg.Root()
+	return API_callback_Results(root.Struct()), err
+}
+
+func (s API_callback_Results) String() string {
+	str, _ := text.Marshal(0xe6a15092c3ec2b96, capnp.Struct(s))
+	return str
+}
+
+func (s API_callback_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (API_callback_Results) DecodeFromPtr(p capnp.Ptr) API_callback_Results {
+	return API_callback_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s API_callback_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s API_callback_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s API_callback_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s API_callback_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s API_callback_Results) Sum() int64 {
+	return int64(capnp.Struct(s).Uint64(0))
+}
+
+func (s API_callback_Results) SetSum(v int64) {
+	capnp.Struct(s).SetUint64(0, uint64(v))
+}
+
+// API_callback_Results_List is a list of API_callback_Results.
+type API_callback_Results_List = capnp.StructList[API_callback_Results]
+
+// NewAPI_callback_Results creates a new list of API_callback_Results.
+func NewAPI_callback_Results_List(s *capnp.Segment, sz int32) (API_callback_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[API_callback_Results](l), err
+}
+
+// API_callback_Results_Future is a wrapper for a API_callback_Results promised by a client call.
+type API_callback_Results_Future struct{ *capnp.Future }
+
+func (f API_callback_Results_Future) Struct() (API_callback_Results, error) {
+	p, err := f.Future.Ptr()
+	return API_callback_Results(p.Struct()), err
+}
+
+type API_validate_Params capnp.Struct
+
+// API_validate_Params_TypeID is the unique identifier for the type API_validate_Params.
+const API_validate_Params_TypeID = 0xd2658886cb87ed28
+
+func NewAPI_validate_Params(s *capnp.Segment) (API_validate_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return API_validate_Params(st), err
+}
+
+func NewRootAPI_validate_Params(s *capnp.Segment) (API_validate_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return API_validate_Params(st), err
+}
+
+func ReadRootAPI_validate_Params(msg *capnp.Message) (API_validate_Params, error) {
+	root, err := msg.Root()
+	return API_validate_Params(root.Struct()), err
+}
+
+func (s API_validate_Params) String() string {
+	str, _ := text.Marshal(0xd2658886cb87ed28, capnp.Struct(s))
+	return str
+}
+
+func (s API_validate_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (API_validate_Params) DecodeFromPtr(p capnp.Ptr) API_validate_Params {
+	return API_validate_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s API_validate_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s API_validate_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s API_validate_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s API_validate_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s API_validate_Params) Value() int64 {
+	return int64(capnp.Struct(s).Uint64(0))
+}
+
+func (s API_validate_Params) SetValue(v int64) {
+	capnp.Struct(s).SetUint64(0, uint64(v))
+}
+
+func (s API_validate_Params) Code() uint32 {
+	return capnp.Struct(s).Uint32(8)
+}
+
+func (s API_validate_Params) SetCode(v uint32) {
+	capnp.Struct(s).SetUint32(8, v)
+}
+
+// API_validate_Params_List is a list of API_validate_Params.
+type API_validate_Params_List = capnp.StructList[API_validate_Params]
+
+// NewAPI_validate_Params creates a new list of API_validate_Params.
+func NewAPI_validate_Params_List(s *capnp.Segment, sz int32) (API_validate_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0}, sz)
+	return capnp.StructList[API_validate_Params](l), err
+}
+
+// API_validate_Params_Future is a wrapper for a API_validate_Params promised by a client call.
+type API_validate_Params_Future struct{ *capnp.Future }
+
+func (f API_validate_Params_Future) Struct() (API_validate_Params, error) {
+	p, err := f.Future.Ptr()
+	return API_validate_Params(p.Struct()), err
+}
+
+type API_validate_Results capnp.Struct
+
+// API_validate_Results_TypeID is the unique identifier for the type API_validate_Results.
+const API_validate_Results_TypeID = 0x80ba478b1c44b867
+
+func NewAPI_validate_Results(s *capnp.Segment) (API_validate_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return API_validate_Results(st), err
+}
+
+func NewRootAPI_validate_Results(s *capnp.Segment) (API_validate_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return API_validate_Results(st), err
+}
+
+func ReadRootAPI_validate_Results(msg *capnp.Message) (API_validate_Results, error) {
+	root, err := msg.Root()
+	return API_validate_Results(root.Struct()), err
+}
+
+func (s API_validate_Results) String() string {
+	str, _ := text.Marshal(0x80ba478b1c44b867, capnp.Struct(s))
+	return str
+}
+
+func (s API_validate_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (API_validate_Results) DecodeFromPtr(p capnp.Ptr) API_validate_Results {
+	return API_validate_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s API_validate_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s API_validate_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s API_validate_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s API_validate_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s API_validate_Results) Value() int64 {
+	return int64(capnp.Struct(s).Uint64(0))
+}
+
+func (s API_validate_Results) SetValue(v int64) {
+	capnp.Struct(s).SetUint64(0, uint64(v))
+}
+
+// API_validate_Results_List is a list of API_validate_Results.
+type API_validate_Results_List = capnp.StructList[API_validate_Results]
+
+// NewAPI_validate_Results creates a new list of API_validate_Results.
+func NewAPI_validate_Results_List(s *capnp.Segment, sz int32) (API_validate_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[API_validate_Results](l), err
+}
+
+// API_validate_Results_Future is a wrapper for a API_validate_Results promised by a client call.
+type API_validate_Results_Future struct{ *capnp.Future }
+
+func (f API_validate_Results_Future) Struct() (API_validate_Results, error) {
+	p, err := f.Future.Ptr()
+	return API_validate_Results(p.Struct()), err
+}
+
+type API_slow_Params capnp.Struct
+
+// API_slow_Params_TypeID is the unique identifier for the type API_slow_Params.
+const API_slow_Params_TypeID = 0xe0c590d632b8b606
+
+func NewAPI_slow_Params(s *capnp.Segment) (API_slow_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return API_slow_Params(st), err
+}
+
+func NewRootAPI_slow_Params(s *capnp.Segment) (API_slow_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return API_slow_Params(st), err
+}
+
+func ReadRootAPI_slow_Params(msg *capnp.Message) (API_slow_Params, error) {
+	root, err := msg.Root()
+	return API_slow_Params(root.Struct()), err
+}
+
+func (s API_slow_Params) String() string {
+	str, _ := text.Marshal(0xe0c590d632b8b606, capnp.Struct(s))
+	return str
+}
+
+func (s API_slow_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (API_slow_Params) DecodeFromPtr(p capnp.Ptr) API_slow_Params {
+	return API_slow_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s API_slow_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s API_slow_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s API_slow_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s API_slow_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s API_slow_Params) Delay() int64 {
+	return int64(capnp.Struct(s).Uint64(0))
+}
+
+func (s API_slow_Params) SetDelay(v int64) {
+	capnp.Struct(s).SetUint64(0, uint64(v))
+}
+
+// API_slow_Params_List is a list of API_slow_Params.
+type API_slow_Params_List = capnp.StructList[API_slow_Params]
+
+// NewAPI_slow_Params creates a new list of API_slow_Params.
+func NewAPI_slow_Params_List(s *capnp.Segment, sz int32) (API_slow_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[API_slow_Params](l), err
+}
+
+// API_slow_Params_Future is a wrapper for a API_slow_Params promised by a client call.
+type API_slow_Params_Future struct{ *capnp.Future }
+
+func (f API_slow_Params_Future) Struct() (API_slow_Params, error) {
+	p, err := f.Future.Ptr()
+	return API_slow_Params(p.Struct()), err
+}
+
+type API_slow_Results capnp.Struct
+
+// API_slow_Results_TypeID is the unique identifier for the type API_slow_Results.
+const API_slow_Results_TypeID = 0x9676ab071ecaf772
+
+func NewAPI_slow_Results(s *capnp.Segment) (API_slow_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return API_slow_Results(st), err
+}
+
+func NewRootAPI_slow_Results(s *capnp.Segment) (API_slow_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return API_slow_Results(st), err
+}
+
+func ReadRootAPI_slow_Results(msg *capnp.Message) (API_slow_Results, error) {
+	root, err := msg.Root()
+	return API_slow_Results(root.Struct()), err
+}
+
+func (s API_slow_Results) String() string {
+	str, _ := text.Marshal(0x9676ab071ecaf772, capnp.Struct(s))
+	return str
+}
+
+func (s API_slow_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (API_slow_Results) DecodeFromPtr(p capnp.Ptr) API_slow_Results {
+	return API_slow_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s API_slow_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s API_slow_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s API_slow_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s API_slow_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// API_slow_Results_List is a list of API_slow_Results.
+type API_slow_Results_List = capnp.StructList[API_slow_Results]
+
+// NewAPI_slow_Results creates a new list of API_slow_Results.
+func NewAPI_slow_Results_List(s *capnp.Segment, sz int32) (API_slow_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[API_slow_Results](l), err
+}
+
+// API_slow_Results_Future is a wrapper for a API_slow_Results promised by a client call.
+type API_slow_Results_Future struct{ *capnp.Future }
+
+func (f API_slow_Results_Future) Struct() (API_slow_Results, error) {
+	p, err := f.Future.Ptr()
+	return API_slow_Results(p.Struct()), err
+}
+
+type API_slowCalls_Params capnp.Struct
+
+// API_slowCalls_Params_TypeID is the unique identifier for the type API_slowCalls_Params.
+const API_slowCalls_Params_TypeID = 0x9d1daf20082a1537
+
+func NewAPI_slowCalls_Params(s *capnp.Segment) (API_slowCalls_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return API_slowCalls_Params(st), err
+}
+
+func NewRootAPI_slowCalls_Params(s *capnp.Segment) (API_slowCalls_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return API_slowCalls_Params(st), err
+}
+
+func ReadRootAPI_slowCalls_Params(msg *capnp.Message) (API_slowCalls_Params, error) {
+	root, err := msg.Root()
+	return API_slowCalls_Params(root.Struct()), err
+}
+
+func (s API_slowCalls_Params) String() string {
+	str, _ := text.Marshal(0x9d1daf20082a1537, capnp.Struct(s))
+	return str
+}
+
+func (s API_slowCalls_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (API_slowCalls_Params) DecodeFromPtr(p capnp.Ptr) API_slowCalls_Params {
+	return API_slowCalls_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s API_slowCalls_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s API_slowCalls_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s API_slowCalls_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s API_slowCalls_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// API_slowCalls_Params_List is a list of API_slowCalls_Params.
+type API_slowCalls_Params_List = capnp.StructList[API_slowCalls_Params]
+
+// NewAPI_slowCalls_Params creates a new list of API_slowCalls_Params.
+func NewAPI_slowCalls_Params_List(s *capnp.Segment, sz int32) (API_slowCalls_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[API_slowCalls_Params](l), err
+}
+
+// API_slowCalls_Params_Future is a wrapper for a API_slowCalls_Params promised by a client call.
+type API_slowCalls_Params_Future struct{ *capnp.Future }
+
+func (f API_slowCalls_Params_Future) Struct() (API_slowCalls_Params, error) {
+	p, err := f.Future.Ptr()
+	return API_slowCalls_Params(p.Struct()), err
+}
+
+type API_slowCalls_Results capnp.Struct
+
+// API_slowCalls_Results_TypeID is the unique identifier for the type API_slowCalls_Results.
+const API_slowCalls_Results_TypeID = 0xb444120a7096b56d
+
+func NewAPI_slowCalls_Results(s *capnp.Segment) (API_slowCalls_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return API_slowCalls_Results(st), err
+}
+
+func NewRootAPI_slowCalls_Results(s *capnp.Segment) (API_slowCalls_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return API_slowCalls_Results(st), err
+}
+
+func ReadRootAPI_slowCalls_Results(msg *capnp.Message) (API_slowCalls_Results, error) {
+	root, err := msg.Root()
+	return API_slowCalls_Results(root.Struct()), err
+}
+
+func (s API_slowCalls_Results) String() string {
+	str, _ := text.Marshal(0xb444120a7096b56d, capnp.Struct(s))
+	return str
+}
+
+func (s API_slowCalls_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (API_slowCalls_Results) DecodeFromPtr(p capnp.Ptr) API_slowCalls_Results {
+	return API_slowCalls_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s API_slowCalls_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s API_slowCalls_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s API_slowCalls_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s API_slowCalls_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s API_slowCalls_Results) Started() int64 {
+	return int64(capnp.Struct(s).Uint64(0))
+}
+
+func (s API_slowCalls_Results) SetStarted(v int64) {
+	capnp.Struct(s).SetUint64(0, uint64(v))
+}
+
+func (s API_slowCalls_Results) Canceled() int64 {
+	return int64(capnp.Struct(s).Uint64(8))
+}
+
+func (s API_slowCalls_Results) SetCanceled(v int64) {
+	capnp.Struct(s).SetUint64(8, uint64(v))
+}
+
+// API_slowCalls_Results_List is a list of API_slowCalls_Results.
+type API_slowCalls_Results_List = capnp.StructList[API_slowCalls_Results]
+
+// NewAPI_slowCalls_Results creates a new list of API_slowCalls_Results.
+func NewAPI_slowCalls_Results_List(s *capnp.Segment, sz int32) (API_slowCalls_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0}, sz)
+	return capnp.StructList[API_slowCalls_Results](l), err
+}
+
+// API_slowCalls_Results_Future is a wrapper for a API_slowCalls_Results promised by a client call.
+type API_slowCalls_Results_Future struct{ *capnp.Future }
+
+func (f API_slowCalls_Results_Future) Struct() (API_slowCalls_Results, error) {
+	p, err := f.Future.Ptr()
+	return API_slowCalls_Results(p.Struct()), err
+}
+
+type API_work_Params capnp.Struct
+
+// API_work_Params_TypeID is the unique identifier for the type API_work_Params.
+const API_work_Params_TypeID = 0xc38dd8fd801f7df6
+
+func NewAPI_work_Params(s *capnp.Segment) (API_work_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return API_work_Params(st), err
+}
+
+func NewRootAPI_work_Params(s *capnp.Segment) (API_work_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return API_work_Params(st), err
+}
+
+func ReadRootAPI_work_Params(msg *capnp.Message) (API_work_Params, error) {
+	root, err := msg.Root()
+	return API_work_Params(root.Struct()), err
+}
+
+func (s API_work_Params) String() string {
+	str, _ := text.Marshal(0xc38dd8fd801f7df6, capnp.Struct(s))
+	return str
+}
+
+func (s API_work_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (API_work_Params) DecodeFromPtr(p capnp.Ptr) API_work_Params {
+	return API_work_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s API_work_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s API_work_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s API_work_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
 
-func (c API) Nop(ctx context.Context, params func(API_nop_Params) error) (API_nop_Results_Future, capnp.ReleaseFunc) {
+func (s API_work_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s API_work_Params) Duration() int64 {
+	return int64(capnp.Struct(s).Uint64(0))
+}
 
-	s := capnp.Send{
-		Method: capnp.Method{
-			InterfaceID:   0xfea5a1579f2ffa9e,
-			MethodID:      0,
-			InterfaceName: "structdef.capnp:API",
-			MethodName:    "nop",
-		},
-	}
-	if params != nil {
-		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
-		s.PlaceArgs = func(s capnp.Struct) error { return params(API_nop_Params(s)) }
-	}
+func (s API_work_Params) SetDuration(v int64) {
+	capnp.Struct(s).SetUint64(0, uint64(v))
+}
 
-	ans, release := capnp.Client(c).SendCall(ctx, s)
-	return API_nop_Results_Future{Future: ans.Future()}, release
+func (s API_work_Params) Cpu() bool {
+	return capnp.Struct(s).Bit(64)
+}
 
+func (s API_work_Params) SetCpu(v bool) {
+	capnp.Struct(s).SetBit(64, v)
 }
 
-func (c API) Add(ctx context.Context, params func(API_add_Params) error) (API_add_Results_Future, capnp.ReleaseFunc) {
+// API_work_Params_List is a list of API_work_Params.
+type API_work_Params_List = capnp.StructList[API_work_Params]
 
-	s := capnp.Send{
-		Method: capnp.Method{
-			InterfaceID:   0xfea5a1579f2ffa9e,
-			MethodID:      1,
-			InterfaceName: "structdef.capnp:API",
-			MethodName:    "add",
-		},
-	}
-	if params != nil {
-		s.ArgsSize = capnp.ObjectSize{DataSize: 16, PointerCount: 0}
-		s.PlaceArgs = func(s capnp.Struct) error { return params(API_add_Params(s)) }
-	}
+// NewAPI_work_Params creates a new list of API_work_Params.
+func NewAPI_work_Params_List(s *capnp.Segment, sz int32) (API_work_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0}, sz)
+	return capnp.StructList[API_work_Params](l), err
+}
 
-	ans, release := capnp.Client(c).SendCall(ctx, s)
-	return API_add_Results_Future{Future: ans.Future()}, release
+// API_work_Params_Future is a wrapper for a API_work_Params promised by a client call.
+type API_work_Params_Future struct{ *capnp.Future }
 
+func (f API_work_Params_Future) Struct() (API_work_Params, error) {
+	p, err := f.Future.Ptr()
+	return API_work_Params(p.Struct()), err
 }
 
-func (c API) MultTree(ctx context.Context, params func(API_multTree_Params) error) (API_multTree_Results_Future, capnp.ReleaseFunc) {
+type API_work_Results capnp.Struct
 
-	s := capnp.Send{
-		Method: capnp.Method{
-			InterfaceID:   0xfea5a1579f2ffa9e,
-			MethodID:      2,
-			InterfaceName: "structdef.capnp:API",
-			MethodName:    "multTree",
-		},
-	}
-	if params != nil {
-		s.ArgsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 1}
-		s.PlaceArgs = func(s capnp.Struct) error { return params(API_multTree_Params(s)) }
-	}
+// API_work_Results_TypeID is the unique identifier for the type API_work_Results.
+const API_work_Results_TypeID = 0xd4a52809523ea996
 
-	ans, release := capnp.Client(c).SendCall(ctx, s)
-	return API_multTree_Results_Future{Future: ans.Future()}, release
+func NewAPI_work_Results(s *capnp.Segment) (API_work_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return API_work_Results(st), err
+}
 
+func NewRootAPI_work_Results(s *capnp.Segment) (API_work_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return API_work_Results(st), err
 }
 
-func (c API) ToHex(ctx context.Context, params func(API_toHex_Params) error) (API_toHex_Results_Future, capnp.ReleaseFunc) {
+func ReadRootAPI_work_Results(msg *capnp.Message) (API_work_Results, error) {
+	root, err := msg.Root()
+	return API_work_Results(root.Struct()), err
+}
 
-	s := capnp.Send{
-		Method: capnp.Method{
-			InterfaceID:   0xfea5a1579f2ffa9e,
-			MethodID:      3,
-			InterfaceName: "structdef.capnp:API",
-			MethodName:    "toHex",
-		},
-	}
-	if params != nil {
-		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
-		s.PlaceArgs = func(s capnp.Struct) error { return params(API_toHex_Params(s)) }
-	}
+func (s API_work_Results) String() string {
+	str, _ := text.Marshal(0xd4a52809523ea996, capnp.Struct(s))
+	return str
+}
 
-	ans, release := capnp.Client(c).SendCall(ctx, s)
-	return API_toHex_Results_Future{Future: ans.Future()}, release
+func (s API_work_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
 
+func (API_work_Results) DecodeFromPtr(p capnp.Ptr) API_work_Results {
+	return API_work_Results(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (c API) WaitStreaming() error {
-	return capnp.Client(c).WaitStreaming()
+func (s API_work_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s API_work_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
 }
 
-// String returns a string that identifies this capability for debugging
-// purposes.  Its format should not be depended on: in particular, it
-// should not be used to compare clients.  Use IsSame to compare clients
-// for equality.
-func (c API) String() string {
-	return "API(" + capnp.Client(c).String() + ")"
+func (s API_work_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
 }
 
-// AddRef creates a new Client that refers to the same capability as c.
-// If c is nil or has resolved to null, then AddRef returns nil.
-func (c API) AddRef() API {
-	return API(capnp.Client(c).AddRef())
+func (s API_work_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
 }
 
-// Release releases a capability reference.  If this is the last
-// reference to the capability, then the underlying resources associated
-// with the capability will be released.
-//
-// Release will panic if c has already been released, but not if c is
-// nil or resolved to null.
-func (c API) Release() {
-	capnp.Client(c).Release()
+// API_work_Results_List is a list of API_work_Results.
+type API_work_Results_List = capnp.StructList[API_work_Results]
+
+// NewAPI_work_Results creates a new list of API_work_Results.
+func NewAPI_work_Results_List(s *capnp.Segment, sz int32) (API_work_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[API_work_Results](l), err
 }
 
-// Resolve blocks until the capability is fully resolved or the Context
-// expires.
-func (c API) Resolve(ctx context.Context) error {
-	return capnp.Client(c).Resolve(ctx)
+// API_work_Results_Future is a wrapper for a API_work_Results promised by a client call.
+type API_work_Results_Future struct{ *capnp.Future }
+
+func (f API_work_Results_Future) Struct() (API_work_Results, error) {
+	p, err := f.Future.Ptr()
+	return API_work_Results(p.Struct()), err
 }
 
-func (c API) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Client(c).EncodeAsPtr(seg)
+type API_echo_Params capnp.Struct
+
+// API_echo_Params_TypeID is the unique identifier for the type API_echo_Params.
+const API_echo_Params_TypeID = 0xb3d5ff2341788649
+
+func NewAPI_echo_Params(s *capnp.Segment) (API_echo_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return API_echo_Params(st), err
 }
 
-func (API) DecodeFromPtr(p capnp.Ptr) API {
-	return API(capnp.Client{}.DecodeFromPtr(p))
+func NewRootAPI_echo_Params(s *capnp.Segment) (API_echo_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return API_echo_Params(st), err
 }
 
-// IsValid reports whether c is a valid reference to a capability.
-// A reference is invalid if it is nil, has resolved to null, or has
-// been released.
-func (c API) IsValid() bool {
-	return capnp.Client(c).IsValid()
+func ReadRootAPI_echo_Params(msg *capnp.Message) (API_echo_Params, error) {
+	root, err := msg.Root()
+	return API_echo_Params(root.Struct()), err
 }
 
-// IsSame reports whether c and other refer to a capability created by the
-// same call to NewClient.  This can return false negatives if c or other
-// are not fully resolved: use Resolve if this is an issue.  If either
-// c or other are released, then IsSame panics.
-func (c API) IsSame(other API) bool {
-	return capnp.Client(c).IsSame(capnp.Client(other))
+func (s API_echo_Params) String() string {
+	str, _ := text.Marshal(0xb3d5ff2341788649, capnp.Struct(s))
+	return str
 }
 
-// Update the flowcontrol.FlowLimiter used to manage flow control for
-// this client. This affects all future calls, but not calls already
-// waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
-// which is also the default.
-func (c API) SetFlowLimiter(lim fc.FlowLimiter) {
-	capnp.Client(c).SetFlowLimiter(lim)
+func (s API_echo_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-// Get the current flowcontrol.FlowLimiter used to manage flow control
-// for this client.
-func (c API) GetFlowLimiter() fc.FlowLimiter {
-	return capnp.Client(c).GetFlowLimiter()
+func (API_echo_Params) DecodeFromPtr(p capnp.Ptr) API_echo_Params {
+	return API_echo_Params(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-// A API_Server is a API with a local implementation.
-type API_Server interface {
-	Nop(context.Context, API_nop) error
+func (s API_echo_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s API_echo_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
 
-	Add(context.Context, API_add) error
+func (s API_echo_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
 
-	MultTree(context.Context, API_multTree) error
+func (s API_echo_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s API_echo_Params) Data() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return []byte(p.Data()), err
+}
+
+func (s API_echo_Params) HasData() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s API_echo_Params) SetData(v []byte) error {
+	return capnp.Struct(s).SetData(0, v)
+}
+
+// API_echo_Params_List is a list of API_echo_Params.
+type API_echo_Params_List = capnp.StructList[API_echo_Params]
+
+// NewAPI_echo_Params creates a new list of API_echo_Params.
+func NewAPI_echo_Params_List(s *capnp.Segment, sz int32) (API_echo_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[API_echo_Params](l), err
+}
+
+// API_echo_Params_Future is a wrapper for a API_echo_Params promised by a client call.
+type API_echo_Params_Future struct{ *capnp.Future }
+
+func (f API_echo_Params_Future) Struct() (API_echo_Params, error) {
+	p, err := f.Future.Ptr()
+	return API_echo_Params(p.Struct()), err
+}
+
+type API_echo_Results capnp.Struct
+
+// API_echo_Results_TypeID is the unique identifier for the type API_echo_Results.
+const API_echo_Results_TypeID = 0xb82a58396d71d5e2
+
+func NewAPI_echo_Results(s *capnp.Segment) (API_echo_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return API_echo_Results(st), err
+}
+
+func NewRootAPI_echo_Results(s *capnp.Segment) (API_echo_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return API_echo_Results(st), err
+}
+
+func ReadRootAPI_echo_Results(msg *capnp.Message) (API_echo_Results, error) {
+	root, err := msg.Root()
+	return API_echo_Results(root.Struct()), err
+}
+
+func (s API_echo_Results) String() string {
+	str, _ := text.Marshal(0xb82a58396d71d5e2, capnp.Struct(s))
+	return str
+}
+
+func (s API_echo_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (API_echo_Results) DecodeFromPtr(p capnp.Ptr) API_echo_Results {
+	return API_echo_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s API_echo_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s API_echo_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s API_echo_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s API_echo_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s API_echo_Results) Data() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return []byte(p.Data()), err
+}
+
+func (s API_echo_Results) HasData() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s API_echo_Results) SetData(v []byte) error {
+	return capnp.Struct(s).SetData(0, v)
+}
+
+// API_echo_Results_List is a list of API_echo_Results.
+type API_echo_Results_List = capnp.StructList[API_echo_Results]
+
+// NewAPI_echo_Results creates a new list of API_echo_Results.
+func NewAPI_echo_Results_List(s *capnp.Segment, sz int32) (API_echo_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[API_echo_Results](l), err
+}
+
+// API_echo_Results_Future is a wrapper for a API_echo_Results promised by a client call.
+type API_echo_Results_Future struct{ *capnp.Future }
+
+func (f API_echo_Results_Future) Struct() (API_echo_Results, error) {
+	p, err := f.Future.Ptr()
+	return API_echo_Results(p.Struct()), err
+}
+
+type API_upperRecords_Params capnp.Struct
+
+// API_upperRecords_Params_TypeID is the unique identifier for the type API_upperRecords_Params.
+const API_upperRecords_Params_TypeID = 0xd2902a139fbd81ae
+
+func NewAPI_upperRecords_Params(s *capnp.Segment) (API_upperRecords_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return API_upperRecords_Params(st), err
+}
+
+func NewRootAPI_upperRecords_Params(s *capnp.Segment) (API_upperRecords_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return API_upperRecords_Params(st), err
+}
+
+func ReadRootAPI_upperRecords_Params(msg *capnp.Message) (API_upperRecords_Params, error) {
+	root, err := msg.Root()
+	return API_upperRecords_Params(root.Struct()), err
+}
+
+func (s API_upperRecords_Params) String() string {
+	str, _ := text.Marshal(0xd2902a139fbd81ae, capnp.Struct(s))
+	return str
+}
+
+func (s API_upperRecords_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (API_upperRecords_Params) DecodeFromPtr(p capnp.Ptr) API_upperRecords_Params {
+	return API_upperRecords_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s API_upperRecords_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s API_upperRecords_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s API_upperRecords_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
 
-	ToHex(context.Context, API_toHex) error
+func (s API_upperRecords_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s API_upperRecords_Params) Records() (Record_List, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return Record_List(p.List()), err
 }
 
-// API_NewServer creates a new Server from an implementation of API_Server.
-func API_NewServer(s API_Server) *server.Server {
-	c, _ := s.(server.Shutdowner)
-	return server.New(API_Methods(nil, s), s, c)
+func (s API_upperRecords_Params) HasRecords() bool {
+	return capnp.Struct(s).HasPtr(0)
 }
 
-// API_ServerToClient creates a new Client from an implementation of API_Server.
-// The caller is responsible for calling Release on the returned Client.
-func API_ServerToClient(s API_Server) API {
-	return API(capnp.NewClient(API_NewServer(s)))
+func (s API_upperRecords_Params) SetRecords(v Record_List) error {
+	return capnp.Struct(s).SetPtr(0, v.ToPtr())
 }
 
-// API_Methods appends Methods to a slice that invoke the methods on s.
-// This can be used to create a more complicated Server.
-func API_Methods(methods []server.Method, s API_Server) []server.Method {
-	if cap(methods) == 0 {
-		methods = make([]server.Method, 0, 4)
+// NewRecords sets the records field to a newly
+// allocated Record_List, preferring placement in s's segment.
+func (s API_upperRecords_Params) NewRecords(n int32) (Record_List, error) {
+	l, err := NewRecord_List(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return Record_List{}, err
 	}
+	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
+	return l, err
+}
 
-	methods = append(methods, server.Method{
-		Method: capnp.Method{
-			InterfaceID:   0xfea5a1579f2ffa9e,
-			MethodID:      0,
-			InterfaceName: "structdef.capnp:API",
-			MethodName:    "nop",
-		},
-		Impl: func(ctx context.Context, call *server.Call) error {
-			return s.Nop(ctx, API_nop{call})
-		},
-	})
+// API_upperRecords_Params_List is a list of API_upperRecords_Params.
+type API_upperRecords_Params_List = capnp.StructList[API_upperRecords_Params]
 
-	methods = append(methods, server.Method{
-		Method: capnp.Method{
-			InterfaceID:   0xfea5a1579f2ffa9e,
-			MethodID:      1,
-			InterfaceName: "structdef.capnp:API",
-			MethodName:    "add",
-		},
-		Impl: func(ctx context.Context, call *server.Call) error {
-			return s.Add(ctx, API_add{call})
-		},
-	})
+// NewAPI_upperRecords_Params creates a new list of API_upperRecords_Params.
+func NewAPI_upperRecords_Params_List(s *capnp.Segment, sz int32) (API_upperRecords_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[API_upperRecords_Params](l), err
+}
 
-	methods = append(methods, server.Method{
-		Method: capnp.Method{
-			InterfaceID:   0xfea5a1579f2ffa9e,
-			MethodID:      2,
-			InterfaceName: "structdef.capnp:API",
-			MethodName:    "multTree",
-		},
-		Impl: func(ctx context.Context, call *server.Call) error {
-			return s.MultTree(ctx, API_multTree{call})
-		},
-	})
+// API_upperRecords_Params_Future is a wrapper for a API_upperRecords_Params promised by a client call.
+type API_upperRecords_Params_Future struct{ *capnp.Future }
 
-	methods = append(methods, server.Method{
-		Method: capnp.Method{
-			InterfaceID:   0xfea5a1579f2ffa9e,
-			MethodID:      3,
-			InterfaceName: "structdef.capnp:API",
-			MethodName:    "toHex",
-		},
-		Impl: func(ctx context.Context, call *server.Call) error {
-			return s.ToHex(ctx, API_toHex{call})
-		},
-	})
+func (f API_upperRecords_Params_Future) Struct() (API_upperRecords_Params, error) {
+	p, err := f.Future.Ptr()
+	return API_upperRecords_Params(p.Struct()), err
+}
 
-	return methods
+type API_upperRecords_Results capnp.Struct
+
+// API_upperRecords_Results_TypeID is the unique identifier for the type API_upperRecords_Results.
+const API_upperRecords_Results_TypeID = 0xc3953629849d8ca8
+
+func NewAPI_upperRecords_Results(s *capnp.Segment) (API_upperRecords_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return API_upperRecords_Results(st), err
 }
 
-// API_nop holds the state for a server call to API.nop.
-// See server.Call for documentation.
-type API_nop struct {
-	*server.Call
+func NewRootAPI_upperRecords_Results(s *capnp.Segment) (API_upperRecords_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return API_upperRecords_Results(st), err
 }
 
-// Args returns the call's arguments.
-func (c API_nop) Args() API_nop_Params {
-	return API_nop_Params(c.Call.Args())
+func ReadRootAPI_upperRecords_Results(msg *capnp.Message) (API_upperRecords_Results, error) {
+	root, err := msg.Root()
+	return API_upperRecords_Results(root.Struct()), err
 }
 
-// AllocResults allocates the results struct.
-func (c API_nop) AllocResults() (API_nop_Results, error) {
-	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return API_nop_Results(r), err
+func (s API_upperRecords_Results) String() string {
+	str, _ := text.Marshal(0xc3953629849d8ca8, capnp.Struct(s))
+	return str
 }
 
-// API_add holds the state for a server call to API.add.
-// See server.Call for documentation.
-type API_add struct {
-	*server.Call
+func (s API_upperRecords_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-// Args returns the call's arguments.
-func (c API_add) Args() API_add_Params {
-	return API_add_Params(c.Call.Args())
+func (API_upperRecords_Results) DecodeFromPtr(p capnp.Ptr) API_upperRecords_Results {
+	return API_upperRecords_Results(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-// AllocResults allocates the results struct.
-func (c API_add) AllocResults() (API_add_Results, error) {
-	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return API_add_Results(r), err
+func (s API_upperRecords_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s API_upperRecords_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
 }
 
-// API_multTree holds the state for a server call to API.multTree.
-// See server.Call for documentation.
-type API_multTree struct {
-	*server.Call
+func (s API_upperRecords_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
 }
 
-// Args returns the call's arguments.
-func (c API_multTree) Args() API_multTree_Params {
-	return API_multTree_Params(c.Call.Args())
+func (s API_upperRecords_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s API_upperRecords_Results) Records() (Record_List, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return Record_List(p.List()), err
 }
 
-// AllocResults allocates the results struct.
-func (c API_multTree) AllocResults() (API_multTree_Results, error) {
-	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return API_multTree_Results(r), err
+func (s API_upperRecords_Results) HasRecords() bool {
+	return capnp.Struct(s).HasPtr(0)
 }
 
-// API_toHex holds the state for a server call to API.toHex.
-// See server.Call for documentation.
-type API_toHex struct {
-	*server.Call
+func (s API_upperRecords_Results) SetRecords(v Record_List) error {
+	return capnp.Struct(s).SetPtr(0, v.ToPtr())
 }
 
-// Args returns the call's arguments.
-func (c API_toHex) Args() API_toHex_Params {
-	return API_toHex_Params(c.Call.Args())
+// NewRecords sets the records field to a newly
+// allocated Record_List, preferring placement in s's segment.
+func (s API_upperRecords_Results) NewRecords(n int32) (Record_List, error) {
+	l, err := NewRecord_List(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return Record_List{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
+	return l, err
 }
 
-// AllocResults allocates the results struct.
-func (c API_toHex) AllocResults() (API_toHex_Results, error) {
-	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return API_toHex_Results(r), err
+// API_upperRecords_Results_List is a list of API_upperRecords_Results.
+type API_upperRecords_Results_List = capnp.StructList[API_upperRecords_Results]
+
+// NewAPI_upperRecords_Results creates a new list of API_upperRecords_Results.
+func NewAPI_upperRecords_Results_List(s *capnp.Segment, sz int32) (API_upperRecords_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[API_upperRecords_Results](l), err
 }
 
-// API_List is a list of API.
-type API_List = capnp.CapList[API]
+// API_upperRecords_Results_Future is a wrapper for a API_upperRecords_Results promised by a client call.
+type API_upperRecords_Results_Future struct{ *capnp.Future }
 
-// NewAPI creates a new list of API.
-func NewAPI_List(s *capnp.Segment, sz int32) (API_List, error) {
-	l, err := capnp.NewPointerList(s, sz)
-	return capnp.CapList[API](l), err
+func (f API_upperRecords_Results_Future) Struct() (API_upperRecords_Results, error) {
+	p, err := f.Future.Ptr()
+	return API_upperRecords_Results(p.Struct()), err
 }
 
-type API_nop_Params capnp.Struct
+type API_updateRows_Params capnp.Struct
 
-// API_nop_Params_TypeID is the unique identifier for the type API_nop_Params.
-const API_nop_Params_TypeID = 0xf9d58e206a93eb9c
+// API_updateRows_Params_TypeID is the unique identifier for the type API_updateRows_Params.
+const API_updateRows_Params_TypeID = 0xcc605450ba56eca0
 
-func NewAPI_nop_Params(s *capnp.Segment) (API_nop_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return API_nop_Params(st), err
+func NewAPI_updateRows_Params(s *capnp.Segment) (API_updateRows_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return API_updateRows_Params(st), err
 }
 
-func NewRootAPI_nop_Params(s *capnp.Segment) (API_nop_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return API_nop_Params(st), err
+func NewRootAPI_updateRows_Params(s *capnp.Segment) (API_updateRows_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return API_updateRows_Params(st), err
 }
 
-func ReadRootAPI_nop_Params(msg *capnp.Message) (API_nop_Params, error) {
+func ReadRootAPI_updateRows_Params(msg *capnp.Message) (API_updateRows_Params, error) {
 	root, err := msg.Root()
-	return API_nop_Params(root.Struct()), err
+	return API_updateRows_Params(root.Struct()), err
 }
 
-func (s API_nop_Params) String() string {
-	str, _ := text.Marshal(0xf9d58e206a93eb9c, capnp.Struct(s))
+func (s API_updateRows_Params) String() string {
+	str, _ := text.Marshal(0xcc605450ba56eca0, capnp.Struct(s))
 	return str
 }
 
-func (s API_nop_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s API_updateRows_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (API_nop_Params) DecodeFromPtr(p capnp.Ptr) API_nop_Params {
-	return API_nop_Params(capnp.Struct{}.DecodeFromPtr(p))
+func (API_updateRows_Params) DecodeFromPtr(p capnp.Ptr) API_updateRows_Params {
+	return API_updateRows_Params(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s API_nop_Params) ToPtr() capnp.Ptr {
+func (s API_updateRows_Params) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s API_nop_Params) IsValid() bool {
+func (s API_updateRows_Params) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s API_nop_Params) Message() *capnp.Message {
+func (s API_updateRows_Params) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s API_nop_Params) Segment() *capnp.Segment {
+func (s API_updateRows_Params) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
+func (s API_updateRows_Params) Rows() (Row_List, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return Row_List(p.List()), err
+}
 
-// API_nop_Params_List is a list of API_nop_Params.
-type API_nop_Params_List = capnp.StructList[API_nop_Params]
+func (s API_updateRows_Params) HasRows() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
 
-// NewAPI_nop_Params creates a new list of API_nop_Params.
-func NewAPI_nop_Params_List(s *capnp.Segment, sz int32) (API_nop_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return capnp.StructList[API_nop_Params](l), err
+func (s API_updateRows_Params) SetRows(v Row_List) error {
+	return capnp.Struct(s).SetPtr(0, v.ToPtr())
 }
 
-// API_nop_Params_Future is a wrapper for a API_nop_Params promised by a client call.
-type API_nop_Params_Future struct{ *capnp.Future }
+// NewRows sets the rows field to a newly
+// allocated Row_List, preferring placement in s's segment.
+func (s API_updateRows_Params) NewRows(n int32) (Row_List, error) {
+	l, err := NewRow_List(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return Row_List{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
+	return l, err
+}
 
-func (f API_nop_Params_Future) Struct() (API_nop_Params, error) {
+// API_updateRows_Params_List is a list of API_updateRows_Params.
+type API_updateRows_Params_List = capnp.StructList[API_updateRows_Params]
+
+// NewAPI_updateRows_Params creates a new list of API_updateRows_Params.
+func NewAPI_updateRows_Params_List(s *capnp.Segment, sz int32) (API_updateRows_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[API_updateRows_Params](l), err
+}
+
+// API_updateRows_Params_Future is a wrapper for a API_updateRows_Params promised by a client call.
+type API_updateRows_Params_Future struct{ *capnp.Future }
+
+func (f API_updateRows_Params_Future) Struct() (API_updateRows_Params, error) {
 	p, err := f.Future.Ptr()
-	return API_nop_Params(p.Struct()), err
+	return API_updateRows_Params(p.Struct()), err
 }
 
-type API_nop_Results capnp.Struct
+type API_updateRows_Results capnp.Struct
 
-// API_nop_Results_TypeID is the unique identifier for the type API_nop_Results.
-const API_nop_Results_TypeID = 0xfddd3379466d7927
+// API_updateRows_Results_TypeID is the unique identifier for the type API_updateRows_Results.
+const API_updateRows_Results_TypeID = 0xa9287c124a8d425a
 
-func NewAPI_nop_Results(s *capnp.Segment) (API_nop_Results, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return API_nop_Results(st), err
+func NewAPI_updateRows_Results(s *capnp.Segment) (API_updateRows_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return API_updateRows_Results(st), err
 }
 
-func NewRootAPI_nop_Results(s *capnp.Segment) (API_nop_Results, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return API_nop_Results(st), err
+func NewRootAPI_updateRows_Results(s *capnp.Segment) (API_updateRows_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return API_updateRows_Results(st), err
 }
 
-func ReadRootAPI_nop_Results(msg *capnp.Message) (API_nop_Results, error) {
+func ReadRootAPI_updateRows_Results(msg *capnp.Message) (API_updateRows_Results, error) {
 	root, err := msg.Root()
-	return API_nop_Results(root.Struct()), err
+	return API_updateRows_Results(root.Struct()), err
 }
 
-func (s API_nop_Results) String() string {
-	str, _ := text.Marshal(0xfddd3379466d7927, capnp.Struct(s))
+func (s API_updateRows_Results) String() string {
+	str, _ := text.Marshal(0xa9287c124a8d425a, capnp.Struct(s))
 	return str
 }
 
-func (s API_nop_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s API_updateRows_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (API_nop_Results) DecodeFromPtr(p capnp.Ptr) API_nop_Results {
-	return API_nop_Results(capnp.Struct{}.DecodeFromPtr(p))
+func (API_updateRows_Results) DecodeFromPtr(p capnp.Ptr) API_updateRows_Results {
+	return API_updateRows_Results(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s API_nop_Results) ToPtr() capnp.Ptr {
+func (s API_updateRows_Results) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s API_nop_Results) IsValid() bool {
+func (s API_updateRows_Results) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s API_nop_Results) Message() *capnp.Message {
+func (s API_updateRows_Results) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s API_nop_Results) Segment() *capnp.Segment {
+func (s API_updateRows_Results) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
+func (s API_updateRows_Results) Rows() (Row_List, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return Row_List(p.List()), err
+}
 
-// API_nop_Results_List is a list of API_nop_Results.
-type API_nop_Results_List = capnp.StructList[API_nop_Results]
+func (s API_updateRows_Results) HasRows() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
 
-// NewAPI_nop_Results creates a new list of API_nop_Results.
-func NewAPI_nop_Results_List(s *capnp.Segment, sz int32) (API_nop_Results_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return capnp.StructList[API_nop_Results](l), err
+func (s API_updateRows_Results) SetRows(v Row_List) error {
+	return capnp.Struct(s).SetPtr(0, v.ToPtr())
 }
 
-// API_nop_Results_Future is a wrapper for a API_nop_Results promised by a client call.
-type API_nop_Results_Future struct{ *capnp.Future }
+// NewRows sets the rows field to a newly
+// allocated Row_List, preferring placement in s's segment.
+func (s API_updateRows_Results) NewRows(n int32) (Row_List, error) {
+	l, err := NewRow_List(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return Row_List{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
+	return l, err
+}
 
-func (f API_nop_Results_Future) Struct() (API_nop_Results, error) {
+// API_updateRows_Results_List is a list of API_updateRows_Results.
+type API_updateRows_Results_List = capnp.StructList[API_updateRows_Results]
+
+// NewAPI_updateRows_Results creates a new list of API_updateRows_Results.
+func NewAPI_updateRows_Results_List(s *capnp.Segment, sz int32) (API_updateRows_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[API_updateRows_Results](l), err
+}
+
+// API_updateRows_Results_Future is a wrapper for a API_updateRows_Results promised by a client call.
+type API_updateRows_Results_Future struct{ *capnp.Future }
+
+func (f API_updateRows_Results_Future) Struct() (API_updateRows_Results, error) {
 	p, err := f.Future.Ptr()
-	return API_nop_Results(p.Struct()), err
+	return API_updateRows_Results(p.Struct()), err
 }
 
-type API_add_Params capnp.Struct
+type API_incrementMap_Params capnp.Struct
 
-// API_add_Params_TypeID is the unique identifier for the type API_add_Params.
-const API_add_Params_TypeID = 0xfbf5e01c6a8344e7
+// API_incrementMap_Params_TypeID is the unique identifier for the type API_incrementMap_Params.
+const API_incrementMap_Params_TypeID = 0x9bf4c150a92b5638
 
-func NewAPI_add_Params(s *capnp.Segment) (API_add_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
-	return API_add_Params(st), err
+func NewAPI_incrementMap_Params(s *capnp.Segment) (API_incrementMap_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return API_incrementMap_Params(st), err
 }
 
-func NewRootAPI_add_Params(s *capnp.Segment) (API_add_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
-	return API_add_Params(st), err
+func NewRootAPI_incrementMap_Params(s *capnp.Segment) (API_incrementMap_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return API_incrementMap_Params(st), err
 }
 
-func ReadRootAPI_add_Params(msg *capnp.Message) (API_add_Params, error) {
+func ReadRootAPI_incrementMap_Params(msg *capnp.Message) (API_incrementMap_Params, error) {
 	root, err := msg.Root()
-	return API_add_Params(root.Struct()), err
+	return API_incrementMap_Params(root.Struct()), err
 }
 
-func (s API_add_Params) String() string {
-	str, _ := text.Marshal(0xfbf5e01c6a8344e7, capnp.Struct(s))
+func (s API_incrementMap_Params) String() string {
+	str, _ := text.Marshal(0x9bf4c150a92b5638, capnp.Struct(s))
 	return str
 }
 
-func (s API_add_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s API_incrementMap_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (API_add_Params) DecodeFromPtr(p capnp.Ptr) API_add_Params {
-	return API_add_Params(capnp.Struct{}.DecodeFromPtr(p))
+func (API_incrementMap_Params) DecodeFromPtr(p capnp.Ptr) API_incrementMap_Params {
+	return API_incrementMap_Params(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s API_add_Params) ToPtr() capnp.Ptr {
+func (s API_incrementMap_Params) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s API_add_Params) IsValid() bool {
+func (s API_incrementMap_Params) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s API_add_Params) Message() *capnp.Message {
+func (s API_incrementMap_Params) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s API_add_Params) Segment() *capnp.Segment {
+func (s API_incrementMap_Params) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s API_add_Params) A() int64 {
-	return int64(capnp.Struct(s).Uint64(0))
+func (s API_incrementMap_Params) Entries() (MapEntry_List, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return MapEntry_List(p.List()), err
 }
 
-func (s API_add_Params) SetA(v int64) {
-	capnp.Struct(s).SetUint64(0, uint64(v))
+func (s API_incrementMap_Params) HasEntries() bool {
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s API_add_Params) B() int64 {
-	return int64(capnp.Struct(s).Uint64(8))
+func (s API_incrementMap_Params) SetEntries(v MapEntry_List) error {
+	return capnp.Struct(s).SetPtr(0, v.ToPtr())
 }
 
-func (s API_add_Params) SetB(v int64) {
-	capnp.Struct(s).SetUint64(8, uint64(v))
+// NewEntries sets the entries field to a newly
+// allocated MapEntry_List, preferring placement in s's segment.
+func (s API_incrementMap_Params) NewEntries(n int32) (MapEntry_List, error) {
+	l, err := NewMapEntry_List(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return MapEntry_List{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
+	return l, err
 }
 
-// API_add_Params_List is a list of API_add_Params.
-type API_add_Params_List = capnp.StructList[API_add_Params]
+// API_incrementMap_Params_List is a list of API_incrementMap_Params.
+type API_incrementMap_Params_List = capnp.StructList[API_incrementMap_Params]
 
-// NewAPI_add_Params creates a new list of API_add_Params.
-func NewAPI_add_Params_List(s *capnp.Segment, sz int32) (API_add_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0}, sz)
-	return capnp.StructList[API_add_Params](l), err
+// NewAPI_incrementMap_Params creates a new list of API_incrementMap_Params.
+func NewAPI_incrementMap_Params_List(s *capnp.Segment, sz int32) (API_incrementMap_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[API_incrementMap_Params](l), err
 }
 
-// API_add_Params_Future is a wrapper for a API_add_Params promised by a client call.
-type API_add_Params_Future struct{ *capnp.Future }
+// API_incrementMap_Params_Future is a wrapper for a API_incrementMap_Params promised by a client call.
+type API_incrementMap_Params_Future struct{ *capnp.Future }
 
-func (f API_add_Params_Future) Struct() (API_add_Params, error) {
+func (f API_incrementMap_Params_Future) Struct() (API_incrementMap_Params, error) {
 	p, err := f.Future.Ptr()
-	return API_add_Params(p.Struct()), err
+	return API_incrementMap_Params(p.Struct()), err
 }
 
-type API_add_Results capnp.Struct
+type API_incrementMap_Results capnp.Struct
 
-// API_add_Results_TypeID is the unique identifier for the type API_add_Results.
-const API_add_Results_TypeID = 0xce72004cadd1cdc5
+// API_incrementMap_Results_TypeID is the unique identifier for the type API_incrementMap_Results.
+const API_incrementMap_Results_TypeID = 0xc9dc42de048be549
 
-func NewAPI_add_Results(s *capnp.Segment) (API_add_Results, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return API_add_Results(st), err
+func NewAPI_incrementMap_Results(s *capnp.Segment) (API_incrementMap_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return API_incrementMap_Results(st), err
 }
 
-func NewRootAPI_add_Results(s *capnp.Segment) (API_add_Results, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return API_add_Results(st), err
+func NewRootAPI_incrementMap_Results(s *capnp.Segment) (API_incrementMap_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return API_incrementMap_Results(st), err
 }
 
-func ReadRootAPI_add_Results(msg *capnp.Message) (API_add_Results, error) {
+func ReadRootAPI_incrementMap_Results(msg *capnp.Message) (API_incrementMap_Results, error) {
 	root, err := msg.Root()
-	return API_add_Results(root.Struct()), err
+	return API_incrementMap_Results(root.Struct()), err
 }
 
-func (s API_add_Results) String() string {
-	str, _ := text.Marshal(0xce72004cadd1cdc5, capnp.Struct(s))
+func (s API_incrementMap_Results) String() string {
+	str, _ := text.Marshal(0xc9dc42de048be549, capnp.Struct(s))
 	return str
 }
 
-func (s API_add_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s API_incrementMap_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (API_add_Results) DecodeFromPtr(p capnp.Ptr) API_add_Results {
-	return API_add_Results(capnp.Struct{}.DecodeFromPtr(p))
+func (API_incrementMap_Results) DecodeFromPtr(p capnp.Ptr) API_incrementMap_Results {
+	return API_incrementMap_Results(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s API_add_Results) ToPtr() capnp.Ptr {
+func (s API_incrementMap_Results) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s API_add_Results) IsValid() bool {
+func (s API_incrementMap_Results) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s API_add_Results) Message() *capnp.Message {
+func (s API_incrementMap_Results) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s API_add_Results) Segment() *capnp.Segment {
+func (s API_incrementMap_Results) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s API_add_Results) Res() int64 {
-	return int64(capnp.Struct(s).Uint64(0))
+func (s API_incrementMap_Results) Entries() (MapEntry_List, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return MapEntry_List(p.List()), err
 }
 
-func (s API_add_Results) SetRes(v int64) {
-	capnp.Struct(s).SetUint64(0, uint64(v))
+func (s API_incrementMap_Results) HasEntries() bool {
+	return capnp.Struct(s).HasPtr(0)
 }
 
-// API_add_Results_List is a list of API_add_Results.
-type API_add_Results_List = capnp.StructList[API_add_Results]
+func (s API_incrementMap_Results) SetEntries(v MapEntry_List) error {
+	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+}
 
-// NewAPI_add_Results creates a new list of API_add_Results.
-func NewAPI_add_Results_List(s *capnp.Segment, sz int32) (API_add_Results_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
-	return capnp.StructList[API_add_Results](l), err
+// NewEntries sets the entries field to a newly
+// allocated MapEntry_List, preferring placement in s's segment.
+func (s API_incrementMap_Results) NewEntries(n int32) (MapEntry_List, error) {
+	l, err := NewMapEntry_List(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return MapEntry_List{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
+	return l, err
 }
 
-// API_add_Results_Future is a wrapper for a API_add_Results promised by a client call.
-type API_add_Results_Future struct{ *capnp.Future }
+// API_incrementMap_Results_List is a list of API_incrementMap_Results.
+type API_incrementMap_Results_List = capnp.StructList[API_incrementMap_Results]
 
-func (f API_add_Results_Future) Struct() (API_add_Results, error) {
+// NewAPI_incrementMap_Results creates a new list of API_incrementMap_Results.
+func NewAPI_incrementMap_Results_List(s *capnp.Segment, sz int32) (API_incrementMap_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[API_incrementMap_Results](l), err
+}
+
+// API_incrementMap_Results_Future is a wrapper for a API_incrementMap_Results promised by a client call.
+type API_incrementMap_Results_Future struct{ *capnp.Future }
+
+func (f API_incrementMap_Results_Future) Struct() (API_incrementMap_Results, error) {
 	p, err := f.Future.Ptr()
-	return API_add_Results(p.Struct()), err
+	return API_incrementMap_Results(p.Struct()), err
 }
 
-type API_multTree_Params capnp.Struct
+type API_scaleVector_Params capnp.Struct
 
-// API_multTree_Params_TypeID is the unique identifier for the type API_multTree_Params.
-const API_multTree_Params_TypeID = 0xb91ace4c4a633a57
+// API_scaleVector_Params_TypeID is the unique identifier for the type API_scaleVector_Params.
+const API_scaleVector_Params_TypeID = 0x80f7a398fc4acbb5
 
-func NewAPI_multTree_Params(s *capnp.Segment) (API_multTree_Params, error) {
+func NewAPI_scaleVector_Params(s *capnp.Segment) (API_scaleVector_Params, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return API_multTree_Params(st), err
+	return API_scaleVector_Params(st), err
 }
 
-func NewRootAPI_multTree_Params(s *capnp.Segment) (API_multTree_Params, error) {
+func NewRootAPI_scaleVector_Params(s *capnp.Segment) (API_scaleVector_Params, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return API_multTree_Params(st), err
+	return API_scaleVector_Params(st), err
 }
 
-func ReadRootAPI_multTree_Params(msg *capnp.Message) (API_multTree_Params, error) {
+func ReadRootAPI_scaleVector_Params(msg *capnp.Message) (API_scaleVector_Params, error) {
 	root, err := msg.Root()
-	return API_multTree_Params(root.Struct()), err
+	return API_scaleVector_Params(root.Struct()), err
 }
 
-func (s API_multTree_Params) String() string {
-	str, _ := text.Marshal(0xb91ace4c4a633a57, capnp.Struct(s))
+func (s API_scaleVector_Params) String() string {
+	str, _ := text.Marshal(0x80f7a398fc4acbb5, capnp.Struct(s))
 	return str
 }
 
-func (s API_multTree_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s API_scaleVector_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (API_multTree_Params) DecodeFromPtr(p capnp.Ptr) API_multTree_Params {
-	return API_multTree_Params(capnp.Struct{}.DecodeFromPtr(p))
+func (API_scaleVector_Params) DecodeFromPtr(p capnp.Ptr) API_scaleVector_Params {
+	return API_scaleVector_Params(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s API_multTree_Params) ToPtr() capnp.Ptr {
+func (s API_scaleVector_Params) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s API_multTree_Params) IsValid() bool {
+func (s API_scaleVector_Params) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s API_multTree_Params) Message() *capnp.Message {
+func (s API_scaleVector_Params) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s API_multTree_Params) Segment() *capnp.Segment {
+func (s API_scaleVector_Params) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s API_multTree_Params) Mult() int64 {
-	return int64(capnp.Struct(s).Uint64(0))
-}
-
-func (s API_multTree_Params) SetMult(v int64) {
-	capnp.Struct(s).SetUint64(0, uint64(v))
-}
-
-func (s API_multTree_Params) Tree() (TreeNode, error) {
+func (s API_scaleVector_Params) Values() (capnp.Float64List, error) {
 	p, err := capnp.Struct(s).Ptr(0)
-	return TreeNode(p.Struct()), err
+	return capnp.Float64List(p.List()), err
 }
 
-func (s API_multTree_Params) HasTree() bool {
+func (s API_scaleVector_Params) HasValues() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s API_multTree_Params) SetTree(v TreeNode) error {
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s API_scaleVector_Params) SetValues(v capnp.Float64List) error {
+	return capnp.Struct(s).SetPtr(0, v.ToPtr())
 }
 
-// NewTree sets the tree field to a newly
-// allocated TreeNode struct, preferring placement in s's segment.
-func (s API_multTree_Params) NewTree() (TreeNode, error) {
-	ss, err := NewTreeNode(capnp.Struct(s).Segment())
+// NewValues sets the values field to a newly
+// allocated capnp.Float64List, preferring placement in s's segment.
+func (s API_scaleVector_Params) NewValues(n int32) (capnp.Float64List, error) {
+	l, err := capnp.NewFloat64List(capnp.Struct(s).Segment(), n)
 	if err != nil {
-		return TreeNode{}, err
+		return capnp.Float64List{}, err
 	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
+	return l, err
+}
+func (s API_scaleVector_Params) Scale() float64 {
+	return math.Float64frombits(capnp.Struct(s).Uint64(0))
 }
 
-// API_multTree_Params_List is a list of API_multTree_Params.
-type API_multTree_Params_List = capnp.StructList[API_multTree_Params]
+func (s API_scaleVector_Params) SetScale(v float64) {
+	capnp.Struct(s).SetUint64(0, math.Float64bits(v))
+}
 
-// NewAPI_multTree_Params creates a new list of API_multTree_Params.
-func NewAPI_multTree_Params_List(s *capnp.Segment, sz int32) (API_multTree_Params_List, error) {
+// API_scaleVector_Params_List is a list of API_scaleVector_Params.
+type API_scaleVector_Params_List = capnp.StructList[API_scaleVector_Params]
+
+// NewAPI_scaleVector_Params creates a new list of API_scaleVector_Params.
+func NewAPI_scaleVector_Params_List(s *capnp.Segment, sz int32) (API_scaleVector_Params_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
-	return capnp.StructList[API_multTree_Params](l), err
+	return capnp.StructList[API_scaleVector_Params](l), err
 }
 
-// API_multTree_Params_Future is a wrapper for a API_multTree_Params promised by a client call.
-type API_multTree_Params_Future struct{ *capnp.Future }
+// API_scaleVector_Params_Future is a wrapper for a API_scaleVector_Params promised by a client call.
+type API_scaleVector_Params_Future struct{ *capnp.Future }
 
-func (f API_multTree_Params_Future) Struct() (API_multTree_Params, error) {
+func (f API_scaleVector_Params_Future) Struct() (API_scaleVector_Params, error) {
 	p, err := f.Future.Ptr()
-	return API_multTree_Params(p.Struct()), err
-}
-func (p API_multTree_Params_Future) Tree() TreeNode_Future {
-	return TreeNode_Future{Future: p.Future.Field(0, nil)}
+	return API_scaleVector_Params(p.Struct()), err
 }
 
-type API_multTree_Results capnp.Struct
+type API_scaleVector_Results capnp.Struct
 
-// API_multTree_Results_TypeID is the unique identifier for the type API_multTree_Results.
-const API_multTree_Results_TypeID = 0x983900eb0fa214ee
+// API_scaleVector_Results_TypeID is the unique identifier for the type API_scaleVector_Results.
+const API_scaleVector_Results_TypeID = 0xe6cc1430d53df7e4
 
-func NewAPI_multTree_Results(s *capnp.Segment) (API_multTree_Results, error) {
+func NewAPI_scaleVector_Results(s *capnp.Segment) (API_scaleVector_Results, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return API_multTree_Results(st), err
+	return API_scaleVector_Results(st), err
 }
 
-func NewRootAPI_multTree_Results(s *capnp.Segment) (API_multTree_Results, error) {
+func NewRootAPI_scaleVector_Results(s *capnp.Segment) (API_scaleVector_Results, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return API_multTree_Results(st), err
+	return API_scaleVector_Results(st), err
 }
 
-func ReadRootAPI_multTree_Results(msg *capnp.Message) (API_multTree_Results, error) {
+func ReadRootAPI_scaleVector_Results(msg *capnp.Message) (API_scaleVector_Results, error) {
 	root, err := msg.Root()
-	return API_multTree_Results(root.Struct()), err
+	return API_scaleVector_Results(root.Struct()), err
 }
 
-func (s API_multTree_Results) String() string {
-	str, _ := text.Marshal(0x983900eb0fa214ee, capnp.Struct(s))
+func (s API_scaleVector_Results) String() string {
+	str, _ := text.Marshal(0xe6cc1430d53df7e4, capnp.Struct(s))
 	return str
 }
 
-func (s API_multTree_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s API_scaleVector_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (API_multTree_Results) DecodeFromPtr(p capnp.Ptr) API_multTree_Results {
-	return API_multTree_Results(capnp.Struct{}.DecodeFromPtr(p))
+func (API_scaleVector_Results) DecodeFromPtr(p capnp.Ptr) API_scaleVector_Results {
+	return API_scaleVector_Results(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s API_multTree_Results) ToPtr() capnp.Ptr {
+func (s API_scaleVector_Results) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s API_multTree_Results) IsValid() bool {
+func (s API_scaleVector_Results) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s API_multTree_Results) Message() *capnp.Message {
+func (s API_scaleVector_Results) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s API_multTree_Results) Segment() *capnp.Segment {
+func (s API_scaleVector_Results) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s API_multTree_Results) Res() (TreeNode, error) {
+func (s API_scaleVector_Results) Values() (capnp.Float64List, error) {
 	p, err := capnp.Struct(s).Ptr(0)
-	return TreeNode(p.Struct()), err
+	return capnp.Float64List(p.List()), err
 }
 
-func (s API_multTree_Results) HasRes() bool {
+func (s API_scaleVector_Results) HasValues() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s API_multTree_Results) SetRes(v TreeNode) error {
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s API_scaleVector_Results) SetValues(v capnp.Float64List) error {
+	return capnp.Struct(s).SetPtr(0, v.ToPtr())
 }
 
-// NewRes sets the res field to a newly
-// allocated TreeNode struct, preferring placement in s's segment.
-func (s API_multTree_Results) NewRes() (TreeNode, error) {
-	ss, err := NewTreeNode(capnp.Struct(s).Segment())
+// NewValues sets the values field to a newly
+// allocated capnp.Float64List, preferring placement in s's segment.
+func (s API_scaleVector_Results) NewValues(n int32) (capnp.Float64List, error) {
+	l, err := capnp.NewFloat64List(capnp.Struct(s).Segment(), n)
 	if err != nil {
-		return TreeNode{}, err
+		return capnp.Float64List{}, err
 	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
+	return l, err
 }
 
-// API_multTree_Results_List is a list of API_multTree_Results.
-type API_multTree_Results_List = capnp.StructList[API_multTree_Results]
+// API_scaleVector_Results_List is a list of API_scaleVector_Results.
+type API_scaleVector_Results_List = capnp.StructList[API_scaleVector_Results]
 
-// NewAPI_multTree_Results creates a new list of API_multTree_Results.
-func NewAPI_multTree_Results_List(s *capnp.Segment, sz int32) (API_multTree_Results_List, error) {
+// NewAPI_scaleVector_Results creates a new list of API_scaleVector_Results.
+func NewAPI_scaleVector_Results_List(s *capnp.Segment, sz int32) (API_scaleVector_Results_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[API_multTree_Results](l), err
+	return capnp.StructList[API_scaleVector_Results](l), err
 }
 
-// API_multTree_Results_Future is a wrapper for a API_multTree_Results promised by a client call.
-type API_multTree_Results_Future struct{ *capnp.Future }
+// API_scaleVector_Results_Future is a wrapper for a API_scaleVector_Results promised by a client call.
+type API_scaleVector_Results_Future struct{ *capnp.Future }
 
-func (f API_multTree_Results_Future) Struct() (API_multTree_Results, error) {
+func (f API_scaleVector_Results_Future) Struct() (API_scaleVector_Results, error) {
 	p, err := f.Future.Ptr()
-	return API_multTree_Results(p.Struct()), err
-}
-func (p API_multTree_Results_Future) Res() TreeNode_Future {
-	return TreeNode_Future{Future: p.Future.Field(0, nil)}
+	return API_scaleVector_Results(p.Struct()), err
 }
 
-type API_toHex_Params capnp.Struct
+type API_nopWithMetadata_Params capnp.Struct
 
-// API_toHex_Params_TypeID is the unique identifier for the type API_toHex_Params.
-const API_toHex_Params_TypeID = 0x880f4d13f4a8eb97
+// API_nopWithMetadata_Params_TypeID is the unique identifier for the type API_nopWithMetadata_Params.
+const API_nopWithMetadata_Params_TypeID = 0xcbe82d71af9e5770
 
-func NewAPI_toHex_Params(s *capnp.Segment) (API_toHex_Params, error) {
+func NewAPI_nopWithMetadata_Params(s *capnp.Segment) (API_nopWithMetadata_Params, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return API_toHex_Params(st), err
+	return API_nopWithMetadata_Params(st), err
 }
 
-func NewRootAPI_toHex_Params(s *capnp.Segment) (API_toHex_Params, error) {
+func NewRootAPI_nopWithMetadata_Params(s *capnp.Segment) (API_nopWithMetadata_Params, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return API_toHex_Params(st), err
+	return API_nopWithMetadata_Params(st), err
 }
 
-func ReadRootAPI_toHex_Params(msg *capnp.Message) (API_toHex_Params, error) {
+func ReadRootAPI_nopWithMetadata_Params(msg *capnp.Message) (API_nopWithMetadata_Params, error) {
 	root, err := msg.Root()
-	return API_toHex_Params(root.Struct()), err
+	return API_nopWithMetadata_Params(root.Struct()), err
 }
 
-func (s API_toHex_Params) String() string {
-	str, _ := text.Marshal(0x880f4d13f4a8eb97, capnp.Struct(s))
+func (s API_nopWithMetadata_Params) String() string {
+	str, _ := text.Marshal(0xcbe82d71af9e5770, capnp.Struct(s))
 	return str
 }
 
-func (s API_toHex_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s API_nopWithMetadata_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (API_toHex_Params) DecodeFromPtr(p capnp.Ptr) API_toHex_Params {
-	return API_toHex_Params(capnp.Struct{}.DecodeFromPtr(p))
+func (API_nopWithMetadata_Params) DecodeFromPtr(p capnp.Ptr) API_nopWithMetadata_Params {
+	return API_nopWithMetadata_Params(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s API_toHex_Params) ToPtr() capnp.Ptr {
+func (s API_nopWithMetadata_Params) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s API_toHex_Params) IsValid() bool {
+func (s API_nopWithMetadata_Params) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s API_toHex_Params) Message() *capnp.Message {
+func (s API_nopWithMetadata_Params) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s API_toHex_Params) Segment() *capnp.Segment {
+func (s API_nopWithMetadata_Params) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s API_toHex_Params) In() ([]byte, error) {
+func (s API_nopWithMetadata_Params) Metadata() (Metadata, error) {
 	p, err := capnp.Struct(s).Ptr(0)
-	return []byte(p.Data()), err
+	return Metadata(p.Struct()), err
 }
 
-func (s API_toHex_Params) HasIn() bool {
+func (s API_nopWithMetadata_Params) HasMetadata() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s API_toHex_Params) SetIn(v []byte) error {
-	return capnp.Struct(s).SetData(0, v)
+func (s API_nopWithMetadata_Params) SetMetadata(v Metadata) error {
+	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
 }
 
-// API_toHex_Params_List is a list of API_toHex_Params.
-type API_toHex_Params_List = capnp.StructList[API_toHex_Params]
+// NewMetadata sets the metadata field to a newly
+// allocated Metadata struct, preferring placement in s's segment.
+func (s API_nopWithMetadata_Params) NewMetadata() (Metadata, error) {
+	ss, err := NewMetadata(capnp.Struct(s).Segment())
+	if err != nil {
+		return Metadata{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
 
-// NewAPI_toHex_Params creates a new list of API_toHex_Params.
-func NewAPI_toHex_Params_List(s *capnp.Segment, sz int32) (API_toHex_Params_List, error) {
+// API_nopWithMetadata_Params_List is a list of API_nopWithMetadata_Params.
+type API_nopWithMetadata_Params_List = capnp.StructList[API_nopWithMetadata_Params]
+
+// NewAPI_nopWithMetadata_Params creates a new list of API_nopWithMetadata_Params.
+func NewAPI_nopWithMetadata_Params_List(s *capnp.Segment, sz int32) (API_nopWithMetadata_Params_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[API_toHex_Params](l), err
+	return capnp.StructList[API_nopWithMetadata_Params](l), err
 }
 
-// API_toHex_Params_Future is a wrapper for a API_toHex_Params promised by a client call.
-type API_toHex_Params_Future struct{ *capnp.Future }
+// API_nopWithMetadata_Params_Future is a wrapper for a API_nopWithMetadata_Params promised by a client call.
+type API_nopWithMetadata_Params_Future struct{ *capnp.Future }
 
-func (f API_toHex_Params_Future) Struct() (API_toHex_Params, error) {
+func (f API_nopWithMetadata_Params_Future) Struct() (API_nopWithMetadata_Params, error) {
 	p, err := f.Future.Ptr()
-	return API_toHex_Params(p.Struct()), err
+	return API_nopWithMetadata_Params(p.Struct()), err
+}
+func (p API_nopWithMetadata_Params_Future) Metadata() Metadata_Future {
+	return Metadata_Future{Future: p.Future.Field(0, nil)}
 }
 
-type API_toHex_Results capnp.Struct
+type API_nopWithMetadata_Results capnp.Struct
 
-// API_toHex_Results_TypeID is the unique identifier for the type API_toHex_Results.
-const API_toHex_Results_TypeID = 0x890d0dbe87503908
+// API_nopWithMetadata_Results_TypeID is the unique identifier for the type API_nopWithMetadata_Results.
+const API_nopWithMetadata_Results_TypeID = 0xe45ff6bc502fdcf2
 
-func NewAPI_toHex_Results(s *capnp.Segment) (API_toHex_Results, error) {
+func NewAPI_nopWithMetadata_Results(s *capnp.Segment) (API_nopWithMetadata_Results, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return API_toHex_Results(st), err
+	return API_nopWithMetadata_Results(st), err
 }
 
-func NewRootAPI_toHex_Results(s *capnp.Segment) (API_toHex_Results, error) {
+func NewRootAPI_nopWithMetadata_Results(s *capnp.Segment) (API_nopWithMetadata_Results, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return API_toHex_Results(st), err
+	return API_nopWithMetadata_Results(st), err
 }
 
-func ReadRootAPI_toHex_Results(msg *capnp.Message) (API_toHex_Results, error) {
+func ReadRootAPI_nopWithMetadata_Results(msg *capnp.Message) (API_nopWithMetadata_Results, error) {
 	root, err := msg.Root()
-	return API_toHex_Results(root.Struct()), err
+	return API_nopWithMetadata_Results(root.Struct()), err
 }
 
-func (s API_toHex_Results) String() string {
-	str, _ := text.Marshal(0x890d0dbe87503908, capnp.Struct(s))
+func (s API_nopWithMetadata_Results) String() string {
+	str, _ := text.Marshal(0xe45ff6bc502fdcf2, capnp.Struct(s))
 	return str
 }
 
-func (s API_toHex_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s API_nopWithMetadata_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (API_toHex_Results) DecodeFromPtr(p capnp.Ptr) API_toHex_Results {
-	return API_toHex_Results(capnp.Struct{}.DecodeFromPtr(p))
+func (API_nopWithMetadata_Results) DecodeFromPtr(p capnp.Ptr) API_nopWithMetadata_Results {
+	return API_nopWithMetadata_Results(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s API_toHex_Results) ToPtr() capnp.Ptr {
+func (s API_nopWithMetadata_Results) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s API_toHex_Results) IsValid() bool {
+func (s API_nopWithMetadata_Results) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s API_toHex_Results) Message() *capnp.Message {
+func (s API_nopWithMetadata_Results) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s API_toHex_Results) Segment() *capnp.Segment {
+func (s API_nopWithMetadata_Results) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s API_toHex_Results) Out() ([]byte, error) {
+func (s API_nopWithMetadata_Results) Echo() (string, error) {
 	p, err := capnp.Struct(s).Ptr(0)
-	return []byte(p.Data()), err
+	return p.Text(), err
 }
 
-func (s API_toHex_Results) HasOut() bool {
+func (s API_nopWithMetadata_Results) HasEcho() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s API_toHex_Results) SetOut(v []byte) error {
-	return capnp.Struct(s).SetData(0, v)
+func (s API_nopWithMetadata_Results) EchoBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
 }
 
-// API_toHex_Results_List is a list of API_toHex_Results.
-type API_toHex_Results_List = capnp.StructList[API_toHex_Results]
+func (s API_nopWithMetadata_Results) SetEcho(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
 
-// NewAPI_toHex_Results creates a new list of API_toHex_Results.
-func NewAPI_toHex_Results_List(s *capnp.Segment, sz int32) (API_toHex_Results_List, error) {
+// API_nopWithMetadata_Results_List is a list of API_nopWithMetadata_Results.
+type API_nopWithMetadata_Results_List = capnp.StructList[API_nopWithMetadata_Results]
+
+// NewAPI_nopWithMetadata_Results creates a new list of API_nopWithMetadata_Results.
+func NewAPI_nopWithMetadata_Results_List(s *capnp.Segment, sz int32) (API_nopWithMetadata_Results_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[API_toHex_Results](l), err
+	return capnp.StructList[API_nopWithMetadata_Results](l), err
 }
 
-// API_toHex_Results_Future is a wrapper for a API_toHex_Results promised by a client call.
-type API_toHex_Results_Future struct{ *capnp.Future }
+// API_nopWithMetadata_Results_Future is a wrapper for a API_nopWithMetadata_Results promised by a client call.
+type API_nopWithMetadata_Results_Future struct{ *capnp.Future }
 
-func (f API_toHex_Results_Future) Struct() (API_toHex_Results, error) {
+func (f API_nopWithMetadata_Results_Future) Struct() (API_nopWithMetadata_Results, error) {
 	p, err := f.Future.Ptr()
-	return API_toHex_Results(p.Struct()), err
-}
-
-const schema_d9767bf36f62edd8 = "x\xda\x8cTOH\x14a\x14\x7f\xbf\xef\x9bu$X" +
-	"w\xc7\xd9\x93\x04\x96,T\xa2\xe6\x9f\x84V0wA" +
-	"DEc\xbe\x08\xc4\xe3\xb8;\x91\xb2\xff\x9a\x9d\xd5\xa4" +
-	"St\xa8.]:\xe4!*\x85\xa0\x02\x0f\x1d\xbbt" +
-	"\xebP\x90\xe0!\xccC\xd8\xad\x83\xd4\xa5:\xa4\xd4\xc4" +
-	"7\xbb;\xb3hj\xb7e\x7f\xef\xfd\xfe\xbco\xde\xeb" +
-	"~\x81\xa4\xd2\x13\xdeP\x88\x89\xf3\xa1\x06\xf7\xc1\xf6\xb3" +
-	"\x1f\xfad\xe4\x0ei1\x10\x85\xa0\x12\xf5\x09v\x0c\x04" +
-	"}\x9a\x0d\x11\xdc\xc6\x84q\xfbu8|\xb7\xbe`\x91" +
-	"5\xcb\x82\x9b\xb2`\xeb[l%\xb2\x9dX\xaa\x83\x97" +
-	"Y\xbb\x84\x9f{\xfdS\x03\xe9\xf1\x89\xb5\x96W$b" +
-	"\xf0+\xde\xb26Y\xb1\xce\x16\x08[o\xde\xaf\xafN" +
-	"\xd8k\x15\\\x91p'g\x12\xee\xe1\x92\xe0\xe1\xf6\xfd" +
-	"\xb9\x13\xf7>\xfc\xaa\x18\x90\xb8.\xf8\x0e)n\xfc\xc2" +
-	"\x93\xc1\x8d\xe1\x91]\x12Q\xc0\xfd\xf8u\xa6\xf0\xfd\xc6" +
-	"\xfcfEA\xef\xe7\xef\xf4\x14\x97\xbf\x06\xf9\x02\xc1\xfd" +
-	"2|k\xee\xf8\xe7\x9f\xbb\xd2\x06\xab\xd2\xac\xf2\x1d\x82" +
-	"\xfe\xd2\xc3O-\xe6F\x16\xfb>\xfd\x0eT\xfa\xc2\x8a" +
-	"\xe7BS\xa4\x8bG;g\x1fO-?\xfdCZ\x94" +
-	"\x07Z\xd2\xa4\xb2\xa2'\xbc\xfa~E\x85\xbe\xa9\xa8\xd4" +
-	"\xe1\x96\x1c\xbb\x9cv2\x16\xbf\xd2\x956\x8b\xf9\xe2@" +
-	"\xca\x18\xebr\x0a\xa3\xd6\xf5\xb8a\xdaf\xaeD$\x14" +
-	"\xae\x10) \xd2\xc2-D\xa2\x91C\xc4\x18\xf8l\x1e" +
-	"ab\x08\x13\x0ec\xb9d\x95\xcaY\x07\xa5z\x96\xb6" +
-	"\x80E-\x94\x9d\xc3ir\xe5\xacs\xd9\xb6,\xc9\x14" +
-	")g\x9d\x03\x99l\xab\x84h0k\x02\xa2Gr\x1a" +
-	"\xa6\xad\x9a\xb9\x92h\xf4)\xcf\xb4\x13\x898\x87\xe8f" +
-	"\x00\xbc\x97\xd6:\xe5\x7f\xa79\xc49\x86\x88\xecE\x88" +
-	"\x18B\x84\x88c[\xd6\xff\x8a\x9a\x99Lu\x1a%:" +
-	",C\x95\xdb'a\xf5$\xf9B1>Ty\x99\xfd" +
-	"\x052\xd2\xc5B\xc6\"2\x80\xfaL\xbd\xff\xc84N" +
-	"$:8\xc4(C\xeb\xbc\x99-[\xbep\xfa\xeal" +
-	"6c[y\"B\x13\xc1\xe0\xd8\x13\xb1\xe9 w2" +
-	"b\xd5]\xbd|s \xaf\xf9\xfa\xcd\xc1La\xd6\xb4" +
-	"1\xb3/>\xdf\x1b\xff\xa8\x19\xe6\x0bEj\xf0\xdbQ" +
-	"kWS\xc6\x98\x1cK\x94\x87\x88\xfc]Em\x9d\xb4" +
-	"km\xc44KE\xb0\x81\xf0\xf6\x9d\xec5mZb" +
-	"\x93*\x98\x7f$\xe0\x1d\x13J,i\xa9qbZB" +
-	"\x05\xf7/\x14j\x97H\xeb\xec%\xa6\x9dT\xa5\xa5$" +
-	"T3\x93I\xc2\xad}{D\x94D\xab\xb7$I\x18" +
-	"\xc0\xdf\x00\x00\x00\xff\xff\x0f\x0f6\xaf"
+	return API_nopWithMetadata_Results(p.Struct()), err
+}
+
+const schema_d9767bf36f62edd8 = "x\xda\xa4Z}\x94\x14\xd5\x95\xbf\xb7\xaa\x87\x9a\x8f\x1e" +
+	"z\x8aW\xf3\xd1==6\xc1\xe1,N\x84\xc0\xa8\x1b" +
+	"\xc0\x90i\x08*3\x07<\xdd3\xac '\xbbZ\xd3" +
+	"\xfddZ\xfa\xcb\xea\xea\x19p\xd7%n\xa2\xc8\xc9\x8e" +
+	"\x07\xa3\xb8\xba\x86DL\xd8#GYt#\x89\xb8\xe2" +
+	"\x8a\xc8n@\xf4\x04\x8fde#\x1b\x92\x03Q\x17&" +
+	"Y\\\x17\x96\xaf\xed=\xf7uWu\xf5t\x0f3\x8b" +
+	"\xffu\xfd\xde\xad\xfb\xee\xd7\xbb\xf7\xd6\xbb=\xfb\x1fj" +
+	"\x83\xae9\xf5;\x1bA\x0a_\xaa\x9a\x94[\xfd\xeab" +
+	"\xffwo{\xed[\x10\xd6\x10\x01\\\x0a\xc0\x0d{j" +
+	";\x10\x90\xed\xaf\xed\x02\xcc\xedz\xa7\xe7\xd2S?:" +
+	"g\x11T!Q\x9c\xac\x9dO\x14#\xb5C\x80\xb9S" +
+	"gS\x8f\xaeP\\\x0fC\xd8k\xb3\xb8\xb3N\xb0\xd0" +
+	"\xeb\x88\xc5\xdf\x9cz\xfes\xb6\xcc\xf3\x08\xa8\x9a\xcd\xe1" +
+	"\xc1\xbaZ\"\xd8(\x08\xfe\xf1\xe2\xfa\xaf\xae\\\xfd\xf1" +
+	"#\x10\x0e\xd8\x1c\xb6\xd7\xf5\x13\xc1.AP=/\xb4" +
+	"\xe1\x8d\xfa\xfa\x8dN\x0eG\xea\xa6\x10\xc11A\xe0Z" +
+	"w\xe9\xc2\x9b\xdf\xbcs\x18\xc2\xcd6\x87\xcby\x82*" +
+	"\xb7Pc\xcf\x9d\x1d\xa7\xf7\xb6o\"5$\x8b\xc5t" +
+	"\xf74\xa2\x98\xe9\xde\x09\x98{\xe6\xe4\xcd\xef\xb7\xbc1" +
+	"\xb2\xc9\xb9\xc7~w'\x11\xbc+X\\{\xb3\xfc[" +
+	"E\xf9ds\x9e@l1\xe2^\x84\xe0\xca\x19\xe7\x0e" +
+	"^\xa3\xbc0\xf8\xa4c\xe5\x88\xbb\x16\xc1\xf5\xeb\xdfk" +
+	"\xcfyN\xcd{\xca\xc1r\x8f;o\\\xc1\xf2\x95U" +
+	"[\xef\xf1y\xee\xfa[\x087`}\xee\xc3\x91\xfe\xd4" +
+	"\x7f\xfd\xf9\xe0Q\xa8\x9a\xac\x00\xb0\x93\xee\xe7\xd8\x88\x9b" +
+	"\xde\xf9\xd4\xdd\xa5\x00\xe6\xe6\xde\xf1\xe5\xed\xa1\xbd\x9f?" +
+	"\xe3\x94\xf0\x80\xb6\x88\xd8\x1d\xd6\x88\xddW\x1b;\xaa\xa7" +
+	"\xeel\xdb\xe2\x90\x03\x1b;H\xc2\xb6?\xdb\xfd/\x9e" +
+	"\xc7wn\x85\xb0\xcf\xb6\xcf\xa7\x9ap\xe2\x19\xf1\xea!" +
+	"\xf3\xa1\x97N\x1c\xb9\xf4w%\xf6il\x14\xcc\xdb\x1a" +
+	"\xc9>\xab\x16\x0d\xf7L\xf9\x8b\x19\xdb\x9d\xbb\xefj\x14" +
+	",\xf64\x12\x8b\x0d\xf1\xd0\xedw\xef\xbd\xf6eP\xbd" +
+	"\xb6}\x1a;i\xf7\xee\x87\xd7.\xbc6w\xe4'%" +
+	"\xeek\x94\xe8\xd5\xa3\xe2\xd5\xc4\xae'\xd3\xb5S\x16\xbf" +
+	"R\xd8]\xbc{\xbeQ\xd8\x1e\x9b(\xc6\xfe\xb0\xf7\xc0" +
+	"\xca\xc7~\xf6\xc2O\x9d\x11\xa27\xad\"\x82D\x13q" +
+	"\xf8\xed\x91\xfb\x12\xf3Vv\xbc\xea\xdcb\xb8I\xc4\xd8" +
+	"fA\xb0b~\xa4g\xe9{\xbe\xdd%q\xbc\xabI" +
+	"\x04\xc0\x1e\xb1\xc7\xb9\x7f{\xe6\xf9\xcbK\xaf\x7f\x03\xd4" +
+	"f\x9b\xc0\xdb\xec#\x82/5\x13\x8b-/\xa4\"\xcf" +
+	"\xb0\xc1\x02\x81\x90aa\xf3\x14R\xf0\xec\x03\x81o]" +
+	"\xfepx\x9fS\xfe\xeb\x9a\x85\x823\x9b\x89\xf7\xf3\x7f" +
+	"\xbd\xe5;\xd7\xfd\xf1\xe6}%\xe25\xf7\x10\xc1\xd3\x82" +
+	"\xf7\xe3s/n\xdd\x11ky\xdby\x0e\x0f4\x8bP" +
+	"9,\x08\xfc\x0b\xdf\xbak\xa5\xb6\xf7\x9f\x1d\xbe=C" +
+	"\x0c\\\xb9\xee\x93\xdfu\xfd\xfb\xa2\x8f\x0e8y\x1f\xcb" +
+	"\xf3\xfeT\xbc\x9a^\xf1\x83\x9d\xf7\xcd\xfc\xe4\x1d'\x81" +
+	"\xb7E\x18oz\x0b\x11<{\xfa\x8e\xd7B\xcb\xef>" +
+	"\xe4$\xe8n\x11\xe6\x0f\x13\xc1\xaf\xf7\xbf{x\xc7R" +
+	"\xe3=\x87l\x0f\xb6\x08\xed\x1e\x12\xef\xcf\x18\xd9\xf0\xce" +
+	"\xc3\x8f\xf0\xf7\x9d\xeaok\x11\xa6\xdd\xd1B\xea\xff\xfd" +
+	"\x83{~\xc8:6\xbd\xef\xdc\xa0\xc6+\x82K\xf5\x12" +
+	"\x87\xe4\xca\xa3O\xbcq\xb3\xf6\x01\xa8\x0d\xe88\x07\x12" +
+	"\x9d\x83\x85\xde\xcf\xd82/\xfd\xea\xf6\x12\xb3'\xb7\x7f" +
+	"\xbd\xb7f\xc6\xb6\x0f\x1c\x96\xd8\xe6\xa5\xd3\x96\xdbm\xde" +
+	"\x1f\xda~|\xc3/K\xac\xec\xcd[Yl3\xb4\xd4" +
+	"\xdb\xbcq\xce\xfdGGmC\x84l\xb7\xf7 \xdb/" +
+	"\xb6\xd9+h\xeb\x17\x1c\xf8\x99\x84+\x8e\x81\xea\xb3\x99" +
+	"\x9d\xf1\x8ax?/\x08&\xfd\xf4\xd5\xce_n\xda\x7f" +
+	"\xdc\xe92\xafO\x98\xa5\xcdG\x04\x97\x8f\x1b\x7f\xb2c" +
+	"\xfbM'@m\x90\x8b\xbb\x01\xb2\x05\xbe\xf7Y\xb7\x8f" +
+	"\xb6\xba\xc5\xb7\x81m\xa6_\xb9\xcf>\xfaJ\xe8\xf5\xb3" +
+	"w\x9dp\xca\xfe\x80O\xe4\xc0\x8d\x82\xdb!l]\xb5" +
+	"\xf3\xdb/\x9e\xa4\\Q&\xfcv\xdfA\xb6Kp|" +
+	"\xd9'l\xf4\xe5\xd3\xfb\xbe\x17\xda\xfa;\xa7l\xf5\xad" +
+	"\"\x9c\x1a[\x89\xdb\x89s\x0b\x8e\xcc\xd6\x0e\xfd\xce\xb9" +
+	"\xddM\xad\xc2#\x0b\x04A\xdf\x0d\x97\x82\xa7\xff\x03O" +
+	"\x95\x09\x9fh\xbd\xc0\xd6\xb5\xd2V\xd9\xd6\xdb\xd8\x16\xfa" +
+	"\x95\x9b\xb6\x0f\xdbV?8\xf2\xfb\xd1\xb2\xc9D\xf6P" +
+	"\xebklX\xbc\xb0\xb1\xf5c\xda\xfa\xec\xcc\xbf\xdc\xe2" +
+	"\xbf\xf1\x0fe\x9c\xff\xd4\x7f\x90\xc5\xfcD\xc8\xfd\xb7\xb1" +
+	"a\xfa\x95{\xf2\x86\x7f\xda\xf9\xce\xaf\xd6\x9e)#\xce" +
+	"\xfa_c\x0f\x08\xe2u\xfe\x0d\xec\x80 \xfe\xde\xa3\xf3" +
+	"~5\xbb\xf9\xd1\xcf\x9cU\xe0e\xbf8\xc1\xbb\xfd\xa4" +
+	"\xd4\xf7O=~\xef\xd4G\x8f\x9c/\x86\x0e;\xea\xbf" +
+	"\x00\xae\xdc\xdb\x9dO4\x85\x7fD\x0b>\xbb\x0a\xfaE" +
+	"\xeeZ\xfe\xd6\xd9\xe9?Y[s\xd1\xb1\xb2\xcd/\xb2" +
+	"~\xfb\xd7\x9f]\xf0\xaf\x8bo\xbdX\xd1!\xc3\xfe\x83" +
+	"\xeci!\xdef?9\xe4\xe3\xc5\xdf\xbe\xd7\x7f\xfc\xbf" +
+	"/:\x8e\x08\xabj\xbb\x00\xc8j\xdah\xfd\x8f\xd6%" +
+	"n]w\xc3\xb1\xcb\x8e\xa0\xe6m\"\x96bm$\xf9" +
+	"\x0f.|\xe5\x87+\xb6n\xfb\xdf2;ll{\x8e" +
+	"=\xd6&\"\xbdm\x83\x8b\x0dOU\xe0\xfa\\\xc64" +
+	"\xb2\x113\xca\xe5{fE\xf4t2=\x7fa\xa8{" +
+	"\xd6\xa0\x1e\x8fEu\x93\xb7\xf7\xf2\x8c'\x1b73a" +
+	"\x97\xec\x02p!\x80Z\xdf\x09\x10\xae\x961\xacI\x18" +
+	"\x18\xd4\xe3Y\x8eU a\x15`e^\x99\x88\x1e\xe7" +
+	"w\xf0\x88\x992\xda\xbbB\xba\xa1'2\xe1j\x9b\xdb" +
+	"u\xf3\x01\xc2\xed2\x86\x83\x12\"\x8a\x08T\x17\xd0\x0e" +
+	"se\x0c/\x96\xb0K\xec\x90\xc1\xc9\x80!\x19\xb1\x0e" +
+	"$\xfa\x19\x10L\xc5S]\xa5}\xbbM\x9e\xe8\x8b%" +
+	"\xd7\xcc\x8a\x99<\xd1\x1e\xd2=b\xd7\xab\xd7\xc1L-" +
+	"\xe1k\xdb\xf3\xc2\x038\x19\xf9\x8a\x8c\xe4X\x12\xebA" +
+	"\xc2z\x07\x17\x97\xc5\xe5\x1bz<\xde\xafG\xd6,\xd7" +
+	"\x8d\xd5\xdc\x9c\x15\xd1\xe3q2nV\x19e\xdciE" +
+	"~\x8a\xc13\x13\x11\x8b\xd8\xc4M\x1c\x93M*k\x96" +
+	"\xc9e\xb3\xb9=\x9b\xe8\xe7\xc6,=\x1a\xcd\xab\x87c" +
+	"\x9a)\xca\xe3\xa6~ey\"\x05%\x89\x95B\x16w" +
+	"\xdb\xacn!VA\x19\xc3K%T-Gw\x13\xb8" +
+	"X\xc6pHB\x94D\xb4\xab\xcb( \x96\xc8\x18^" +
+	".a c\xea\x86i\xed\x19\x88\xa4\xb2I\xfb\xa9\xcb" +
+	"\x14\x96D\xb5\x98~\x00Q\x1dK\xb4$\x1f\xca\xab\xda" +
+	"\xde\xcb\x03\x99\xec8VW\x8b\xc9d\x14\xd3\xea\x12}" +
+	"\xe31\x9e4\xfbL\x83\xeb\x09+:,\xc2\x92#\x10" +
+	"O\x0d\x15\xdc\x94\x01\xa8,`\"\x1b7\x97\x1b\xbc\xf2" +
+	"\x91\x1b-_C1\xa3\x00b\x83C>\xb4x*\xbd" +
+	"\xa9\xa1\x10b\xf8;\x16\x176,\xfb\x00\xfa\x1e\x91e" +
+	"\xec{B.z\x81=&\xf7\x02\xf4m\"\xfc\xfb\x84" +
+	"KyO\xb0\xa7\x05\xfe\x14\xe1?&\\\x965\x94\x01" +
+	"\xd8V\x81?K\xf8\x8b\x84\xbb\\\x1a\xba\xa8\xb0\xc8\x8b" +
+	"\x00\xfa~L\xf8K\x84W\xd5jX\x05\xc0v\x08\xfc" +
+	"y\xc2_!|R\x9d\x86\x93\xa8\xfc\xc8\xf3\x01\xfa^" +
+	"$\xfcU\xc2\x95I\x9a\xc8\x87\xbb\xe4N\x80\xbe\x97\x08" +
+	"\x7f]\x96pN\xf5\x9b\xa8a5\x95]\xf1\xc2+\xb4" +
+	"\xf0&-\xd4\xecE\x0dk\x00\xd8\x1e\xb9\x07\xa0\xefu" +
+	"Z\xf8\xb9,!\xd6jX\x0b\xc0\xf6\xcb\x1d\x00}o" +
+	"\x12|\x886\xa8C\x0d\xeb\x00\xd8\x01\xb1\xc1\xdb\x84\xff" +
+	"\x82p\xb7\xa4\xa1\x1b\x80\xbd+\x04\xfd9\xe1\x1f\x10^" +
+	"/kX\x0f\xc0\x0e\x0b\xf6\xbf \xfc#\xc2'\xbb4" +
+	"\x9cL\xf5@\xf0\xff\x80\xf0\xe3\x84{\xaa4\xf4\x00\xb0" +
+	"cB\xce\x0f\x09?AxC\x9b\x86\x0d\x00\xec7r" +
+	"?@\xdfq\xc2O\x13\xaeVk\xa8\x02\xb0O\x85A" +
+	"?!\xfcs\xc2\xa7\xd4h8\x05\x80\x9d\x11\xfb\xfe'" +
+	"\xe1\x97\x08g\xb5\x1a2\x00v^\xf0\xf9\x1f\xc2]." +
+	"\x09U\xcd\xa3\xa1\x06\xc0\xd0\xd5\x03\xd0\xeb\x92\xb1\xcfM" +
+	"pc\x93\x86\x8d\x00\xac\xc6Ej\xb9\x08o \xbc\xc9" +
+	"\xada\x13\x00\xab'\xf2>7\xe1-.\x09\xe74\xbf" +
+	"\x85\x1a6\x03\xb0F\xb1\xa0\xd1\xc2TZh\xd9\x87\x1a" +
+	"\xb6\x00\xb06\x17\x09\xea\xa7\x85\x19\xc4\xc9;IC/" +
+	"\x00\x9b\xeeZ\x05\xd0\xd7N\xf8l\xc2}\x8a\x86>\x00" +
+	"6\xd3E\x86\x9eA\xf8\x8d\x84\xb7Vk\xd8\x0a\xc0\xe6" +
+	"\x08\x89\xae'|.\xe1\xfe\x1a\x0d\xfd\x00\xec&A?" +
+	"\x9b\xf0\xaf\x11\xdeV\xaba\x1b\x00\x9b\xe7\"\x83\xdeH" +
+	"x\x90\xf0k\xea4\xbc\x06\x80-\x10\x82~\x8d\xf0%" +
+	"\x84\x07\xdc\x1a\x06\xa8U\x12\xf8b\xc2C\x84O\xad\xd7" +
+	"p*\x00[&\xf8/!|\xb9\x8b\x12v\xd4\xceg" +
+	"zDd\x97n\xc0\"\x161\xb8n\xf2\xe8B@;" +
+	"\xeb\xe4\xb2\xe9\xe8hl}\xbf\x1e\xd7\x93\x11\xbb\x84\xac" +
+	"\x1f\xe4F&\x96J\xa2\x0b$t\x01veL\xdd\xcc" +
+	"f\xac\xc7@&\x922\xec\xda\xd5\xa5G\xcc\xd8 G" +
+	"\x04\x09\x1107\xc8\x8d\xd8=1\x1e\x05:\xd8y\xcc" +
+	"\x93\xd4\x13\x1c\xdd \xa1\x1b0\xc0\x13z,n=\xad" +
+	"\x17B\x1b\xeb\xac\xe7\\$k\x18<\x19YG\xaf\x17" +
+	"0O:\xae'\xad\x87.\x83\xaf&\xd1,\xfaT\xda" +
+	"\x8c\xa5\x92z\x1c\x94>n\xa2\x02\x12\xd2\xd7c\x94\xc7" +
+	"\xf9h\xc5\xd3\xba\xc1\x93f\xb7\x10\xcca\xa0h\xcc\\" +
+	"\x1a\x03%\x11sP\x1a\xb1\x94\x113\x85\x0c\x05\x9d\xd7" +
+	"\x1b\xdc4b\xdc\xb6A.\x1a\xcb\x08\xd1\x89\xc6\xaa\xe2" +
+	"\xba\x11\x19\x88\x0d\x96\xa8\x9e3\x87R\xb7\xea\x113\x05" +
+	"h\xd8\x18_kr\x83d\x96\xbb\xa3\xb6Y\xd2\x03\xa9" +
+	"$w\x98%\x91\xd6\x93\xb6Y\x02f\xcc\x8c\xdb\xab]" +
+	"\xf1\x94\xe8\x1e,\x1b\x98\xb1\x04\xbf?\x95\xe4\x0e\x9b\xe5" +
+	"\x0c~\x0f7\x0cn8\xb0@2e\xf2\x8cMQV" +
+	"\xe1)\x89\xc7\x92\x11\x83'x\xd2\\\xa6\xa7\xad\x82\xe0" +
+	"\xcc\xe3\x8b\x0ay\xbc]\xc2\xf5<\x997I\xa1\xb3i" +
+	"(v\xed\x80\x04\x8e\xd1M\xc5SC\xd4Kd\xac\xae" +
+	"\xa6\x9c\xec\x1b\x03\xd9\xe4\x1a\xd1\xfdDSI\xde\xde\xdb" +
+	"\xc5\xcb\xea]\x0f@\xd8-c\xb8E\xc2\\d\x80G" +
+	"\xd6d\xb2\x09R\xb5\x1a$\xac\x1eK\xb9\x0c7\x06\xb9" +
+	"QZ\xed\xae\xaa\xc2wL\xac\xc2{2\xb1\xe4\x1aT" +
+	"\x8b\x1f\x01W\xaa\xef\xf9s\xd9\x9b\x1a\xcaTT\xb8\xa3" +
+	"hx\x8f\x91\x1arX\xdd\xbeW\x19\xcb\xea\xa5\xbd\xa4" +
+	"\xd5=T\x96\x82G\x06R\x95\xfc\xdeQ\xac\xdf\x9e\xa8" +
+	"n\xeac\xf7c\xa5>\xb6{\x15G\xc3\xbc\xa8\xd00" +
+	"\xcfv\x98y&9\xf4z\x19\xc3s%\\/L\xca" +
+	"\x1di\x8c\x92S\x9c\x97\x9c\xdc\x09\xf5\xa7\xa4\x88\xfc\x85" +
+	"\xfafa\x0f\xbb\xf1\xb9z\x8b\xd8\xcd\x91\xd5X:\xec" +
+	"\xd1Q\xb4\x87m\x0e\xc2f\xc8\x18\xbeQB\x0f\xbdk" +
+	"\x87\x94ip~\x85\xee\xa9R[,\xc4\x97\xaf\xb6_" +
+	"\x1c\xcdq57\xadF\xbb\xb2\xaaC)c\x8d\x1dB" +
+	"\x0e5{\x8a*\xa9\x18\xcc\xeb9gZ\xd1\xed\xb9h" +
+	"\xd6\xd0)\x99;\xdc\xacD\xd2Y;mV<\xd3\xd9" +
+	"t\x9a\x1b\xbd<\x922\xa2\x99\xca\x8er\xa6,#O" +
+	"X<<\xf6\xc7\xfc\x95RV\xb1\xf5\xbe\xaa\x0f\xb1\xb1" +
+	"sPyG=v2\x1eW\xb7\x09\xa7\xe3\x92=\x92" +
+	"\xa9\xf4\x8a\x989\xb0\x8c\x9b:Eq\xa5#\xe3\xcc\xb5" +
+	"\x89\x02\x1d\xf9\xa8\xa1x\xc54V\x14\x8eJl\xa1\x80" +
+	">\xda|_ \xaf\x11s;\xbe\xcd\xd2|\xf5\xff\xfa" +
+	"\x0a\xb5/\x0b*\x1c\xceNg\xb2\x92\xcaOg\xa9\xcf" +
+	"=\x91T\x94_\xb9\x08\x95\x04\xec8\x15v\xc2\xe1*" +
+	"Y;X~\x0c\xdcB]\x15}>9t\x99V)" +
+	"\xf1v\x16uQ\xd6\xf0b\xc7\x91\xd7kt\xb3P~" +
+	"\xd2\xc7\x89\xe1\x92/\xccq\x13i\xa1`\xda\x97\x89\xa3" +
+	"rQ\x99\x9a\x00\xa4\xe2D\x0f\x84}\xf1:VD\x15" +
+	"\x1b\x8f\x08\xfd\xb2o{\xbeP!\xac\xe4\xe4Q\xd7\x12" +
+	"\xfa\xba\xb2\x00\x95F\xcb\x84k\xf2\xde\xac\x02\xb0\xafk" +
+	"\xd1\xba\xb0S\xe7t\x82\xa4NW\x10\xed\xdb=\xb4\xc6" +
+	" \xaa\xb7\x03$\xb5^\x09\x08\x9d\x82\xe8\xa1\xa6*\x88" +
+	"!\x9c`F\xa8t\xc9\xe3\xb4\x02\x95\xc8\xb28)z" +
+	"JO\x8bP\xcc{\xaar0N0\x16'vs3" +
+	"\xde\xedC&\x9b\x18'C;\xae\xfb*\xa5\x96\xf9\xc5" +
+	"\x10\xab|\xb7W\xc1\x87\x05\xe1\xba\xf2\xcdI>f\xc9" +
+	"\x91\xd6\xa8\x07\xad\xa9\xa0\xaa\x92\xb3\xaa\x14\x0f\xe9S\xea" +
+	"$\xfbb\xa4+\x9f=\x88K\x83-\x96\xee\x03\x08\x7f" +
+	"S\xc6\xf0@\xd1\x9e\x9c\xdct\xb7\x8c\xe1\xb8\x84\xaa\x84" +
+	"\xf9\xe65FF\x8e\xca\x18NK\xa8\xca\x92\xb8\x11Q" +
+	"\x13d\xa1\x01\x19\xc3f\xc9G\xe5\x15>\xdc\x94\xfe\xd8" +
+	"\x15\xbcn\xb5\x9c\xd6\xf9$]\xad\xd1)Z\xb33[" +
+	"W\xeaK\xc7\xd25_x\x8b\xa1o\x0d?\xd1\x9a`" +
+	"Q\x0fQ\x08}kf\x85\xd6\xdd\xb8\xea\x9d&B_" +
+	"\xd1\xa3\xd1 *\xab\xb9Y\xbaM\xa5\xde\xa6R\xb74" +
+	"~\x8d\x97F\x9d +u\x8c\xfbqS(\x896\x9d" +
+	"2V.\xb2Bq\xf4\xf5\x1cu\x95\xb7\xa7\xa2\xbc\xec" +
+	"\x80uV8`V\x97\xbd\xa4L\x91\xc8@,\x1e5" +
+	"\xb8h\xbe\xec\x84\xe9\xec2'\x8f\xa5-\x95\xe0\x0a\xd7" +
+	"\xe2S*\x15\x9b)\xc5\x03\x8e\xf6U,\xf6_\xf9h" +
+	"\x939\xc7\xab\xf1\xc9T\x1a&U\xb8C\\\x18\xea&" +
+	"\xb3,\x11\xb1cMD\xd0\x1a@\xb09\xd24\x90\xd8" +
+	"t\x89\xa2\xc7\x9aZ\xa0\x18\x00\x82\xf1\x1e\xf3\x8a\xd5z" +
+	"IA\xc9\x1e\xa9\xa2\x98r\xc3\xbc\xa7\x18J= \xb1" +
+	"\xf3\xa8\xa0lO\xfd\xd1\x1a\xde\xb3\x11\xec\x04\x89\xfd\x06" +
+	"\x15t\xd9\xf3f\xb4\xe6\x9a\xec\x08\xde\x0b\x12{\x17\x15" +
+	"\xac\xb2G\xedhM\xf4\xd8^\xb1\xba\x1b\x15\x9cd\xcf" +
+	"J\xd1\x1a\xd9\xb3\x1d\xd8\x0b\x12\xdb\x86\x0a*\xf6\xa4\x1f" +
+	"\xad\x19\x18{\x1aI\xaa\xc7P\xc1j{T\x89\xd6\x1f" +
+	"\x1f\xd8Cb\xf5\x01T\xb0\xc6\x1e\xe9\xa15\xd1g\xf7" +
+	"a\x07H\x8c\xa3\x82\xb5\xf6x\x1d\xadq5\xbbS\xec" +
+	"\x1bF\x05\xeb\xec\x190Z\xf3Iv\x8bxw\x1e*" +
+	"\xe8\xb6G\xe0h\x0d\xaa\xd9L\xb1\xfa%T\xb0\xde\x9e" +
+	"\x8f\xa25'f\x8dB\xdfzTp\xb2=\x9eEk" +
+	"\x04\xcf\x10W\x81\xa4\x9eW\xd0c\xff)\x00\xad1\xb0" +
+	":r/H\xeaI\x05\x1b\xec\xffn\xa05\xedS\x8f" +
+	"\xf6\x83\xa4\x1eVP\xb5\x87\xc2h\x0d\x1e\xd5\xfd\x7f\x05" +
+	"\x92\xbaG\xa1\xb8\x09b>=\xe4\xaco4\x00\x08b" +
+	"@\x8c&\x82\x98\xb3\x1av\xf0P\x0b\x13\xc4\x9c\xd5\xd1" +
+	"\xd8\x80\xf5y\x00h\xd0r!\xd7\x0b.9\xab\xb5\x14" +
+	"O\x1ej\x07\x88e\xe1\xf3\x180\x13D\x0fuQ\xc1" +
+	"|\x19\x0db\xcej\x0f\xc1C\x9d_\xd0\xba\xaf\xebM" +
+	"\x81<D\x8f\xd67\x01x\xe8\xab\x80\x98\x15\xca\x15(" +
+	"f\x8a\xf6\xb7\xca7Z-\x92Hy\xff\x17\x00\x00\xff" +
+	"\xff\xcd\x99\xbd3"
 
 func RegisterSchema(reg *schemas.Registry) {
 	reg.Register(&schemas.Schema{
 		String: schema_d9767bf36f62edd8,
 		Nodes: []uint64{
+			0x80ba478b1c44b867,
+			0x80f7a398fc4acbb5,
+			0x860407578e6ff6eb,
 			0x880f4d13f4a8eb97,
+			0x88e76758377ffbbb,
 			0x890d0dbe87503908,
+			0x8d595cc0fafc7904,
+			0x9024c1ec2a59bdb5,
+			0x90edbe18d23be59b,
+			0x95e80707e2033b23,
+			0x9676ab071ecaf772,
 			0x983900eb0fa214ee,
+			0x9a5f0f1a66a15ab4,
+			0x9bf4c150a92b5638,
+			0x9d1daf20082a1537,
+			0xa1af930fc7b95e1d,
+			0xa6fcd5e4b08574cc,
+			0xa9287c124a8d425a,
+			0xb123c1604e506c87,
+			0xb3d5ff2341788649,
+			0xb444120a7096b56d,
+			0xb6abb79158c9c1ef,
+			0xb82a58396d71d5e2,
 			0xb91ace4c4a633a57,
+			0xbe2c4cfda89bdaf7,
+			0xbe76139b636fab9d,
+			0xc38dd8fd801f7df6,
+			0xc3953629849d8ca8,
+			0xc41869ada1fb3893,
+			0xc6c114585fc2411c,
+			0xc9dc42de048be549,
+			0xcbe82d71af9e5770,
+			0xcc605450ba56eca0,
 			0xce72004cadd1cdc5,
+			0xd2658886cb87ed28,
+			0xd2902a139fbd81ae,
+			0xd4143bbe94d9586e,
+			0xd4a52809523ea996,
+			0xd687e0a9507a74b9,
+			0xd97a318917194c77,
+			0xdd570102b7c93d0d,
+			0xe0c590d632b8b606,
+			0xe435a9ad5572e0fd,
+			0xe45ff6bc502fdcf2,
+			0xe5ac83af5a1b01cc,
+			0xe6a15092c3ec2b96,
+			0xe6cc1430d53df7e4,
+			0xeb01eaec40fc3353,
+			0xeeed81671d01c322,
+			0xef341c9d7e2df6e4,
+			0xf178dbcbafbf3396,
+			0xf28e1730db398e92,
 			0xf9d58e206a93eb9c,
+			0xf9d5a351169432c4,
+			0xfb0978b325f6c254,
 			0xfb4644d73da03e24,
 			0xfbf5e01c6a8344e7,
 			0xfddd3379466d7927,
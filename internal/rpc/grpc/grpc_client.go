@@ -6,16 +6,37 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"sync"
+	"time"
 
 	"github.com/matheusd/gorpcbench/rpcbench"
+	"github.com/sourcegraph/conc/pool"
 	grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 type grpcClient struct {
 	conn *grpc.ClientConn
-	tree *TreeNode
 	api  APIClient
+
+	// trees are the request trees, reused across calls. This is a pool
+	// because the client may be used concurrently.
+	trees sync.Pool
+
+	// records are the request record lists, reused across calls.
+	records sync.Pool
+
+	// rows are the request row pages, reused across calls.
+	rows sync.Pool
 }
 
 func (c *grpcClient) Nop(ctx context.Context) error {
@@ -32,7 +53,8 @@ func (c *grpcClient) Add(ctx context.Context, a int64, b int64) (int64, error) {
 }
 
 func (c *grpcClient) MultTreeValues(ctx context.Context, mult int64, fillArgs func(rpcbench.TreeNode)) (rpcbench.TreeNode, error) {
-	tree := c.tree
+	tree := c.trees.Get().(*TreeNode)
+	defer c.trees.Put(tree)
 	fillArgs(tree)
 	res, err := c.api.MultTree(ctx, &MultTreeRequest{Mult: mult, Tree: tree})
 	if err != nil {
@@ -50,11 +72,316 @@ func (c *grpcClient) ToHex(ctx context.Context, in, out []byte) error {
 	return nil
 }
 
-func newGRPCClient(ctx context.Context, addr string) (*grpcClient, error) {
+func (c *grpcClient) ServerStream(ctx context.Context, start int64, count int, onItem func(int64) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := c.api.ServerStream(ctx, &ServerStreamRequest{Start: start, Count: int64(count)})
+	if err != nil {
+		return err
+	}
+
+	var item StreamItem
+	for {
+		err := stream.RecvMsg(&item)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := onItem(item.Value); err != nil {
+			return err
+		}
+	}
+}
+
+func (c *grpcClient) ClientStream(ctx context.Context, chunks [][]byte) (uint32, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := c.api.ClientStream(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var chunk StreamChunk
+	for _, chunk.Data = range chunks {
+		if err := stream.Send(&chunk); err != nil {
+			return 0, err
+		}
+	}
+	res, err := stream.CloseAndRecv()
+	if err != nil {
+		return 0, err
+	}
+	return res.Checksum, nil
+}
+
+func (c *grpcClient) Callback(ctx context.Context, start int64, count int, onCall func(int64) (int64, error)) (int64, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := c.api.Callback(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	msg := CallbackMessage{Value: start, Count: int64(count)}
+	if err := stream.Send(&msg); err != nil {
+		return 0, err
+	}
+	for {
+		if err := stream.RecvMsg(&msg); err != nil {
+			return 0, err
+		}
+		if msg.Return {
+			break
+		}
+		res, err := onCall(msg.Value)
+		if err != nil {
+			return 0, err
+		}
+		msg.Value = res
+		if err := stream.Send(&msg); err != nil {
+			return 0, err
+		}
+	}
+
+	// Wait for the end of the call, so that it is not canceled.
+	sum := msg.Value
+	if err := stream.CloseSend(); err != nil {
+		return 0, err
+	}
+	err = stream.RecvMsg(&msg)
+	if errors.Is(err, io.EOF) {
+		return sum, nil
+	}
+	if err == nil {
+		return 0, errors.New("unexpected message after end of callback call")
+	}
+	return 0, err
+}
+
+func (c *grpcClient) Validate(ctx context.Context, v int64, code rpcbench.AppErrorCode) (int64, error) {
+	res, err := c.api.Validate(ctx, &ValidateRequest{Value: v, Code: uint32(code)})
+	if err != nil {
+		return 0, statusAppError(err)
+	}
+	return res.Value, nil
+}
+
+// statusAppError returns the app error carried by the status of a failed call,
+// or err itself if it does not carry one.
+func statusAppError(err error) error {
+	// App error codes match the gRPC status codes. Other codes are errors
+	// of the system itself.
+	st, ok := status.FromError(err)
+	if !ok || !slices.Contains(rpcbench.AppErrorCodes(), rpcbench.AppErrorCode(st.Code())) {
+		return err
+	}
+	return &rpcbench.AppError{Code: rpcbench.AppErrorCode(st.Code()), Message: st.Message()}
+}
+
+func (c *grpcClient) NopWithMetadata(ctx context.Context, md []rpcbench.MetadataEntry) (string, error) {
+	kv := make([]string, 0, 2*len(md))
+	for _, e := range md {
+		kv = append(kv, e.Key, e.Value)
+	}
+	ctx = metadata.AppendToOutgoingContext(ctx, kv...)
+
+	// The server echoes the value in the header of the reply.
+	var header metadata.MD
+	if _, err := c.api.NopWithMetadata(ctx, &VoidData{}, grpc.Header(&header)); err != nil {
+		return "", statusAppError(err)
+	}
+	if v := header.Get(rpcbench.MetadataEchoKey); len(v) > 0 {
+		return v[0], nil
+	}
+	return "", nil
+}
+
+func (c *grpcClient) Slow(ctx context.Context, delay time.Duration) error {
+	_, err := c.api.Slow(ctx, &SlowRequest{Delay: int64(delay)})
+	switch status.Code(err) {
+	case codes.DeadlineExceeded:
+		// The deadline is also sent to the server, which may fail the
+		// call before the client notices it expired.
+		return context.DeadlineExceeded
+	case codes.Canceled:
+		return context.Canceled
+	}
+	return err
+}
+
+func (c *grpcClient) SlowCalls(ctx context.Context) (started, canceled int64, err error) {
+	res, err := c.api.SlowCalls(ctx, &VoidData{})
+	if err != nil {
+		return 0, 0, err
+	}
+	return res.Started, res.Canceled, nil
+}
+
+// workStreamDesc is the descriptor of the (unary) Work calls made as streams.
+var workStreamDesc = grpc.StreamDesc{StreamName: "Work"}
+
+func (c *grpcClient) WorkWithNops(ctx context.Context, work rpcbench.Work, nops int, onNop func(i int)) error {
+	// The Work call is made as a stream, such that its request is written
+	// to the conn before the Nop calls are made. Canceling ctx releases the
+	// stream if it is not received from.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := c.conn.NewStream(ctx, &workStreamDesc, API_Work_FullMethodName)
+	if err != nil {
+		return err
+	}
+	if err := stream.SendMsg(&WorkRequest{Duration: int64(work.Duration), Cpu: work.CPU}); err != nil {
+		return err
+	}
+
+	// Every call is made concurrently, multiplexed through the conn of the
+	// client.
+	g := pool.New().WithErrors()
+	g.Go(func() error {
+		return stream.RecvMsg(&VoidData{})
+	})
+	for i := range nops {
+		g.Go(func() error {
+			if _, err := c.api.Nop(ctx, &VoidData{}); err != nil {
+				return err
+			}
+			onNop(i)
+			return nil
+		})
+	}
+	return g.Wait()
+}
+
+func (c *grpcClient) Echo(ctx context.Context, in, out []byte) error {
+	res, err := c.api.Echo(ctx, &LargeMessage{Data: in})
+	if err != nil {
+		return err
+	}
+	if len(res.Data) != len(out) {
+		return fmt.Errorf("unexpected reply size %d", len(res.Data))
+	}
+	copy(out, res.Data)
+	return nil
+}
+
+func (c *grpcClient) UpperRecords(ctx context.Context, in, out []rpcbench.Record) error {
+	req := c.records.Get().(*RecordList)
+	defer c.records.Put(req)
+	recordsToGrpc(in, req)
+	res, err := c.api.UpperRecords(ctx, req)
+	if err != nil {
+		return err
+	}
+	if len(res.Records) != len(out) {
+		return fmt.Errorf("unexpected number of records %d", len(res.Records))
+	}
+	grpcToRecords(res, out)
+	return nil
+}
+
+func (c *grpcClient) UpdateRows(ctx context.Context, in, out []rpcbench.Row) error {
+	req := c.rows.Get().(*RowPage)
+	defer c.rows.Put(req)
+	rowsToGrpc(in, req)
+	res, err := c.api.UpdateRows(ctx, req)
+	if err != nil {
+		return err
+	}
+	if len(res.Rows) != len(out) {
+		return fmt.Errorf("unexpected number of rows %d", len(res.Rows))
+	}
+	grpcToRows(res, out)
+	return nil
+}
+
+func (c *grpcClient) IncrementMap(ctx context.Context, in, out map[string]int64) error {
+	res, err := c.api.IncrementMap(ctx, &CounterMap{Values: in})
+	if err != nil {
+		return err
+	}
+	maps.Copy(out, res.Values)
+	return nil
+}
+
+func (c *grpcClient) ScaleVector(ctx context.Context, in []float64, scale float64, out []float64) error {
+	res, err := c.api.ScaleVector(ctx, &ScaleVectorRequest{Values: in, Scale: scale})
+	if err != nil {
+		return err
+	}
+	if len(res.Values) != len(out) {
+		return fmt.Errorf("unexpected vector size %d", len(res.Values))
+	}
+	copy(out, res.Values)
+	return nil
+}
+
+// grpcBidiStream is a bidi stream backed by a gRPC bidi streaming call. Each
+// direction has its own message, given Send and Recv are called concurrently.
+type grpcBidiStream struct {
+	stream   grpc.BidiStreamingClient[StreamItem, StreamItem]
+	cancel   func()
+	sendItem StreamItem
+	recvItem StreamItem
+}
+
+func (s *grpcBidiStream) Send(v int64) error {
+	s.sendItem.Value = v
+	return s.stream.Send(&s.sendItem)
+}
+
+func (s *grpcBidiStream) Recv() (int64, error) {
+	if err := s.stream.RecvMsg(&s.recvItem); err != nil {
+		return 0, err
+	}
+	return s.recvItem.Value, nil
+}
+
+func (s *grpcBidiStream) Close() error {
+	defer s.cancel()
+	if err := s.stream.CloseSend(); err != nil {
+		return err
+	}
+	err := s.stream.RecvMsg(&s.recvItem)
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	if err == nil {
+		return errors.New("unexpected message after end of bidi stream")
+	}
+	return err
+}
+
+func (c *grpcClient) OpenBidiStream(ctx context.Context) (rpcbench.BidiStream, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := c.api.BidiStream(ctx)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &grpcBidiStream{stream: stream, cancel: cancel}, nil
+}
+
+// Close closes the conn of the client.
+func (c *grpcClient) Close() error {
+	return c.conn.Close()
+}
+
+func newGRPCClient(ctx context.Context, cfg rpcbench.ClientConfig) (*grpcClient, error) {
+	creds := insecure.NewCredentials()
+	if cfg.TLSConfig != nil {
+		creds = credentials.NewTLS(cfg.TLSConfig)
+	}
 	opts := []grpc.DialOption{
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxMsgSize())),
+	}
+	target := cfg.Addr
+	if cfg.Network == "unix" {
+		target = "unix://" + cfg.Addr
 	}
-	conn, err := grpc.NewClient(addr, opts...)
+	conn, err := grpc.NewClient(target, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -66,8 +393,10 @@ func newGRPCClient(ctx context.Context, addr string) (*grpcClient, error) {
 	}()
 
 	return &grpcClient{
-		tree: new(TreeNode),
-		conn: conn,
-		api:  api,
+		conn:    conn,
+		api:     api,
+		trees:   sync.Pool{New: func() any { return new(TreeNode) }},
+		records: sync.Pool{New: func() any { return new(RecordList) }},
+		rows:    sync.Pool{New: func() any { return new(RowPage) }},
 	}, nil
 }
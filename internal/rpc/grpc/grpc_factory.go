@@ -19,8 +19,8 @@ func (f grpcFactory) NewServer(l net.Listener) (rpcbench.Server, error) {
 	return newGRPCServer(l), nil
 }
 
-func (f grpcFactory) NewClient(ctx context.Context, addr string) (rpcbench.Client, error) {
-	return newGRPCClient(ctx, addr)
+func (f grpcFactory) NewClient(ctx context.Context, cfg rpcbench.ClientConfig) (rpcbench.Client, error) {
+	return newGRPCClient(ctx, cfg)
 }
 
 func GRPCFactoryIniter() rpcbench.RPCFactory {
@@ -7,15 +7,24 @@ package grpc
 import (
 	"context"
 	"encoding/hex"
+	"errors"
+	"hash/crc32"
+	"io"
 	"net"
+	"time"
 
+	"github.com/matheusd/gorpcbench/rpcbench"
 	grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 type grpcServer struct {
 	UnimplementedAPIServer
-	gs *grpc.Server
-	l  net.Listener
+	gs   *grpc.Server
+	l    net.Listener
+	slow rpcbench.SlowHandler
 }
 
 func (s *grpcServer) Nop(context.Context, *VoidData) (*VoidData, error) {
@@ -49,6 +58,148 @@ func (s *grpcServer) ToHex(_ context.Context, req *ToHexRequest) (*ToHexResponse
 	return &ToHexResponse{Out: buf}, nil
 }
 
+func (s *grpcServer) ServerStream(req *ServerStreamRequest, stream grpc.ServerStreamingServer[StreamItem]) error {
+	var item StreamItem
+	for i := range req.Count {
+		item.Value = req.Start + i
+		if err := stream.Send(&item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *grpcServer) ClientStream(stream grpc.ClientStreamingServer[StreamChunk, ClientStreamResponse]) error {
+	var sum uint32
+	var chunk StreamChunk
+	for {
+		err := stream.RecvMsg(&chunk)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		sum = crc32.Update(sum, crc32.IEEETable, chunk.Data)
+	}
+	return stream.SendAndClose(&ClientStreamResponse{Checksum: sum})
+}
+
+func (s *grpcServer) BidiStream(stream grpc.BidiStreamingServer[StreamItem, StreamItem]) error {
+	var item StreamItem
+	for {
+		err := stream.RecvMsg(&item)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(&item); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *grpcServer) Callback(stream grpc.BidiStreamingServer[CallbackMessage, CallbackMessage]) error {
+	var msg CallbackMessage
+	if err := stream.RecvMsg(&msg); err != nil {
+		return err
+	}
+	start, count := msg.Value, msg.Count
+
+	var sum int64
+	for i := range count {
+		msg.Value, msg.Count = start+i, 0
+		if err := stream.Send(&msg); err != nil {
+			return err
+		}
+		if err := stream.RecvMsg(&msg); err != nil {
+			return err
+		}
+		sum += msg.Value
+	}
+	msg.Value, msg.Return = sum, true
+	return stream.Send(&msg)
+}
+
+func (s *grpcServer) Validate(_ context.Context, req *ValidateRequest) (*ValidateResponse, error) {
+	// App error codes match the gRPC status codes.
+	if req.Code != 0 {
+		appErr := rpcbench.RejectValue(rpcbench.AppErrorCode(req.Code), req.Value)
+		return nil, status.Error(codes.Code(appErr.Code), appErr.Message)
+	}
+	return &ValidateResponse{Value: req.Value}, nil
+}
+
+// Slow handles the call until its context is canceled, which happens once its
+// deadline (sent by the client as the grpc-timeout header) expires.
+func (s *grpcServer) Slow(ctx context.Context, req *SlowRequest) (*VoidData, error) {
+	if err := s.slow.Handle(ctx, time.Duration(req.Delay)); err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+	return &VoidData{}, nil
+}
+
+func (s *grpcServer) SlowCalls(context.Context, *VoidData) (*SlowCallsResponse, error) {
+	started, canceled := s.slow.Counts()
+	return &SlowCallsResponse{Started: started, Canceled: canceled}, nil
+}
+
+func (s *grpcServer) Work(_ context.Context, req *WorkRequest) (*VoidData, error) {
+	rpcbench.Work{Duration: time.Duration(req.Duration), CPU: req.Cpu}.Do()
+	return &VoidData{}, nil
+}
+
+func (s *grpcServer) Echo(_ context.Context, req *LargeMessage) (*LargeMessage, error) {
+	return req, nil
+}
+
+func (s *grpcServer) UpperRecords(_ context.Context, req *RecordList) (*RecordList, error) {
+	for _, r := range req.Records {
+		r.Name = rpcbench.UpperName(r.Name)
+	}
+	return req, nil
+}
+
+func (s *grpcServer) UpdateRows(_ context.Context, req *RowPage) (*RowPage, error) {
+	for _, r := range req.Rows {
+		r.Version++
+	}
+	return req, nil
+}
+
+func (s *grpcServer) IncrementMap(_ context.Context, req *CounterMap) (*CounterMap, error) {
+	for k := range req.Values {
+		req.Values[k]++
+	}
+	return req, nil
+}
+
+func (s *grpcServer) ScaleVector(_ context.Context, req *ScaleVectorRequest) (*Vector, error) {
+	for i := range req.Values {
+		req.Values[i] *= req.Scale
+	}
+	return &Vector{Values: req.Values}, nil
+}
+
+func (s *grpcServer) NopWithMetadata(ctx context.Context, _ *VoidData) (*VoidData, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	echo, appErr := rpcbench.CheckMetadata(func(key string) string {
+		if v := md.Get(key); len(v) > 0 {
+			return v[0]
+		}
+		return ""
+	})
+	if appErr != nil {
+		return nil, status.Error(codes.Code(appErr.Code), appErr.Message)
+	}
+	if err := grpc.SetHeader(ctx, metadata.Pairs(rpcbench.MetadataEchoKey, echo)); err != nil {
+		return nil, err
+	}
+	return &VoidData{}, nil
+}
+
 func (s *grpcServer) Run(ctx context.Context) error {
 	go func() {
 		<-ctx.Done()
@@ -61,7 +212,7 @@ func newGRPCServer(l net.Listener) *grpcServer {
 	s := &grpcServer{
 		l: l,
 	}
-	s.gs = grpc.NewServer()
+	s.gs = grpc.NewServer(grpc.MaxRecvMsgSize(maxMsgSize()))
 	RegisterAPIServer(s.gs, s)
 	return s
 }
@@ -10,6 +10,99 @@ import (
 	"github.com/matheusd/gorpcbench/rpcbench"
 )
 
+// maxMsgSize is the max size of messages received by both ends of conns, which
+// fits a payload of the max message size.
+func maxMsgSize() int {
+	return rpcbench.MaxMessageSize() + rpcbench.MaxMessageOverhead
+}
+
+// recordsToGrpc sets the records of l, reusing its storage.
+func recordsToGrpc(records []rpcbench.Record, l *RecordList) {
+	if cap(l.Records) < len(records) {
+		l.Records = make([]*Record, len(records))
+	}
+	l.Records = l.Records[:len(records)]
+	for i, r := range records {
+		if l.Records[i] == nil {
+			l.Records[i] = new(Record)
+		}
+		g := l.Records[i]
+		g.Id, g.Name, g.Email, g.Bio = r.ID, r.Name, r.Email, r.Bio
+	}
+}
+
+func grpcToRecords(l *RecordList, records []rpcbench.Record) {
+	for i, g := range l.Records {
+		records[i] = rpcbench.Record{ID: g.Id, Name: g.Name, Email: g.Email, Bio: g.Bio}
+	}
+}
+
+// rowsToGrpc sets the rows of p, reusing its storage. The optional fields of
+// the rows are shared with p.
+func rowsToGrpc(rows []rpcbench.Row, p *RowPage) {
+	if cap(p.Rows) < len(rows) {
+		p.Rows = make([]*Row, len(rows))
+	}
+	p.Rows = p.Rows[:len(rows)]
+	for i := range rows {
+		if p.Rows[i] == nil {
+			p.Rows[i] = new(Row)
+		}
+		r, g := &rows[i], p.Rows[i]
+		g.Id, g.AccountId, g.CreatedAt, g.UpdatedAt = r.ID, r.AccountID, r.CreatedAt, r.UpdatedAt
+		g.Balance, g.Version, g.Status, g.Score = r.Balance, r.Version, r.Status, r.Score
+		g.Active, g.Verified = r.Active, r.Verified
+		g.Name, g.Email, g.Country = r.Name, r.Email, r.Country
+		g.Currency, g.Plan, g.Region = r.Currency, r.Plan, r.Region
+
+		g.DeletedAt, g.ParentId, g.CreditLimit = r.DeletedAt, r.ParentID, r.CreditLimit
+		g.Priority, g.Retries, g.Discount = r.Priority, r.Retries, r.Discount
+		g.Archived, g.TwoFactor = r.Archived, r.TwoFactor
+		g.ExternalId, g.Phone, g.Company, g.Title = r.ExternalID, r.Phone, r.Company, r.Title
+		g.Locale, g.Timezone, g.Referrer, g.Notes = r.Locale, r.Timezone, r.Referrer, r.Notes
+	}
+}
+
+func grpcToRows(p *RowPage, rows []rpcbench.Row) {
+	for i, g := range p.Rows {
+		rows[i] = rpcbench.Row{
+			ID:        g.Id,
+			AccountID: g.AccountId,
+			CreatedAt: g.CreatedAt,
+			UpdatedAt: g.UpdatedAt,
+			Balance:   g.Balance,
+			Version:   g.Version,
+			Status:    g.Status,
+			Score:     g.Score,
+			Active:    g.Active,
+			Verified:  g.Verified,
+			Name:      g.Name,
+			Email:     g.Email,
+			Country:   g.Country,
+			Currency:  g.Currency,
+			Plan:      g.Plan,
+			Region:    g.Region,
+
+			DeletedAt:   g.DeletedAt,
+			ParentID:    g.ParentId,
+			CreditLimit: g.CreditLimit,
+			Priority:    g.Priority,
+			Retries:     g.Retries,
+			Discount:    g.Discount,
+			Archived:    g.Archived,
+			TwoFactor:   g.TwoFactor,
+			ExternalID:  g.ExternalId,
+			Phone:       g.Phone,
+			Company:     g.Company,
+			Title:       g.Title,
+			Locale:      g.Locale,
+			Timezone:    g.Timezone,
+			Referrer:    g.Referrer,
+			Notes:       g.Notes,
+		}
+	}
+}
+
 func treeToGrpc(t *rpcbench.TreeNodeImpl, g *TreeNode) {
 	g.Value = t.Value
 	g.Children = make([]*TreeNode, len(t.Children))
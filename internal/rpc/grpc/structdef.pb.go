@@ -8,9 +8,6 @@
 // 	protoc        v3.21.12
 // source: structdef.proto
 
-//*
-// ffoooo
-
 package grpc
 
 import (
@@ -396,37 +393,1286 @@ func (x *ToHexResponse) GetOut() []byte {
 	return nil
 }
 
-var File_structdef_proto protoreflect.FileDescriptor
+type ServerStreamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         int64                  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_structdef_proto_rawDesc = "" +
-	"\n" +
-	"\x0fstructdef.proto\x12\n" +
-	"goserbench\"\n" +
-	"\n" +
-	"\bVoidData\"(\n" +
-	"\n" +
-	"AddRequest\x12\f\n" +
-	"\x01a\x18\x01 \x01(\x03R\x01a\x12\f\n" +
-	"\x01b\x18\x02 \x01(\x03R\x01b\"\x1d\n" +
-	"\tAddResult\x12\x10\n" +
-	"\x03res\x18\x01 \x01(\x03R\x03res\"R\n" +
-	"\bTreeNode\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\x03R\x05value\x120\n" +
-	"\bchildren\x18\x02 \x03(\v2\x14.goserbench.TreeNodeR\bchildren\"O\n" +
-	"\x0fMultTreeRequest\x12\x12\n" +
-	"\x04mult\x18\x01 \x01(\x03R\x04mult\x12(\n" +
-	"\x04tree\x18\x02 \x01(\v2\x14.goserbench.TreeNodeR\x04tree\"<\n" +
-	"\x10MultTreeResponse\x12(\n" +
-	"\x04tree\x18\x01 \x01(\v2\x14.goserbench.TreeNodeR\x04tree\"\x1e\n" +
-	"\fToHexRequest\x12\x0e\n" +
-	"\x02in\x18\x01 \x01(\fR\x02in\"!\n" +
-	"\rToHexResponse\x12\x10\n" +
-	"\x03out\x18\x01 \x01(\fR\x03out2\xfb\x01\n" +
-	"\x03API\x123\n" +
-	"\x03Nop\x12\x14.goserbench.VoidData\x1a\x14.goserbench.VoidData\"\x00\x126\n" +
-	"\x03Add\x12\x16.goserbench.AddRequest\x1a\x15.goserbench.AddResult\"\x00\x12G\n" +
-	"\bMultTree\x12\x1b.goserbench.MultTreeRequest\x1a\x1c.goserbench.MultTreeResponse\"\x00\x12>\n" +
-	"\x05ToHex\x12\x18.goserbench.ToHexRequest\x1a\x19.goserbench.ToHexResponse\"\x00B7Z5github.com/matheusd/gorpcbench/internal/rpc/grpc;grpcb\x06proto3"
+func (x *ServerStreamRequest) Reset() {
+	*x = ServerStreamRequest{}
+	mi := &file_structdef_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerStreamRequest) ProtoMessage() {}
+
+func (x *ServerStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_structdef_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerStreamRequest.ProtoReflect.Descriptor instead.
+func (*ServerStreamRequest) Descriptor() ([]byte, []int) {
+	return file_structdef_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ServerStreamRequest) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *ServerStreamRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type StreamItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         int64                  `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamItem) Reset() {
+	*x = StreamItem{}
+	mi := &file_structdef_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamItem) ProtoMessage() {}
+
+func (x *StreamItem) ProtoReflect() protoreflect.Message {
+	mi := &file_structdef_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamItem.ProtoReflect.Descriptor instead.
+func (*StreamItem) Descriptor() ([]byte, []int) {
+	return file_structdef_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *StreamItem) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type StreamChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamChunk) Reset() {
+	*x = StreamChunk{}
+	mi := &file_structdef_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamChunk) ProtoMessage() {}
+
+func (x *StreamChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_structdef_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamChunk.ProtoReflect.Descriptor instead.
+func (*StreamChunk) Descriptor() ([]byte, []int) {
+	return file_structdef_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StreamChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ClientStreamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Checksum      uint32                 `protobuf:"varint,1,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClientStreamResponse) Reset() {
+	*x = ClientStreamResponse{}
+	mi := &file_structdef_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientStreamResponse) ProtoMessage() {}
+
+func (x *ClientStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_structdef_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientStreamResponse.ProtoReflect.Descriptor instead.
+func (*ClientStreamResponse) Descriptor() ([]byte, []int) {
+	return file_structdef_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ClientStreamResponse) GetChecksum() uint32 {
+	if x != nil {
+		return x.Checksum
+	}
+	return 0
+}
+
+type ValidateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         int64                  `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	Code          uint32                 `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateRequest) Reset() {
+	*x = ValidateRequest{}
+	mi := &file_structdef_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateRequest) ProtoMessage() {}
+
+func (x *ValidateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_structdef_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateRequest.ProtoReflect.Descriptor instead.
+func (*ValidateRequest) Descriptor() ([]byte, []int) {
+	return file_structdef_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ValidateRequest) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *ValidateRequest) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+type ValidateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         int64                  `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateResponse) Reset() {
+	*x = ValidateResponse{}
+	mi := &file_structdef_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateResponse) ProtoMessage() {}
+
+func (x *ValidateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_structdef_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateResponse.ProtoReflect.Descriptor instead.
+func (*ValidateResponse) Descriptor() ([]byte, []int) {
+	return file_structdef_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ValidateResponse) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// CallbackMessage is, in order: the request of the client (with the start and
+// count of callbacks), a callback made by the server or the reply of the client
+// to it, and the result of the call (with return set).
+type CallbackMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         int64                  `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Return        bool                   `protobuf:"varint,3,opt,name=return,proto3" json:"return,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CallbackMessage) Reset() {
+	*x = CallbackMessage{}
+	mi := &file_structdef_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallbackMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallbackMessage) ProtoMessage() {}
+
+func (x *CallbackMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_structdef_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallbackMessage.ProtoReflect.Descriptor instead.
+func (*CallbackMessage) Descriptor() ([]byte, []int) {
+	return file_structdef_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CallbackMessage) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *CallbackMessage) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *CallbackMessage) GetReturn() bool {
+	if x != nil {
+		return x.Return
+	}
+	return false
+}
+
+type SlowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delay         int64                  `protobuf:"varint,1,opt,name=delay,proto3" json:"delay,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlowRequest) Reset() {
+	*x = SlowRequest{}
+	mi := &file_structdef_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlowRequest) ProtoMessage() {}
+
+func (x *SlowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_structdef_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlowRequest.ProtoReflect.Descriptor instead.
+func (*SlowRequest) Descriptor() ([]byte, []int) {
+	return file_structdef_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SlowRequest) GetDelay() int64 {
+	if x != nil {
+		return x.Delay
+	}
+	return 0
+}
+
+type SlowCallsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Started       int64                  `protobuf:"varint,1,opt,name=started,proto3" json:"started,omitempty"`
+	Canceled      int64                  `protobuf:"varint,2,opt,name=canceled,proto3" json:"canceled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlowCallsResponse) Reset() {
+	*x = SlowCallsResponse{}
+	mi := &file_structdef_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlowCallsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlowCallsResponse) ProtoMessage() {}
+
+func (x *SlowCallsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_structdef_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlowCallsResponse.ProtoReflect.Descriptor instead.
+func (*SlowCallsResponse) Descriptor() ([]byte, []int) {
+	return file_structdef_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SlowCallsResponse) GetStarted() int64 {
+	if x != nil {
+		return x.Started
+	}
+	return 0
+}
+
+func (x *SlowCallsResponse) GetCanceled() int64 {
+	if x != nil {
+		return x.Canceled
+	}
+	return 0
+}
+
+type WorkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Duration      int64                  `protobuf:"varint,1,opt,name=duration,proto3" json:"duration,omitempty"`
+	Cpu           bool                   `protobuf:"varint,2,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkRequest) Reset() {
+	*x = WorkRequest{}
+	mi := &file_structdef_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkRequest) ProtoMessage() {}
+
+func (x *WorkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_structdef_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkRequest.ProtoReflect.Descriptor instead.
+func (*WorkRequest) Descriptor() ([]byte, []int) {
+	return file_structdef_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *WorkRequest) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *WorkRequest) GetCpu() bool {
+	if x != nil {
+		return x.Cpu
+	}
+	return false
+}
+
+type LargeMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LargeMessage) Reset() {
+	*x = LargeMessage{}
+	mi := &file_structdef_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LargeMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LargeMessage) ProtoMessage() {}
+
+func (x *LargeMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_structdef_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LargeMessage.ProtoReflect.Descriptor instead.
+func (*LargeMessage) Descriptor() ([]byte, []int) {
+	return file_structdef_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *LargeMessage) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type Record struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Bio           string                 `protobuf:"bytes,4,opt,name=bio,proto3" json:"bio,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Record) Reset() {
+	*x = Record{}
+	mi := &file_structdef_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Record) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Record) ProtoMessage() {}
+
+func (x *Record) ProtoReflect() protoreflect.Message {
+	mi := &file_structdef_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Record.ProtoReflect.Descriptor instead.
+func (*Record) Descriptor() ([]byte, []int) {
+	return file_structdef_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Record) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Record) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Record) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Record) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+type RecordList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Records       []*Record              `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordList) Reset() {
+	*x = RecordList{}
+	mi := &file_structdef_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordList) ProtoMessage() {}
+
+func (x *RecordList) ProtoReflect() protoreflect.Message {
+	mi := &file_structdef_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordList.ProtoReflect.Descriptor instead.
+func (*RecordList) Descriptor() ([]byte, []int) {
+	return file_structdef_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RecordList) GetRecords() []*Record {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type Row struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId     int64                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Balance       int64                  `protobuf:"varint,5,opt,name=balance,proto3" json:"balance,omitempty"`
+	Version       int32                  `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	Status        int32                  `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"`
+	Score         float64                `protobuf:"fixed64,8,opt,name=score,proto3" json:"score,omitempty"`
+	Active        bool                   `protobuf:"varint,9,opt,name=active,proto3" json:"active,omitempty"`
+	Verified      bool                   `protobuf:"varint,10,opt,name=verified,proto3" json:"verified,omitempty"`
+	Name          string                 `protobuf:"bytes,11,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,12,opt,name=email,proto3" json:"email,omitempty"`
+	Country       string                 `protobuf:"bytes,13,opt,name=country,proto3" json:"country,omitempty"`
+	Currency      string                 `protobuf:"bytes,14,opt,name=currency,proto3" json:"currency,omitempty"`
+	Plan          string                 `protobuf:"bytes,15,opt,name=plan,proto3" json:"plan,omitempty"`
+	Region        string                 `protobuf:"bytes,16,opt,name=region,proto3" json:"region,omitempty"`
+	DeletedAt     *int64                 `protobuf:"varint,17,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`
+	ParentId      *int64                 `protobuf:"varint,18,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	CreditLimit   *int64                 `protobuf:"varint,19,opt,name=credit_limit,json=creditLimit,proto3,oneof" json:"credit_limit,omitempty"`
+	Priority      *int32                 `protobuf:"varint,20,opt,name=priority,proto3,oneof" json:"priority,omitempty"`
+	Retries       *int32                 `protobuf:"varint,21,opt,name=retries,proto3,oneof" json:"retries,omitempty"`
+	Discount      *float64               `protobuf:"fixed64,22,opt,name=discount,proto3,oneof" json:"discount,omitempty"`
+	Archived      *bool                  `protobuf:"varint,23,opt,name=archived,proto3,oneof" json:"archived,omitempty"`
+	TwoFactor     *bool                  `protobuf:"varint,24,opt,name=two_factor,json=twoFactor,proto3,oneof" json:"two_factor,omitempty"`
+	ExternalId    *string                `protobuf:"bytes,25,opt,name=external_id,json=externalId,proto3,oneof" json:"external_id,omitempty"`
+	Phone         *string                `protobuf:"bytes,26,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
+	Company       *string                `protobuf:"bytes,27,opt,name=company,proto3,oneof" json:"company,omitempty"`
+	Title         *string                `protobuf:"bytes,28,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Locale        *string                `protobuf:"bytes,29,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+	Timezone      *string                `protobuf:"bytes,30,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`
+	Referrer      *string                `protobuf:"bytes,31,opt,name=referrer,proto3,oneof" json:"referrer,omitempty"`
+	Notes         *string                `protobuf:"bytes,32,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Row) Reset() {
+	*x = Row{}
+	mi := &file_structdef_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Row) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Row) ProtoMessage() {}
+
+func (x *Row) ProtoReflect() protoreflect.Message {
+	mi := &file_structdef_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Row.ProtoReflect.Descriptor instead.
+func (*Row) Descriptor() ([]byte, []int) {
+	return file_structdef_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Row) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Row) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *Row) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Row) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Row) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *Row) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Row) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *Row) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Row) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Row) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *Row) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Row) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Row) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Row) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Row) GetPlan() string {
+	if x != nil {
+		return x.Plan
+	}
+	return ""
+}
+
+func (x *Row) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Row) GetDeletedAt() int64 {
+	if x != nil && x.DeletedAt != nil {
+		return *x.DeletedAt
+	}
+	return 0
+}
+
+func (x *Row) GetParentId() int64 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return 0
+}
+
+func (x *Row) GetCreditLimit() int64 {
+	if x != nil && x.CreditLimit != nil {
+		return *x.CreditLimit
+	}
+	return 0
+}
+
+func (x *Row) GetPriority() int32 {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return 0
+}
+
+func (x *Row) GetRetries() int32 {
+	if x != nil && x.Retries != nil {
+		return *x.Retries
+	}
+	return 0
+}
+
+func (x *Row) GetDiscount() float64 {
+	if x != nil && x.Discount != nil {
+		return *x.Discount
+	}
+	return 0
+}
+
+func (x *Row) GetArchived() bool {
+	if x != nil && x.Archived != nil {
+		return *x.Archived
+	}
+	return false
+}
+
+func (x *Row) GetTwoFactor() bool {
+	if x != nil && x.TwoFactor != nil {
+		return *x.TwoFactor
+	}
+	return false
+}
+
+func (x *Row) GetExternalId() string {
+	if x != nil && x.ExternalId != nil {
+		return *x.ExternalId
+	}
+	return ""
+}
+
+func (x *Row) GetPhone() string {
+	if x != nil && x.Phone != nil {
+		return *x.Phone
+	}
+	return ""
+}
+
+func (x *Row) GetCompany() string {
+	if x != nil && x.Company != nil {
+		return *x.Company
+	}
+	return ""
+}
+
+func (x *Row) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *Row) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+func (x *Row) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
+func (x *Row) GetReferrer() string {
+	if x != nil && x.Referrer != nil {
+		return *x.Referrer
+	}
+	return ""
+}
+
+func (x *Row) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+type RowPage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rows          []*Row                 `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RowPage) Reset() {
+	*x = RowPage{}
+	mi := &file_structdef_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RowPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RowPage) ProtoMessage() {}
+
+func (x *RowPage) ProtoReflect() protoreflect.Message {
+	mi := &file_structdef_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RowPage.ProtoReflect.Descriptor instead.
+func (*RowPage) Descriptor() ([]byte, []int) {
+	return file_structdef_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RowPage) GetRows() []*Row {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type CounterMap struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        map[string]int64       `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CounterMap) Reset() {
+	*x = CounterMap{}
+	mi := &file_structdef_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CounterMap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CounterMap) ProtoMessage() {}
+
+func (x *CounterMap) ProtoReflect() protoreflect.Message {
+	mi := &file_structdef_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CounterMap.ProtoReflect.Descriptor instead.
+func (*CounterMap) Descriptor() ([]byte, []int) {
+	return file_structdef_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CounterMap) GetValues() map[string]int64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type ScaleVectorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []float64              `protobuf:"fixed64,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+	Scale         float64                `protobuf:"fixed64,2,opt,name=scale,proto3" json:"scale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScaleVectorRequest) Reset() {
+	*x = ScaleVectorRequest{}
+	mi := &file_structdef_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScaleVectorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScaleVectorRequest) ProtoMessage() {}
+
+func (x *ScaleVectorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_structdef_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScaleVectorRequest.ProtoReflect.Descriptor instead.
+func (*ScaleVectorRequest) Descriptor() ([]byte, []int) {
+	return file_structdef_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ScaleVectorRequest) GetValues() []float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *ScaleVectorRequest) GetScale() float64 {
+	if x != nil {
+		return x.Scale
+	}
+	return 0
+}
+
+type Vector struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []float64              `protobuf:"fixed64,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Vector) Reset() {
+	*x = Vector{}
+	mi := &file_structdef_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Vector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vector) ProtoMessage() {}
+
+func (x *Vector) ProtoReflect() protoreflect.Message {
+	mi := &file_structdef_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vector.ProtoReflect.Descriptor instead.
+func (*Vector) Descriptor() ([]byte, []int) {
+	return file_structdef_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Vector) GetValues() []float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+var File_structdef_proto protoreflect.FileDescriptor
+
+const file_structdef_proto_rawDesc = "" +
+	"\n" +
+	"\x0fstructdef.proto\x12\n" +
+	"goserbench\"\n" +
+	"\n" +
+	"\bVoidData\"(\n" +
+	"\n" +
+	"AddRequest\x12\f\n" +
+	"\x01a\x18\x01 \x01(\x03R\x01a\x12\f\n" +
+	"\x01b\x18\x02 \x01(\x03R\x01b\"\x1d\n" +
+	"\tAddResult\x12\x10\n" +
+	"\x03res\x18\x01 \x01(\x03R\x03res\"R\n" +
+	"\bTreeNode\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x03R\x05value\x120\n" +
+	"\bchildren\x18\x02 \x03(\v2\x14.goserbench.TreeNodeR\bchildren\"O\n" +
+	"\x0fMultTreeRequest\x12\x12\n" +
+	"\x04mult\x18\x01 \x01(\x03R\x04mult\x12(\n" +
+	"\x04tree\x18\x02 \x01(\v2\x14.goserbench.TreeNodeR\x04tree\"<\n" +
+	"\x10MultTreeResponse\x12(\n" +
+	"\x04tree\x18\x01 \x01(\v2\x14.goserbench.TreeNodeR\x04tree\"\x1e\n" +
+	"\fToHexRequest\x12\x0e\n" +
+	"\x02in\x18\x01 \x01(\fR\x02in\"!\n" +
+	"\rToHexResponse\x12\x10\n" +
+	"\x03out\x18\x01 \x01(\fR\x03out\"A\n" +
+	"\x13ServerStreamRequest\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\x03R\x05start\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"\"\n" +
+	"\n" +
+	"StreamItem\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x03R\x05value\"!\n" +
+	"\vStreamChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"2\n" +
+	"\x14ClientStreamResponse\x12\x1a\n" +
+	"\bchecksum\x18\x01 \x01(\rR\bchecksum\";\n" +
+	"\x0fValidateRequest\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x03R\x05value\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\rR\x04code\"(\n" +
+	"\x10ValidateResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x03R\x05value\"U\n" +
+	"\x0fCallbackMessage\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x03R\x05value\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x12\x16\n" +
+	"\x06return\x18\x03 \x01(\bR\x06return\"#\n" +
+	"\vSlowRequest\x12\x14\n" +
+	"\x05delay\x18\x01 \x01(\x03R\x05delay\"I\n" +
+	"\x11SlowCallsResponse\x12\x18\n" +
+	"\astarted\x18\x01 \x01(\x03R\astarted\x12\x1a\n" +
+	"\bcanceled\x18\x02 \x01(\x03R\bcanceled\";\n" +
+	"\vWorkRequest\x12\x1a\n" +
+	"\bduration\x18\x01 \x01(\x03R\bduration\x12\x10\n" +
+	"\x03cpu\x18\x02 \x01(\bR\x03cpu\"\"\n" +
+	"\fLargeMessage\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"T\n" +
+	"\x06Record\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x10\n" +
+	"\x03bio\x18\x04 \x01(\tR\x03bio\":\n" +
+	"\n" +
+	"RecordList\x12,\n" +
+	"\arecords\x18\x01 \x03(\v2\x12.goserbench.RecordR\arecords\"\xec\b\n" +
+	"\x03Row\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x03R\taccountId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\x03R\tupdatedAt\x12\x18\n" +
+	"\abalance\x18\x05 \x01(\x03R\abalance\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\x05R\aversion\x12\x16\n" +
+	"\x06status\x18\a \x01(\x05R\x06status\x12\x14\n" +
+	"\x05score\x18\b \x01(\x01R\x05score\x12\x16\n" +
+	"\x06active\x18\t \x01(\bR\x06active\x12\x1a\n" +
+	"\bverified\x18\n" +
+	" \x01(\bR\bverified\x12\x12\n" +
+	"\x04name\x18\v \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\f \x01(\tR\x05email\x12\x18\n" +
+	"\acountry\x18\r \x01(\tR\acountry\x12\x1a\n" +
+	"\bcurrency\x18\x0e \x01(\tR\bcurrency\x12\x12\n" +
+	"\x04plan\x18\x0f \x01(\tR\x04plan\x12\x16\n" +
+	"\x06region\x18\x10 \x01(\tR\x06region\x12\"\n" +
+	"\n" +
+	"deleted_at\x18\x11 \x01(\x03H\x00R\tdeletedAt\x88\x01\x01\x12 \n" +
+	"\tparent_id\x18\x12 \x01(\x03H\x01R\bparentId\x88\x01\x01\x12&\n" +
+	"\fcredit_limit\x18\x13 \x01(\x03H\x02R\vcreditLimit\x88\x01\x01\x12\x1f\n" +
+	"\bpriority\x18\x14 \x01(\x05H\x03R\bpriority\x88\x01\x01\x12\x1d\n" +
+	"\aretries\x18\x15 \x01(\x05H\x04R\aretries\x88\x01\x01\x12\x1f\n" +
+	"\bdiscount\x18\x16 \x01(\x01H\x05R\bdiscount\x88\x01\x01\x12\x1f\n" +
+	"\barchived\x18\x17 \x01(\bH\x06R\barchived\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"two_factor\x18\x18 \x01(\bH\aR\ttwoFactor\x88\x01\x01\x12$\n" +
+	"\vexternal_id\x18\x19 \x01(\tH\bR\n" +
+	"externalId\x88\x01\x01\x12\x19\n" +
+	"\x05phone\x18\x1a \x01(\tH\tR\x05phone\x88\x01\x01\x12\x1d\n" +
+	"\acompany\x18\x1b \x01(\tH\n" +
+	"R\acompany\x88\x01\x01\x12\x19\n" +
+	"\x05title\x18\x1c \x01(\tH\vR\x05title\x88\x01\x01\x12\x1b\n" +
+	"\x06locale\x18\x1d \x01(\tH\fR\x06locale\x88\x01\x01\x12\x1f\n" +
+	"\btimezone\x18\x1e \x01(\tH\rR\btimezone\x88\x01\x01\x12\x1f\n" +
+	"\breferrer\x18\x1f \x01(\tH\x0eR\breferrer\x88\x01\x01\x12\x19\n" +
+	"\x05notes\x18  \x01(\tH\x0fR\x05notes\x88\x01\x01B\r\n" +
+	"\v_deleted_atB\f\n" +
+	"\n" +
+	"_parent_idB\x0f\n" +
+	"\r_credit_limitB\v\n" +
+	"\t_priorityB\n" +
+	"\n" +
+	"\b_retriesB\v\n" +
+	"\t_discountB\v\n" +
+	"\t_archivedB\r\n" +
+	"\v_two_factorB\x0e\n" +
+	"\f_external_idB\b\n" +
+	"\x06_phoneB\n" +
+	"\n" +
+	"\b_companyB\b\n" +
+	"\x06_titleB\t\n" +
+	"\a_localeB\v\n" +
+	"\t_timezoneB\v\n" +
+	"\t_referrerB\b\n" +
+	"\x06_notes\".\n" +
+	"\aRowPage\x12#\n" +
+	"\x04rows\x18\x01 \x03(\v2\x0f.goserbench.RowR\x04rows\"\x83\x01\n" +
+	"\n" +
+	"CounterMap\x12:\n" +
+	"\x06values\x18\x01 \x03(\v2\".goserbench.CounterMap.ValuesEntryR\x06values\x1a9\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"B\n" +
+	"\x12ScaleVectorRequest\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\x01R\x06values\x12\x14\n" +
+	"\x05scale\x18\x02 \x01(\x01R\x05scale\" \n" +
+	"\x06Vector\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\x01R\x06values2\xa8\t\n" +
+	"\x03API\x123\n" +
+	"\x03Nop\x12\x14.goserbench.VoidData\x1a\x14.goserbench.VoidData\"\x00\x126\n" +
+	"\x03Add\x12\x16.goserbench.AddRequest\x1a\x15.goserbench.AddResult\"\x00\x12G\n" +
+	"\bMultTree\x12\x1b.goserbench.MultTreeRequest\x1a\x1c.goserbench.MultTreeResponse\"\x00\x12>\n" +
+	"\x05ToHex\x12\x18.goserbench.ToHexRequest\x1a\x19.goserbench.ToHexResponse\"\x00\x12K\n" +
+	"\fServerStream\x12\x1f.goserbench.ServerStreamRequest\x1a\x16.goserbench.StreamItem\"\x000\x01\x12M\n" +
+	"\fClientStream\x12\x17.goserbench.StreamChunk\x1a .goserbench.ClientStreamResponse\"\x00(\x01\x12B\n" +
+	"\n" +
+	"BidiStream\x12\x16.goserbench.StreamItem\x1a\x16.goserbench.StreamItem\"\x00(\x010\x01\x12J\n" +
+	"\bCallback\x12\x1b.goserbench.CallbackMessage\x1a\x1b.goserbench.CallbackMessage\"\x00(\x010\x01\x12G\n" +
+	"\bValidate\x12\x1b.goserbench.ValidateRequest\x1a\x1c.goserbench.ValidateResponse\"\x00\x127\n" +
+	"\x04Slow\x12\x17.goserbench.SlowRequest\x1a\x14.goserbench.VoidData\"\x00\x12B\n" +
+	"\tSlowCalls\x12\x14.goserbench.VoidData\x1a\x1d.goserbench.SlowCallsResponse\"\x00\x127\n" +
+	"\x04Work\x12\x17.goserbench.WorkRequest\x1a\x14.goserbench.VoidData\"\x00\x12<\n" +
+	"\x04Echo\x12\x18.goserbench.LargeMessage\x1a\x18.goserbench.LargeMessage\"\x00\x12@\n" +
+	"\fUpperRecords\x12\x16.goserbench.RecordList\x1a\x16.goserbench.RecordList\"\x00\x128\n" +
+	"\n" +
+	"UpdateRows\x12\x13.goserbench.RowPage\x1a\x13.goserbench.RowPage\"\x00\x12@\n" +
+	"\fIncrementMap\x12\x16.goserbench.CounterMap\x1a\x16.goserbench.CounterMap\"\x00\x12C\n" +
+	"\vScaleVector\x12\x1e.goserbench.ScaleVectorRequest\x1a\x12.goserbench.Vector\"\x00\x12?\n" +
+	"\x0fNopWithMetadata\x12\x14.goserbench.VoidData\x1a\x14.goserbench.VoidData\"\x00B7Z5github.com/matheusd/gorpcbench/internal/rpc/grpc;grpcb\x06proto3"
 
 var (
 	file_structdef_proto_rawDescOnce sync.Once
@@ -440,34 +1686,84 @@ func file_structdef_proto_rawDescGZIP() []byte {
 	return file_structdef_proto_rawDescData
 }
 
-var file_structdef_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_structdef_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_structdef_proto_goTypes = []any{
-	(*VoidData)(nil),         // 0: goserbench.VoidData
-	(*AddRequest)(nil),       // 1: goserbench.AddRequest
-	(*AddResult)(nil),        // 2: goserbench.AddResult
-	(*TreeNode)(nil),         // 3: goserbench.TreeNode
-	(*MultTreeRequest)(nil),  // 4: goserbench.MultTreeRequest
-	(*MultTreeResponse)(nil), // 5: goserbench.MultTreeResponse
-	(*ToHexRequest)(nil),     // 6: goserbench.ToHexRequest
-	(*ToHexResponse)(nil),    // 7: goserbench.ToHexResponse
+	(*VoidData)(nil),             // 0: goserbench.VoidData
+	(*AddRequest)(nil),           // 1: goserbench.AddRequest
+	(*AddResult)(nil),            // 2: goserbench.AddResult
+	(*TreeNode)(nil),             // 3: goserbench.TreeNode
+	(*MultTreeRequest)(nil),      // 4: goserbench.MultTreeRequest
+	(*MultTreeResponse)(nil),     // 5: goserbench.MultTreeResponse
+	(*ToHexRequest)(nil),         // 6: goserbench.ToHexRequest
+	(*ToHexResponse)(nil),        // 7: goserbench.ToHexResponse
+	(*ServerStreamRequest)(nil),  // 8: goserbench.ServerStreamRequest
+	(*StreamItem)(nil),           // 9: goserbench.StreamItem
+	(*StreamChunk)(nil),          // 10: goserbench.StreamChunk
+	(*ClientStreamResponse)(nil), // 11: goserbench.ClientStreamResponse
+	(*ValidateRequest)(nil),      // 12: goserbench.ValidateRequest
+	(*ValidateResponse)(nil),     // 13: goserbench.ValidateResponse
+	(*CallbackMessage)(nil),      // 14: goserbench.CallbackMessage
+	(*SlowRequest)(nil),          // 15: goserbench.SlowRequest
+	(*SlowCallsResponse)(nil),    // 16: goserbench.SlowCallsResponse
+	(*WorkRequest)(nil),          // 17: goserbench.WorkRequest
+	(*LargeMessage)(nil),         // 18: goserbench.LargeMessage
+	(*Record)(nil),               // 19: goserbench.Record
+	(*RecordList)(nil),           // 20: goserbench.RecordList
+	(*Row)(nil),                  // 21: goserbench.Row
+	(*RowPage)(nil),              // 22: goserbench.RowPage
+	(*CounterMap)(nil),           // 23: goserbench.CounterMap
+	(*ScaleVectorRequest)(nil),   // 24: goserbench.ScaleVectorRequest
+	(*Vector)(nil),               // 25: goserbench.Vector
+	nil,                          // 26: goserbench.CounterMap.ValuesEntry
 }
 var file_structdef_proto_depIdxs = []int32{
-	3, // 0: goserbench.TreeNode.children:type_name -> goserbench.TreeNode
-	3, // 1: goserbench.MultTreeRequest.tree:type_name -> goserbench.TreeNode
-	3, // 2: goserbench.MultTreeResponse.tree:type_name -> goserbench.TreeNode
-	0, // 3: goserbench.API.Nop:input_type -> goserbench.VoidData
-	1, // 4: goserbench.API.Add:input_type -> goserbench.AddRequest
-	4, // 5: goserbench.API.MultTree:input_type -> goserbench.MultTreeRequest
-	6, // 6: goserbench.API.ToHex:input_type -> goserbench.ToHexRequest
-	0, // 7: goserbench.API.Nop:output_type -> goserbench.VoidData
-	2, // 8: goserbench.API.Add:output_type -> goserbench.AddResult
-	5, // 9: goserbench.API.MultTree:output_type -> goserbench.MultTreeResponse
-	7, // 10: goserbench.API.ToHex:output_type -> goserbench.ToHexResponse
-	7, // [7:11] is the sub-list for method output_type
-	3, // [3:7] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	3,  // 0: goserbench.TreeNode.children:type_name -> goserbench.TreeNode
+	3,  // 1: goserbench.MultTreeRequest.tree:type_name -> goserbench.TreeNode
+	3,  // 2: goserbench.MultTreeResponse.tree:type_name -> goserbench.TreeNode
+	19, // 3: goserbench.RecordList.records:type_name -> goserbench.Record
+	21, // 4: goserbench.RowPage.rows:type_name -> goserbench.Row
+	26, // 5: goserbench.CounterMap.values:type_name -> goserbench.CounterMap.ValuesEntry
+	0,  // 6: goserbench.API.Nop:input_type -> goserbench.VoidData
+	1,  // 7: goserbench.API.Add:input_type -> goserbench.AddRequest
+	4,  // 8: goserbench.API.MultTree:input_type -> goserbench.MultTreeRequest
+	6,  // 9: goserbench.API.ToHex:input_type -> goserbench.ToHexRequest
+	8,  // 10: goserbench.API.ServerStream:input_type -> goserbench.ServerStreamRequest
+	10, // 11: goserbench.API.ClientStream:input_type -> goserbench.StreamChunk
+	9,  // 12: goserbench.API.BidiStream:input_type -> goserbench.StreamItem
+	14, // 13: goserbench.API.Callback:input_type -> goserbench.CallbackMessage
+	12, // 14: goserbench.API.Validate:input_type -> goserbench.ValidateRequest
+	15, // 15: goserbench.API.Slow:input_type -> goserbench.SlowRequest
+	0,  // 16: goserbench.API.SlowCalls:input_type -> goserbench.VoidData
+	17, // 17: goserbench.API.Work:input_type -> goserbench.WorkRequest
+	18, // 18: goserbench.API.Echo:input_type -> goserbench.LargeMessage
+	20, // 19: goserbench.API.UpperRecords:input_type -> goserbench.RecordList
+	22, // 20: goserbench.API.UpdateRows:input_type -> goserbench.RowPage
+	23, // 21: goserbench.API.IncrementMap:input_type -> goserbench.CounterMap
+	24, // 22: goserbench.API.ScaleVector:input_type -> goserbench.ScaleVectorRequest
+	0,  // 23: goserbench.API.NopWithMetadata:input_type -> goserbench.VoidData
+	0,  // 24: goserbench.API.Nop:output_type -> goserbench.VoidData
+	2,  // 25: goserbench.API.Add:output_type -> goserbench.AddResult
+	5,  // 26: goserbench.API.MultTree:output_type -> goserbench.MultTreeResponse
+	7,  // 27: goserbench.API.ToHex:output_type -> goserbench.ToHexResponse
+	9,  // 28: goserbench.API.ServerStream:output_type -> goserbench.StreamItem
+	11, // 29: goserbench.API.ClientStream:output_type -> goserbench.ClientStreamResponse
+	9,  // 30: goserbench.API.BidiStream:output_type -> goserbench.StreamItem
+	14, // 31: goserbench.API.Callback:output_type -> goserbench.CallbackMessage
+	13, // 32: goserbench.API.Validate:output_type -> goserbench.ValidateResponse
+	0,  // 33: goserbench.API.Slow:output_type -> goserbench.VoidData
+	16, // 34: goserbench.API.SlowCalls:output_type -> goserbench.SlowCallsResponse
+	0,  // 35: goserbench.API.Work:output_type -> goserbench.VoidData
+	18, // 36: goserbench.API.Echo:output_type -> goserbench.LargeMessage
+	20, // 37: goserbench.API.UpperRecords:output_type -> goserbench.RecordList
+	22, // 38: goserbench.API.UpdateRows:output_type -> goserbench.RowPage
+	23, // 39: goserbench.API.IncrementMap:output_type -> goserbench.CounterMap
+	25, // 40: goserbench.API.ScaleVector:output_type -> goserbench.Vector
+	0,  // 41: goserbench.API.NopWithMetadata:output_type -> goserbench.VoidData
+	24, // [24:42] is the sub-list for method output_type
+	6,  // [6:24] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_structdef_proto_init() }
@@ -475,13 +1771,14 @@ func file_structdef_proto_init() {
 	if File_structdef_proto != nil {
 		return
 	}
+	file_structdef_proto_msgTypes[21].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_structdef_proto_rawDesc), len(file_structdef_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
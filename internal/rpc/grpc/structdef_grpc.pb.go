@@ -8,9 +8,6 @@
 // - protoc             v3.21.12
 // source: structdef.proto
 
-//*
-// ffoooo
-
 package grpc
 
 import (
@@ -26,10 +23,24 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	API_Nop_FullMethodName      = "/goserbench.API/Nop"
-	API_Add_FullMethodName      = "/goserbench.API/Add"
-	API_MultTree_FullMethodName = "/goserbench.API/MultTree"
-	API_ToHex_FullMethodName    = "/goserbench.API/ToHex"
+	API_Nop_FullMethodName             = "/goserbench.API/Nop"
+	API_Add_FullMethodName             = "/goserbench.API/Add"
+	API_MultTree_FullMethodName        = "/goserbench.API/MultTree"
+	API_ToHex_FullMethodName           = "/goserbench.API/ToHex"
+	API_ServerStream_FullMethodName    = "/goserbench.API/ServerStream"
+	API_ClientStream_FullMethodName    = "/goserbench.API/ClientStream"
+	API_BidiStream_FullMethodName      = "/goserbench.API/BidiStream"
+	API_Callback_FullMethodName        = "/goserbench.API/Callback"
+	API_Validate_FullMethodName        = "/goserbench.API/Validate"
+	API_Slow_FullMethodName            = "/goserbench.API/Slow"
+	API_SlowCalls_FullMethodName       = "/goserbench.API/SlowCalls"
+	API_Work_FullMethodName            = "/goserbench.API/Work"
+	API_Echo_FullMethodName            = "/goserbench.API/Echo"
+	API_UpperRecords_FullMethodName    = "/goserbench.API/UpperRecords"
+	API_UpdateRows_FullMethodName      = "/goserbench.API/UpdateRows"
+	API_IncrementMap_FullMethodName    = "/goserbench.API/IncrementMap"
+	API_ScaleVector_FullMethodName     = "/goserbench.API/ScaleVector"
+	API_NopWithMetadata_FullMethodName = "/goserbench.API/NopWithMetadata"
 )
 
 // APIClient is the client API for API service.
@@ -40,6 +51,20 @@ type APIClient interface {
 	Add(ctx context.Context, in *AddRequest, opts ...grpc.CallOption) (*AddResult, error)
 	MultTree(ctx context.Context, in *MultTreeRequest, opts ...grpc.CallOption) (*MultTreeResponse, error)
 	ToHex(ctx context.Context, in *ToHexRequest, opts ...grpc.CallOption) (*ToHexResponse, error)
+	ServerStream(ctx context.Context, in *ServerStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamItem], error)
+	ClientStream(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[StreamChunk, ClientStreamResponse], error)
+	BidiStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[StreamItem, StreamItem], error)
+	Callback(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[CallbackMessage, CallbackMessage], error)
+	Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateResponse, error)
+	Slow(ctx context.Context, in *SlowRequest, opts ...grpc.CallOption) (*VoidData, error)
+	SlowCalls(ctx context.Context, in *VoidData, opts ...grpc.CallOption) (*SlowCallsResponse, error)
+	Work(ctx context.Context, in *WorkRequest, opts ...grpc.CallOption) (*VoidData, error)
+	Echo(ctx context.Context, in *LargeMessage, opts ...grpc.CallOption) (*LargeMessage, error)
+	UpperRecords(ctx context.Context, in *RecordList, opts ...grpc.CallOption) (*RecordList, error)
+	UpdateRows(ctx context.Context, in *RowPage, opts ...grpc.CallOption) (*RowPage, error)
+	IncrementMap(ctx context.Context, in *CounterMap, opts ...grpc.CallOption) (*CounterMap, error)
+	ScaleVector(ctx context.Context, in *ScaleVectorRequest, opts ...grpc.CallOption) (*Vector, error)
+	NopWithMetadata(ctx context.Context, in *VoidData, opts ...grpc.CallOption) (*VoidData, error)
 }
 
 type aPIClient struct {
@@ -90,6 +115,164 @@ func (c *aPIClient) ToHex(ctx context.Context, in *ToHexRequest, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *aPIClient) ServerStream(ctx context.Context, in *ServerStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamItem], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[0], API_ServerStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ServerStreamRequest, StreamItem]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type API_ServerStreamClient = grpc.ServerStreamingClient[StreamItem]
+
+func (c *aPIClient) ClientStream(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[StreamChunk, ClientStreamResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[1], API_ClientStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamChunk, ClientStreamResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type API_ClientStreamClient = grpc.ClientStreamingClient[StreamChunk, ClientStreamResponse]
+
+func (c *aPIClient) BidiStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[StreamItem, StreamItem], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[2], API_BidiStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamItem, StreamItem]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type API_BidiStreamClient = grpc.BidiStreamingClient[StreamItem, StreamItem]
+
+func (c *aPIClient) Callback(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[CallbackMessage, CallbackMessage], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[3], API_Callback_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[CallbackMessage, CallbackMessage]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type API_CallbackClient = grpc.BidiStreamingClient[CallbackMessage, CallbackMessage]
+
+func (c *aPIClient) Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateResponse)
+	err := c.cc.Invoke(ctx, API_Validate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) Slow(ctx context.Context, in *SlowRequest, opts ...grpc.CallOption) (*VoidData, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoidData)
+	err := c.cc.Invoke(ctx, API_Slow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) SlowCalls(ctx context.Context, in *VoidData, opts ...grpc.CallOption) (*SlowCallsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SlowCallsResponse)
+	err := c.cc.Invoke(ctx, API_SlowCalls_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) Work(ctx context.Context, in *WorkRequest, opts ...grpc.CallOption) (*VoidData, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoidData)
+	err := c.cc.Invoke(ctx, API_Work_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) Echo(ctx context.Context, in *LargeMessage, opts ...grpc.CallOption) (*LargeMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LargeMessage)
+	err := c.cc.Invoke(ctx, API_Echo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UpperRecords(ctx context.Context, in *RecordList, opts ...grpc.CallOption) (*RecordList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordList)
+	err := c.cc.Invoke(ctx, API_UpperRecords_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UpdateRows(ctx context.Context, in *RowPage, opts ...grpc.CallOption) (*RowPage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RowPage)
+	err := c.cc.Invoke(ctx, API_UpdateRows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) IncrementMap(ctx context.Context, in *CounterMap, opts ...grpc.CallOption) (*CounterMap, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CounterMap)
+	err := c.cc.Invoke(ctx, API_IncrementMap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ScaleVector(ctx context.Context, in *ScaleVectorRequest, opts ...grpc.CallOption) (*Vector, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Vector)
+	err := c.cc.Invoke(ctx, API_ScaleVector_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) NopWithMetadata(ctx context.Context, in *VoidData, opts ...grpc.CallOption) (*VoidData, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoidData)
+	err := c.cc.Invoke(ctx, API_NopWithMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // APIServer is the server API for API service.
 // All implementations must embed UnimplementedAPIServer
 // for forward compatibility.
@@ -98,6 +281,20 @@ type APIServer interface {
 	Add(context.Context, *AddRequest) (*AddResult, error)
 	MultTree(context.Context, *MultTreeRequest) (*MultTreeResponse, error)
 	ToHex(context.Context, *ToHexRequest) (*ToHexResponse, error)
+	ServerStream(*ServerStreamRequest, grpc.ServerStreamingServer[StreamItem]) error
+	ClientStream(grpc.ClientStreamingServer[StreamChunk, ClientStreamResponse]) error
+	BidiStream(grpc.BidiStreamingServer[StreamItem, StreamItem]) error
+	Callback(grpc.BidiStreamingServer[CallbackMessage, CallbackMessage]) error
+	Validate(context.Context, *ValidateRequest) (*ValidateResponse, error)
+	Slow(context.Context, *SlowRequest) (*VoidData, error)
+	SlowCalls(context.Context, *VoidData) (*SlowCallsResponse, error)
+	Work(context.Context, *WorkRequest) (*VoidData, error)
+	Echo(context.Context, *LargeMessage) (*LargeMessage, error)
+	UpperRecords(context.Context, *RecordList) (*RecordList, error)
+	UpdateRows(context.Context, *RowPage) (*RowPage, error)
+	IncrementMap(context.Context, *CounterMap) (*CounterMap, error)
+	ScaleVector(context.Context, *ScaleVectorRequest) (*Vector, error)
+	NopWithMetadata(context.Context, *VoidData) (*VoidData, error)
 	mustEmbedUnimplementedAPIServer()
 }
 
@@ -120,6 +317,48 @@ func (UnimplementedAPIServer) MultTree(context.Context, *MultTreeRequest) (*Mult
 func (UnimplementedAPIServer) ToHex(context.Context, *ToHexRequest) (*ToHexResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ToHex not implemented")
 }
+func (UnimplementedAPIServer) ServerStream(*ServerStreamRequest, grpc.ServerStreamingServer[StreamItem]) error {
+	return status.Errorf(codes.Unimplemented, "method ServerStream not implemented")
+}
+func (UnimplementedAPIServer) ClientStream(grpc.ClientStreamingServer[StreamChunk, ClientStreamResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ClientStream not implemented")
+}
+func (UnimplementedAPIServer) BidiStream(grpc.BidiStreamingServer[StreamItem, StreamItem]) error {
+	return status.Errorf(codes.Unimplemented, "method BidiStream not implemented")
+}
+func (UnimplementedAPIServer) Callback(grpc.BidiStreamingServer[CallbackMessage, CallbackMessage]) error {
+	return status.Errorf(codes.Unimplemented, "method Callback not implemented")
+}
+func (UnimplementedAPIServer) Validate(context.Context, *ValidateRequest) (*ValidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validate not implemented")
+}
+func (UnimplementedAPIServer) Slow(context.Context, *SlowRequest) (*VoidData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Slow not implemented")
+}
+func (UnimplementedAPIServer) SlowCalls(context.Context, *VoidData) (*SlowCallsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlowCalls not implemented")
+}
+func (UnimplementedAPIServer) Work(context.Context, *WorkRequest) (*VoidData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Work not implemented")
+}
+func (UnimplementedAPIServer) Echo(context.Context, *LargeMessage) (*LargeMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Echo not implemented")
+}
+func (UnimplementedAPIServer) UpperRecords(context.Context, *RecordList) (*RecordList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpperRecords not implemented")
+}
+func (UnimplementedAPIServer) UpdateRows(context.Context, *RowPage) (*RowPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRows not implemented")
+}
+func (UnimplementedAPIServer) IncrementMap(context.Context, *CounterMap) (*CounterMap, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncrementMap not implemented")
+}
+func (UnimplementedAPIServer) ScaleVector(context.Context, *ScaleVectorRequest) (*Vector, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScaleVector not implemented")
+}
+func (UnimplementedAPIServer) NopWithMetadata(context.Context, *VoidData) (*VoidData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NopWithMetadata not implemented")
+}
 func (UnimplementedAPIServer) mustEmbedUnimplementedAPIServer() {}
 func (UnimplementedAPIServer) testEmbeddedByValue()             {}
 
@@ -213,6 +452,218 @@ func _API_ToHex_Handler(srv interface{}, ctx context.Context, dec func(interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_ServerStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ServerStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).ServerStream(m, &grpc.GenericServerStream[ServerStreamRequest, StreamItem]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type API_ServerStreamServer = grpc.ServerStreamingServer[StreamItem]
+
+func _API_ClientStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(APIServer).ClientStream(&grpc.GenericServerStream[StreamChunk, ClientStreamResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type API_ClientStreamServer = grpc.ClientStreamingServer[StreamChunk, ClientStreamResponse]
+
+func _API_BidiStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(APIServer).BidiStream(&grpc.GenericServerStream[StreamItem, StreamItem]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type API_BidiStreamServer = grpc.BidiStreamingServer[StreamItem, StreamItem]
+
+func _API_Callback_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(APIServer).Callback(&grpc.GenericServerStream[CallbackMessage, CallbackMessage]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type API_CallbackServer = grpc.BidiStreamingServer[CallbackMessage, CallbackMessage]
+
+func _API_Validate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).Validate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_Validate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).Validate(ctx, req.(*ValidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_Slow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).Slow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_Slow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).Slow(ctx, req.(*SlowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_SlowCalls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoidData)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SlowCalls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_SlowCalls_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SlowCalls(ctx, req.(*VoidData))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_Work_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).Work(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_Work_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).Work(ctx, req.(*WorkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_Echo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LargeMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).Echo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_Echo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).Echo(ctx, req.(*LargeMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UpperRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UpperRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UpperRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpperRecords(ctx, req.(*RecordList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UpdateRows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RowPage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UpdateRows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_UpdateRows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UpdateRows(ctx, req.(*RowPage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_IncrementMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CounterMap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).IncrementMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_IncrementMap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).IncrementMap(ctx, req.(*CounterMap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ScaleVector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScaleVectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ScaleVector(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_ScaleVector_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ScaleVector(ctx, req.(*ScaleVectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_NopWithMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoidData)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).NopWithMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_NopWithMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).NopWithMetadata(ctx, req.(*VoidData))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // API_ServiceDesc is the grpc.ServiceDesc for API service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -236,7 +687,70 @@ var API_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ToHex",
 			Handler:    _API_ToHex_Handler,
 		},
+		{
+			MethodName: "Validate",
+			Handler:    _API_Validate_Handler,
+		},
+		{
+			MethodName: "Slow",
+			Handler:    _API_Slow_Handler,
+		},
+		{
+			MethodName: "SlowCalls",
+			Handler:    _API_SlowCalls_Handler,
+		},
+		{
+			MethodName: "Work",
+			Handler:    _API_Work_Handler,
+		},
+		{
+			MethodName: "Echo",
+			Handler:    _API_Echo_Handler,
+		},
+		{
+			MethodName: "UpperRecords",
+			Handler:    _API_UpperRecords_Handler,
+		},
+		{
+			MethodName: "UpdateRows",
+			Handler:    _API_UpdateRows_Handler,
+		},
+		{
+			MethodName: "IncrementMap",
+			Handler:    _API_IncrementMap_Handler,
+		},
+		{
+			MethodName: "ScaleVector",
+			Handler:    _API_ScaleVector_Handler,
+		},
+		{
+			MethodName: "NopWithMetadata",
+			Handler:    _API_NopWithMetadata_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ServerStream",
+			Handler:       _API_ServerStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ClientStream",
+			Handler:       _API_ClientStream_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "BidiStream",
+			Handler:       _API_BidiStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Callback",
+			Handler:       _API_Callback_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "structdef.proto",
 }
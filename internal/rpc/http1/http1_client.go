@@ -8,6 +8,7 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"crypto/tls"
 	"fmt"
 	"io"
 	"net"
@@ -25,16 +26,50 @@ type http1Client struct {
 
 	aux        []byte
 	bodyWriter bytes.Buffer
-	bodyReader bytes.Reader
 
 	nopURL  string
 	addURL  string
 	treeURL string
 	hexURL  string
+
+	largeURL   string
+	recordsURL string
+	rowsURL    string
+	mapURL     string
+	vectorURL  string
+
+	validateURL  string
+	slowURL      string
+	slowCallsURL string
+	metadataURL  string
+}
+
+// get makes a GET request that honors ctx.
+func (c *http1Client) get(ctx context.Context, url string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.hc.Do(req)
+}
+
+// post makes a POST request with a binary body that honors ctx.
+//
+// The body is read through a new reader on every request, given the transport
+// may still read from it (to check it has no data past its length) after the
+// request returns, while body (such as the bytes of bodyWriter) is already
+// reused for the next request.
+func (c *http1Client) post(ctx context.Context, url string, body []byte) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	return c.hc.Do(req)
 }
 
 func (c *http1Client) Nop(ctx context.Context) error {
-	_, err := c.hc.Get(c.nopURL)
+	_, err := c.get(ctx, c.nopURL)
 	return err
 }
 
@@ -51,7 +86,7 @@ func (c *http1Client) Add(ctx context.Context, a int64, b int64) (int64, error)
 		return 0, err
 	}
 
-	r, err := c.hc.Post(c.addURL, "application/octet-stream", &c.bodyWriter)
+	r, err := c.post(ctx, c.addURL, c.bodyWriter.Bytes())
 	if err != nil {
 		return 0, err
 	}
@@ -73,7 +108,7 @@ func (c *http1Client) MultTreeValues(ctx context.Context, mult int64, fillArgs f
 		return nil, err
 	}
 
-	r, err := c.hc.Post(c.treeURL, "application/octet-stream", &c.bodyWriter)
+	r, err := c.post(ctx, c.treeURL, c.bodyWriter.Bytes())
 	if err != nil {
 		return nil, err
 	}
@@ -92,8 +127,7 @@ func (c *http1Client) ToHex(ctx context.Context, in, out []byte) error {
 		panic("todo")
 	}
 
-	c.bodyReader.Reset(in)
-	r, err := c.hc.Post(c.hexURL, "application/octet-stream", &c.bodyReader)
+	r, err := c.post(ctx, c.hexURL, in)
 	if err != nil {
 		return err
 	}
@@ -106,9 +140,236 @@ func (c *http1Client) ToHex(ctx context.Context, in, out []byte) error {
 	return nil
 }
 
-func newHttp1Client(_ context.Context, addr string) (*http1Client, error) {
+func (c *http1Client) Echo(ctx context.Context, in, out []byte) error {
+	if c.isJson {
+		panic("todo")
+	}
+
+	r, err := c.post(ctx, c.largeURL, in)
+	if err != nil {
+		return err
+	}
+	defer r.Body.Close()
+
+	if r.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %q", r.Status)
+	}
+	_, err = io.ReadFull(r.Body, out)
+	return err
+}
+
+func (c *http1Client) UpperRecords(ctx context.Context, in, out []rpcbench.Record) error {
+	if c.isJson {
+		panic("todo")
+	}
+
+	c.bodyWriter.Reset()
+	if err := binutils.WriteRecords(&c.bodyWriter, c.aux, in); err != nil {
+		return err
+	}
+	r, err := c.post(ctx, c.recordsURL, c.bodyWriter.Bytes())
+	if err != nil {
+		return err
+	}
+	defer r.Body.Close()
+
+	if r.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %q", r.Status)
+	}
+	res, err := binutils.ReadRecords(r.Body, c.aux, out)
+	if err != nil {
+		return err
+	}
+	if len(res) != len(out) {
+		return fmt.Errorf("unexpected number of records %d", len(res))
+	}
+	return nil
+}
+
+func (c *http1Client) UpdateRows(ctx context.Context, in, out []rpcbench.Row) error {
+	if c.isJson {
+		panic("todo")
+	}
+
+	c.bodyWriter.Reset()
+	if err := binutils.WriteRows(&c.bodyWriter, c.aux, in); err != nil {
+		return err
+	}
+	r, err := c.post(ctx, c.rowsURL, c.bodyWriter.Bytes())
+	if err != nil {
+		return err
+	}
+	defer r.Body.Close()
+
+	if r.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %q", r.Status)
+	}
+	res, err := binutils.ReadRows(r.Body, c.aux, out)
+	if err != nil {
+		return err
+	}
+	if len(res) != len(out) {
+		return fmt.Errorf("unexpected number of rows %d", len(res))
+	}
+	return nil
+}
+
+func (c *http1Client) IncrementMap(ctx context.Context, in, out map[string]int64) error {
+	if c.isJson {
+		panic("todo")
+	}
+
+	c.bodyWriter.Reset()
+	if err := binutils.WriteMap(&c.bodyWriter, c.aux, in); err != nil {
+		return err
+	}
+	r, err := c.post(ctx, c.mapURL, c.bodyWriter.Bytes())
+	if err != nil {
+		return err
+	}
+	defer r.Body.Close()
+
+	if r.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %q", r.Status)
+	}
+	return binutils.ReadMap(r.Body, c.aux, out)
+}
+
+func (c *http1Client) ScaleVector(ctx context.Context, in []float64, scale float64, out []float64) error {
+	if c.isJson {
+		panic("todo")
+	}
+
+	c.bodyWriter.Reset()
+	if err := binutils.WriteFloat64(&c.bodyWriter, c.aux, scale); err != nil {
+		return err
+	}
+	if err := binutils.WriteFloat64s(&c.bodyWriter, in); err != nil {
+		return err
+	}
+	r, err := c.post(ctx, c.vectorURL, c.bodyWriter.Bytes())
+	if err != nil {
+		return err
+	}
+	defer r.Body.Close()
+
+	if r.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %q", r.Status)
+	}
+	res, err := binutils.ReadFloat64s(r.Body, c.aux, out)
+	if err != nil {
+		return err
+	}
+	if len(res) != len(out) {
+		return fmt.Errorf("unexpected vector size %d", len(res))
+	}
+	return nil
+}
+
+func (c *http1Client) Validate(ctx context.Context, v int64, code rpcbench.AppErrorCode) (int64, error) {
+	c.bodyWriter.Reset()
+	if err := binutils.WriteInt64(&c.bodyWriter, c.aux, v); err != nil {
+		return 0, err
+	}
+	if err := binutils.WriteInt64(&c.bodyWriter, c.aux, int64(code)); err != nil {
+		return 0, err
+	}
+
+	r, err := c.post(ctx, c.validateURL, c.bodyWriter.Bytes())
+	if err != nil {
+		return 0, err
+	}
+	defer r.Body.Close()
+
+	if r.StatusCode == http.StatusOK {
+		return binutils.ReadInt64(r.Body, c.aux)
+	}
+	return 0, responseAppError(r)
+}
+
+// responseAppError returns the app error of a failed call, whose body is the
+// message of the error.
+func responseAppError(r *http.Response) error {
+	msg, err := io.ReadAll(io.LimitReader(r.Body, rpcbench.MaxHexEncodeSize))
+	if err != nil {
+		return err
+	}
+	appCode, ok := statusAppErrorCode(r.StatusCode)
+	if !ok {
+		return fmt.Errorf("unexpected status %s: %s", r.Status, msg)
+	}
+	return &rpcbench.AppError{Code: appCode, Message: string(msg)}
+}
+
+// NopWithMetadata sends the metadata as the headers of the request.
+func (c *http1Client) NopWithMetadata(ctx context.Context, md []rpcbench.MetadataEntry) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.metadataURL, nil)
+	if err != nil {
+		return "", err
+	}
+	for _, e := range md {
+		req.Header.Add(e.Key, e.Value)
+	}
+	r, err := c.hc.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer r.Body.Close()
+
+	if r.StatusCode != http.StatusOK {
+		return "", responseAppError(r)
+	}
+	return r.Header.Get(rpcbench.MetadataEchoKey), nil
+}
+
+func (c *http1Client) Slow(ctx context.Context, delay time.Duration) error {
+	c.bodyWriter.Reset()
+	if err := binutils.WriteInt64(&c.bodyWriter, c.aux, int64(delay)); err != nil {
+		return err
+	}
+
+	// The conn of a request interrupted by ctx is closed by the transport,
+	// which is how the server sees the cancellation.
+	r, err := c.post(ctx, c.slowURL, c.bodyWriter.Bytes())
+	if err != nil {
+		return err
+	}
+	r.Body.Close()
+	if r.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s", r.Status)
+	}
+	return nil
+}
+
+func (c *http1Client) SlowCalls(ctx context.Context) (started, canceled int64, err error) {
+	r, err := c.get(ctx, c.slowCallsURL)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer r.Body.Close()
+
+	if started, err = binutils.ReadInt64(r.Body, c.aux); err != nil {
+		return 0, 0, err
+	}
+	if canceled, err = binutils.ReadInt64(r.Body, c.aux); err != nil {
+		return 0, 0, err
+	}
+	return started, canceled, nil
+}
+
+// Close closes the idle conns of the client.
+func (c *http1Client) Close() error {
+	c.hc.CloseIdleConnections()
+	return nil
+}
+
+func newHttp1Client(_ context.Context, cfg rpcbench.ClientConfig) (*http1Client, error) {
+	// The transport always connects to the network and address of the
+	// server, regardless of the one in the URL.
 	dialerCtx := func(dialer *net.Dialer) func(context.Context, string, string) (net.Conn, error) {
-		return dialer.DialContext
+		return func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return dialer.DialContext(ctx, cfg.Network, cfg.Addr)
+		}
 	}
 
 	hc := http.Client{
@@ -125,15 +386,35 @@ func newHttp1Client(_ context.Context, addr string) (*http1Client, error) {
 			IdleConnTimeout:       90 * time.Second,
 			TLSHandshakeTimeout:   10 * time.Second,
 			ExpectContinueTimeout: 1 * time.Second,
+			TLSClientConfig:       cfg.TLSConfig,
+
+			// A non-nil, empty map disables HTTP/2, which would
+			// otherwise be negotiated on TLS conns.
+			TLSNextProto: map[string]func(string, *tls.Conn) http.RoundTripper{},
 		},
 	}
 
+	baseURL := "http://" + cfg.HTTPHost()
+	if cfg.TLSConfig != nil {
+		baseURL = "https://" + cfg.HTTPHost()
+	}
 	return &http1Client{
 		hc:      hc,
 		aux:     make([]byte, 8),
-		nopURL:  "http://" + addr + "/nop",
-		addURL:  "http://" + addr + "/add",
-		treeURL: "http://" + addr + "/multTree",
-		hexURL:  "http://" + addr + "/toHex",
+		nopURL:  baseURL + "/nop",
+		addURL:  baseURL + "/add",
+		treeURL: baseURL + "/multTree",
+		hexURL:  baseURL + "/toHex",
+
+		largeURL:   baseURL + "/large",
+		recordsURL: baseURL + "/records",
+		rowsURL:    baseURL + "/rows",
+		mapURL:     baseURL + "/map",
+		vectorURL:  baseURL + "/vector",
+
+		validateURL:  baseURL + "/validate",
+		slowURL:      baseURL + "/slow",
+		slowCallsURL: baseURL + "/slowCalls",
+		metadataURL:  baseURL + "/metadata",
 	}, nil
 }
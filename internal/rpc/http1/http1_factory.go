@@ -17,8 +17,8 @@ func (f http1Factory) NewServer(l net.Listener) (rpcbench.Server, error) {
 	return newHttp1Server(l), nil
 }
 
-func (f http1Factory) NewClient(ctx context.Context, addr string) (rpcbench.Client, error) {
-	return newHttp1Client(ctx, addr)
+func (f http1Factory) NewClient(ctx context.Context, cfg rpcbench.ClientConfig) (rpcbench.Client, error) {
+	return newHttp1Client(ctx, cfg)
 }
 
 func HTTP1FactoryIniter() rpcbench.RPCFactory {
@@ -13,15 +13,43 @@ import (
 	"log"
 	"net"
 	"net/http"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/matheusd/gorpcbench/internal/binutils"
+	"github.com/matheusd/gorpcbench/rpcbench"
 )
 
+// appErrorStatus maps the codes of app errors to the HTTP status of the
+// responses to failed calls.
+var appErrorStatus = map[rpcbench.AppErrorCode]int{
+	rpcbench.AppErrInvalidArgument:    http.StatusBadRequest,
+	rpcbench.AppErrNotFound:           http.StatusNotFound,
+	rpcbench.AppErrPermissionDenied:   http.StatusForbidden,
+	rpcbench.AppErrFailedPrecondition: http.StatusPreconditionFailed,
+}
+
+// statusAppErrorCode returns the code of the app error mapped to an HTTP
+// status.
+func statusAppErrorCode(status int) (rpcbench.AppErrorCode, bool) {
+	for code, s := range appErrorStatus {
+		if s == status {
+			return code, true
+		}
+	}
+	return 0, false
+}
+
 type http1Server struct {
 	l       net.Listener
 	skipLog bool
 	mux     http.ServeMux
+	slow    rpcbench.SlowHandler
+
+	// vectors are the vectors of vector calls, reused across calls. This
+	// is a pool because calls are handled concurrently.
+	vectors sync.Pool
 }
 
 func (s *http1Server) handleNop(w http.ResponseWriter, r *http.Request) {
@@ -118,6 +146,233 @@ func (s *http1Server) handleToHex(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (s *http1Server) handleLarge(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Content-Type") == "application/json" {
+		w.WriteHeader(http.StatusBadRequest) // Not implemented.
+		return
+	}
+	if r.ContentLength < 0 || r.ContentLength > int64(rpcbench.MaxMessageSize()) {
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	// The body is echoed back as it is read, so that it is never entirely
+	// in memory.
+	rpc := http.NewResponseController(w)
+	if err := rpc.EnableFullDuplex(); err != nil {
+		if !s.skipLog {
+			log.Printf("Unable to enable full duplex: %v", err)
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Length", strconv.FormatInt(r.ContentLength, 10))
+	body := http.MaxBytesReader(w, r.Body, int64(rpcbench.MaxMessageSize()))
+	if _, err := io.Copy(w, body); err != nil {
+		if !s.skipLog {
+			log.Printf("Unable to write response to large(): %v", err)
+		}
+	}
+}
+
+func (s *http1Server) handleRecords(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Content-Type") == "application/json" {
+		w.WriteHeader(http.StatusBadRequest) // Not implemented.
+		return
+	}
+
+	reader := bufio.NewReader(r.Body)
+	aux := make([]byte, 8)
+	records, err := binutils.ReadRecords(reader, aux, nil)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	for i := range records {
+		records[i].Name = rpcbench.UpperName(records[i].Name)
+	}
+	if err := binutils.WriteRecords(w, aux, records); err != nil {
+		if !s.skipLog {
+			log.Printf("Unable to write response to records(): %v", err)
+		}
+	}
+}
+
+func (s *http1Server) handleRows(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Content-Type") == "application/json" {
+		w.WriteHeader(http.StatusBadRequest) // Not implemented.
+		return
+	}
+
+	reader := bufio.NewReader(r.Body)
+	aux := make([]byte, 8)
+	rows, err := binutils.ReadRows(reader, aux, nil)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	for i := range rows {
+		rows[i].Version++
+	}
+	if err := binutils.WriteRows(w, aux, rows); err != nil {
+		if !s.skipLog {
+			log.Printf("Unable to write response to rows(): %v", err)
+		}
+	}
+}
+
+func (s *http1Server) handleMap(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Content-Type") == "application/json" {
+		w.WriteHeader(http.StatusBadRequest) // Not implemented.
+		return
+	}
+
+	reader := bufio.NewReader(r.Body)
+	aux := make([]byte, 8)
+	m := make(map[string]int64)
+	if err := binutils.ReadMap(reader, aux, m); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	for k := range m {
+		m[k]++
+	}
+	if err := binutils.WriteMap(w, aux, m); err != nil {
+		if !s.skipLog {
+			log.Printf("Unable to write response to map(): %v", err)
+		}
+	}
+}
+
+func (s *http1Server) handleVector(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Content-Type") == "application/json" {
+		w.WriteHeader(http.StatusBadRequest) // Not implemented.
+		return
+	}
+
+	reader := bufio.NewReader(r.Body)
+	aux := make([]byte, 8)
+	scale, err := binutils.ReadFloat64(reader, aux)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	vectorp := s.vectors.Get().(*[]float64)
+	defer s.vectors.Put(vectorp)
+	vector, err := binutils.ReadFloat64s(reader, aux, *vectorp)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	*vectorp = vector
+	for i := range vector {
+		vector[i] *= scale
+	}
+	if err := binutils.WriteFloat64s(w, vector); err != nil {
+		if !s.skipLog {
+			log.Printf("Unable to write response to vector(): %v", err)
+		}
+	}
+}
+
+// writeAppError fails a call with an app error, by replying with the status of
+// the error and its message.
+func (s *http1Server) writeAppError(w http.ResponseWriter, appErr *rpcbench.AppError, call string) {
+	status, ok := appErrorStatus[appErr.Code]
+	if !ok {
+		status = http.StatusInternalServerError
+	}
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(status)
+	if _, err := io.WriteString(w, appErr.Message); err != nil && !s.skipLog {
+		log.Printf("Unable to write response to %s(): %v", call, err)
+	}
+}
+
+// handleMetadata validates the metadata of the call, which is sent in its
+// headers, and replies with the echoed value in the same header as the one it
+// was sent in.
+func (s *http1Server) handleMetadata(w http.ResponseWriter, r *http.Request) {
+	echo, appErr := rpcbench.CheckMetadata(r.Header.Get)
+	if appErr != nil {
+		s.writeAppError(w, appErr, "metadata")
+		return
+	}
+	w.Header().Set(rpcbench.MetadataEchoKey, echo)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *http1Server) handleValidate(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Content-Type") == "application/json" {
+		w.WriteHeader(http.StatusBadRequest) // Not implemented.
+		return
+	}
+
+	// Assume binary encoding.
+	reader := bufio.NewReader(r.Body)
+	var v, code int64
+	var err error
+	aux := make([]byte, 8)
+	if v, err = binutils.ReadInt64(reader, aux); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if code, err = binutils.ReadInt64(reader, aux); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if code != 0 {
+		s.writeAppError(w, rpcbench.RejectValue(rpcbench.AppErrorCode(code), v), "validate")
+		return
+	}
+
+	if err = binutils.WriteInt64(w, aux, v); err != nil {
+		if !s.skipLog {
+			log.Printf("Unable to write response to validate(): %v", err)
+		}
+		return
+	}
+}
+
+func (s *http1Server) handleSlow(w http.ResponseWriter, r *http.Request) {
+	reader := bufio.NewReader(r.Body)
+	aux := make([]byte, 8)
+	delay, err := binutils.ReadInt64(reader, aux)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	// The server only starts watching the conn for it being closed by the
+	// client (which cancels the context of the request) once the entire
+	// body has been read.
+	if _, err := io.Copy(io.Discard, reader); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if err := s.slow.Handle(r.Context(), time.Duration(delay)); err != nil {
+		// The client is gone, so there is no one to reply to.
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *http1Server) handleSlowCalls(w http.ResponseWriter, r *http.Request) {
+	aux := make([]byte, 8)
+	started, canceled := s.slow.Counts()
+	for _, v := range []int64{started, canceled} {
+		if err := binutils.WriteInt64(w, aux, v); err != nil {
+			if !s.skipLog {
+				log.Printf("Unable to write response to slowCalls(): %v", err)
+			}
+			return
+		}
+	}
+}
+
 func (s *http1Server) Run(ctx context.Context) error {
 	var hs http.Server
 
@@ -139,9 +394,19 @@ func (s *http1Server) Run(ctx context.Context) error {
 
 func newHttp1Server(l net.Listener) *http1Server {
 	s := &http1Server{l: l, skipLog: true}
+	s.vectors.New = func() any { return new([]float64) }
 	s.mux.HandleFunc("/nop", s.handleNop)
 	s.mux.HandleFunc("/add", s.handleAdd)
 	s.mux.HandleFunc("/multTree", s.handleMultTree)
 	s.mux.HandleFunc("/toHex", s.handleToHex)
+	s.mux.HandleFunc("/large", s.handleLarge)
+	s.mux.HandleFunc("/records", s.handleRecords)
+	s.mux.HandleFunc("/rows", s.handleRows)
+	s.mux.HandleFunc("/map", s.handleMap)
+	s.mux.HandleFunc("/vector", s.handleVector)
+	s.mux.HandleFunc("/metadata", s.handleMetadata)
+	s.mux.HandleFunc("/validate", s.handleValidate)
+	s.mux.HandleFunc("/slow", s.handleSlow)
+	s.mux.HandleFunc("/slowCalls", s.handleSlowCalls)
 	return s
 }
@@ -0,0 +1,46 @@
+// Copyright (c) 2025 Matheus Degiovani
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package mdcapnp
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matheusd/gorpcbench/rpcbench"
+)
+
+// appErrorPrefix starts the reason of exceptions that carry app errors, which
+// is followed by the code and message of the error, as in
+// "apperr 5: value 10 rejected". Call handlers fail calls with plain errors
+// (sent to the client as the reason of an exception), so the code is encoded
+// in the reason.
+const appErrorPrefix = "apperr "
+
+// appErrorError returns the error call handlers fail calls with, when they fail
+// with an app error.
+func appErrorError(appErr *rpcbench.AppError) error {
+	return fmt.Errorf("%s%d: %s", appErrorPrefix, appErr.Code, appErr.Message)
+}
+
+// errorAppError returns the app error carried by an error returned to the
+// client, or err itself if it does not carry one.
+func errorAppError(err error) error {
+	// The reason may be annotated (prefixed) along the way.
+	reason := err.Error()
+	i := strings.Index(reason, appErrorPrefix)
+	if i < 0 {
+		return err
+	}
+	codeStr, msg, ok := strings.Cut(reason[i+len(appErrorPrefix):], ": ")
+	if !ok {
+		return err
+	}
+	code, convErr := strconv.ParseUint(codeStr, 10, 32)
+	if convErr != nil {
+		return err
+	}
+	return &rpcbench.AppError{Code: rpcbench.AppErrorCode(code), Message: msg}
+}
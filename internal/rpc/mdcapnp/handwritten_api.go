@@ -2,7 +2,10 @@ package mdcapnp
 
 import (
 	"context"
+	"encoding/binary"
+	"math"
 
+	"github.com/matheusd/gorpcbench/rpcbench"
 	rpc "matheusd.com/mdcapnp/capnprpc"
 	ser "matheusd.com/mdcapnp/capnpser"
 )
@@ -13,6 +16,17 @@ const (
 	api_add_methodId      = 0x0002
 	api_toHex_methodId    = 0x0003
 	api_multTree_methodId = 0x0004
+
+	api_slow_methodId      = 0x0005
+	api_slowCalls_methodId = 0x0006
+	api_work_methodId      = 0x0007
+	api_validate_methodId  = 0x0008
+	api_echo_methodId      = 0x0009
+	api_vector_methodId    = 0x000a
+	api_metadata_methodId  = 0x000b
+	api_records_methodId   = 0x000c
+	api_rows_methodId      = 0x000d
+	api_map_methodId       = 0x000e
 )
 
 type testAPI rpc.CallFuture
@@ -139,7 +153,7 @@ func (fut futureHexResult) Wait(ctx context.Context) (hexResponse, rpc.ReturnRes
 	return rpc.WaitShallowCopyReturnResultsStruct[hexResponse](ctx, rpc.CallFuture(fut))
 }
 
-func (api testAPI) ToHex(v []byte) futureHexResult {
+func (api testAPI) ToHex(v []byte) (fut futureHexResult, err error) {
 	vSerSize, _ := ser.ByteCount(len(v)).StorageWordCount()
 	// vSerSize *= 4
 	cs, req := rpc.SetupCallWithStructParamsGeneric[hexRequestBuilder](
@@ -150,13 +164,16 @@ func (api testAPI) ToHex(v []byte) futureHexResult {
 		hexRequestSize,
 	)
 
-	req.SetData(v)
+	if err = req.SetData(v); err != nil {
+		return
+	}
 	cs.WantShallowReturnCopy = true
 
-	return futureHexResult(rpc.RemoteCall(
+	fut = futureHexResult(rpc.RemoteCall(
 		rpc.CallFuture(api),
 		cs,
 	))
+	return
 }
 
 var (
@@ -249,6 +266,910 @@ func (api testAPI) MultTree(sizeHint ser.WordCount) (futureMultTree, multTreeReq
 	)), multTreeRequestBuilder(reqb)
 }
 
+var slowRequestSize = ser.StructSize{DataSectionSize: 1, PointerSectionSize: 0}
+
+type slowRequestBuilder ser.StructBuilder
+
+func (b *slowRequestBuilder) SetDelay(v int64) error {
+	return (*ser.StructBuilder)(b).SetInt64(0, v)
+}
+
+type slowRequest ser.Struct
+
+func (s *slowRequest) Delay() int64 {
+	return (*ser.Struct)(s).Int64(0)
+}
+
+func (api testAPI) Slow(delay int64) rpc.VoidFuture {
+	cs, req := rpc.SetupCallWithStructParamsGeneric[slowRequestBuilder](
+		rpc.CallFuture(api),
+		slowRequestSize.TotalSize(),
+		api_interfaceId,
+		api_slow_methodId,
+		slowRequestSize,
+	)
+
+	req.SetDelay(delay)
+
+	return rpc.VoidFuture(rpc.RemoteCall(
+		rpc.CallFuture(api),
+		cs,
+	))
+}
+
+var slowCallsResponseSize = ser.StructSize{DataSectionSize: 2, PointerSectionSize: 0}
+
+type slowCallsResponseBuilder ser.StructBuilder
+
+func (b *slowCallsResponseBuilder) SetStarted(v int64) error {
+	return (*ser.StructBuilder)(b).SetInt64(0, v)
+}
+
+func (b *slowCallsResponseBuilder) SetCanceled(v int64) error {
+	return (*ser.StructBuilder)(b).SetInt64(1, v)
+}
+
+type slowCallsResponse ser.Struct
+
+func (s *slowCallsResponse) Started() int64 {
+	return (*ser.Struct)(s).Int64(0)
+}
+
+func (s *slowCallsResponse) Canceled() int64 {
+	return (*ser.Struct)(s).Int64(1)
+}
+
+type futureSlowCallsResult rpc.CallFuture
+
+func (fut futureSlowCallsResult) Wait(ctx context.Context) (started, canceled int64, err error) {
+	r, rr, err := rpc.WaitShallowCopyReturnResultsStruct[slowCallsResponse](ctx, rpc.CallFuture(fut))
+	if err != nil {
+		return
+	}
+	started, canceled = r.Started(), r.Canceled()
+	rr.Release()
+	return
+}
+
+func (api testAPI) SlowCalls() futureSlowCallsResult {
+	cs := rpc.SetupCallNoParams(rpc.CallFuture(api),
+		api_interfaceId,
+		api_slowCalls_methodId,
+	)
+	cs.WantShallowReturnCopy = true
+
+	return futureSlowCallsResult(rpc.RemoteCall(
+		rpc.CallFuture(api),
+		cs,
+	))
+}
+
+var workRequestSize = ser.StructSize{DataSectionSize: 2, PointerSectionSize: 0}
+
+// workRequestBuilder builds the request of a work call. Whether the work is
+// done on the CPU is encoded as an int64 (1 for true).
+type workRequestBuilder ser.StructBuilder
+
+func (b *workRequestBuilder) SetDuration(v int64) error {
+	return (*ser.StructBuilder)(b).SetInt64(0, v)
+}
+
+func (b *workRequestBuilder) SetCPU(v bool) error {
+	var cpu int64
+	if v {
+		cpu = 1
+	}
+	return (*ser.StructBuilder)(b).SetInt64(1, cpu)
+}
+
+type workRequest ser.Struct
+
+func (s *workRequest) Duration() int64 {
+	return (*ser.Struct)(s).Int64(0)
+}
+
+func (s *workRequest) CPU() bool {
+	return (*ser.Struct)(s).Int64(1) != 0
+}
+
+func (api testAPI) Work(duration int64, cpu bool) rpc.VoidFuture {
+	cs, req := rpc.SetupCallWithStructParamsGeneric[workRequestBuilder](
+		rpc.CallFuture(api),
+		workRequestSize.TotalSize(),
+		api_interfaceId,
+		api_work_methodId,
+		workRequestSize,
+	)
+
+	req.SetDuration(duration)
+	req.SetCPU(cpu)
+
+	return rpc.VoidFuture(rpc.RemoteCall(
+		rpc.CallFuture(api),
+		cs,
+	))
+}
+
+var validateRequestSize = ser.StructSize{DataSectionSize: 2, PointerSectionSize: 0}
+
+// validateRequestBuilder builds the request of a validate call. The code of the
+// app error to fail the call with (zero for none) is encoded as an int64.
+type validateRequestBuilder ser.StructBuilder
+
+func (b *validateRequestBuilder) SetValue(v int64) error {
+	return (*ser.StructBuilder)(b).SetInt64(0, v)
+}
+
+func (b *validateRequestBuilder) SetCode(v rpcbench.AppErrorCode) error {
+	return (*ser.StructBuilder)(b).SetInt64(1, int64(v))
+}
+
+type validateRequest ser.Struct
+
+func (s *validateRequest) Value() int64 {
+	return (*ser.Struct)(s).Int64(0)
+}
+
+func (s *validateRequest) Code() rpcbench.AppErrorCode {
+	return rpcbench.AppErrorCode((*ser.Struct)(s).Int64(1))
+}
+
+var validateResponseSize = ser.StructSize{DataSectionSize: 1, PointerSectionSize: 0}
+
+type validateResponseBuilder ser.StructBuilder
+
+func (b *validateResponseBuilder) SetValue(v int64) error {
+	return (*ser.StructBuilder)(b).SetInt64(0, v)
+}
+
+type validateResponse ser.Struct
+
+func (s *validateResponse) Value() int64 {
+	return (*ser.Struct)(s).Int64(0)
+}
+
+type futureValidateResult rpc.CallFuture
+
+func (fut futureValidateResult) Wait(ctx context.Context) (res int64, err error) {
+	r, rr, err := rpc.WaitShallowCopyReturnResultsStruct[validateResponse](ctx, rpc.CallFuture(fut))
+	if err != nil {
+		return
+	}
+	res = r.Value()
+	rr.Release()
+	return
+}
+
+func (api testAPI) Validate(v int64, code rpcbench.AppErrorCode) futureValidateResult {
+	cs, req := rpc.SetupCallWithStructParamsGeneric[validateRequestBuilder](
+		rpc.CallFuture(api),
+		validateRequestSize.TotalSize(),
+		api_interfaceId,
+		api_validate_methodId,
+		validateRequestSize,
+	)
+
+	req.SetValue(v)
+	req.SetCode(code)
+	cs.WantShallowReturnCopy = true
+
+	return futureValidateResult(rpc.RemoteCall(
+		rpc.CallFuture(api),
+		cs,
+	))
+}
+
+var echoRequestSize = ser.StructSize{DataSectionSize: 0, PointerSectionSize: 1}
+
+type echoRequestBuilder ser.StructBuilder
+
+func (b *echoRequestBuilder) SetData(v []byte) error {
+	return (*ser.StructBuilder)(b).SetData(0, v)
+}
+
+type echoRequest ser.Struct
+
+func (s *echoRequest) Data() []byte {
+	return []byte((*ser.Struct)(s).Data(0))
+}
+
+var echoResponseSize = ser.StructSize{DataSectionSize: 0, PointerSectionSize: 1}
+
+type echoResponseBuilder ser.StructBuilder
+
+func (b *echoResponseBuilder) NewData(dataLen int) ([]byte, error) {
+	return (*ser.StructBuilder)(b).NewDataField(0, ser.ByteCount(dataLen))
+}
+
+type echoResponse ser.Struct
+
+func (s *echoResponse) Data() []byte {
+	return []byte((*ser.Struct)(s).Data(0))
+}
+
+type futureEchoResult rpc.CallFuture
+
+func (fut futureEchoResult) Wait(ctx context.Context) (echoResponse, rpc.ReturnResults, error) {
+	return rpc.WaitShallowCopyReturnResultsStruct[echoResponse](ctx, rpc.CallFuture(fut))
+}
+
+func (api testAPI) Echo(v []byte) (fut futureEchoResult, err error) {
+	vSerSize, _ := ser.ByteCount(len(v)).StorageWordCount()
+	cs, req := rpc.SetupCallWithStructParamsGeneric[echoRequestBuilder](
+		rpc.CallFuture(api),
+		echoRequestSize.TotalSize()+vSerSize,
+		api_interfaceId,
+		api_echo_methodId,
+		echoRequestSize,
+	)
+
+	if err = req.SetData(v); err != nil {
+		return
+	}
+	cs.WantShallowReturnCopy = true
+
+	fut = futureEchoResult(rpc.RemoteCall(
+		rpc.CallFuture(api),
+		cs,
+	))
+	return
+}
+
+var vectorRequestSize = ser.StructSize{DataSectionSize: 1, PointerSectionSize: 1}
+
+// vectorRequestBuilder builds the request of a vector call. The scale is
+// encoded as the int64 with its bits, and the vector as data with the
+// little-endian bits of each element.
+type vectorRequestBuilder ser.StructBuilder
+
+func (b *vectorRequestBuilder) SetScale(v float64) error {
+	return (*ser.StructBuilder)(b).SetInt64(0, int64(math.Float64bits(v)))
+}
+
+func (b *vectorRequestBuilder) SetVector(v []float64) error {
+	data, err := (*ser.StructBuilder)(b).NewDataField(0, ser.ByteCount(len(v)*8))
+	if err != nil {
+		return err
+	}
+	encodeFloat64s(data, v)
+	return nil
+}
+
+type vectorRequest ser.Struct
+
+func (s *vectorRequest) Scale() float64 {
+	return math.Float64frombits(uint64((*ser.Struct)(s).Int64(0)))
+}
+
+// VectorData returns the encoded vector. Use decodeFloat64s to decode it.
+func (s *vectorRequest) VectorData() []byte {
+	return []byte((*ser.Struct)(s).Data(0))
+}
+
+var vectorResponseSize = ser.StructSize{DataSectionSize: 0, PointerSectionSize: 1}
+
+type vectorResponseBuilder ser.StructBuilder
+
+// NewVectorData returns the data to encode a vector of vectorLen elements
+// into. Use encodeFloat64s to encode it.
+func (b *vectorResponseBuilder) NewVectorData(vectorLen int) ([]byte, error) {
+	return (*ser.StructBuilder)(b).NewDataField(0, ser.ByteCount(vectorLen*8))
+}
+
+type vectorResponse ser.Struct
+
+// VectorData returns the encoded vector. Use decodeFloat64s to decode it.
+func (s *vectorResponse) VectorData() []byte {
+	return []byte((*ser.Struct)(s).Data(0))
+}
+
+// encodeFloat64s encodes v into data, which has 8 bytes per element.
+func encodeFloat64s(data []byte, v []float64) {
+	for i, f := range v {
+		binary.LittleEndian.PutUint64(data[i*8:], math.Float64bits(f))
+	}
+}
+
+// decodeFloat64s decodes data into v, which has one element per 8 bytes of
+// data.
+func decodeFloat64s(v []float64, data []byte) {
+	for i := range v {
+		v[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[i*8:]))
+	}
+}
+
+type futureVectorResult rpc.CallFuture
+
+func (fut futureVectorResult) Wait(ctx context.Context) (vectorResponse, rpc.ReturnResults, error) {
+	return rpc.WaitShallowCopyReturnResultsStruct[vectorResponse](ctx, rpc.CallFuture(fut))
+}
+
+func (api testAPI) ScaleVector(v []float64, scale float64) (fut futureVectorResult, err error) {
+	vSerSize, _ := ser.ByteCount(len(v) * 8).StorageWordCount()
+	cs, req := rpc.SetupCallWithStructParamsGeneric[vectorRequestBuilder](
+		rpc.CallFuture(api),
+		vectorRequestSize.TotalSize()+vSerSize,
+		api_interfaceId,
+		api_vector_methodId,
+		vectorRequestSize,
+	)
+
+	req.SetScale(scale)
+	if err = req.SetVector(v); err != nil {
+		return
+	}
+	cs.WantShallowReturnCopy = true
+
+	fut = futureVectorResult(rpc.RemoteCall(
+		rpc.CallFuture(api),
+		cs,
+	))
+	return
+}
+
+var metadataEntrySize = ser.StructSize{DataSectionSize: 0, PointerSectionSize: 2}
+
+type metadataEntryBuilder ser.StructBuilder
+
+func (b *metadataEntryBuilder) SetKey(v string) error {
+	return (*ser.StructBuilder)(b).SetData(0, []byte(v))
+}
+
+func (b *metadataEntryBuilder) SetValue(v string) error {
+	return (*ser.StructBuilder)(b).SetData(1, []byte(v))
+}
+
+type metadataEntryListBuilder ser.StructListBuilder
+
+func (lb *metadataEntryListBuilder) Len() int { return (*ser.StructListBuilder)(lb).Len() }
+func (lb *metadataEntryListBuilder) At(i int) metadataEntryBuilder {
+	return metadataEntryBuilder((*ser.StructListBuilder)(lb).At(i))
+}
+
+type metadataEntry ser.Struct
+
+func (s *metadataEntry) Key() []byte {
+	return []byte((*ser.Struct)(s).Data(0))
+}
+
+func (s *metadataEntry) Value() []byte {
+	return []byte((*ser.Struct)(s).Data(1))
+}
+
+type metadataEntryList ser.StructList
+
+func (sl *metadataEntryList) Len() int { return (*ser.StructList)(sl).Len() }
+func (sl *metadataEntryList) At(i int) metadataEntry {
+	return metadataEntry((*ser.StructList)(sl).At(i))
+}
+
+var metadataRequestSize = ser.StructSize{DataSectionSize: 0, PointerSectionSize: 1}
+
+type metadataRequestBuilder ser.StructBuilder
+
+func (b *metadataRequestBuilder) NewMetadata(listLen int) (res metadataEntryListBuilder, err error) {
+	err = ser.NewStructListBuilderField((*ser.StructBuilder)(b), 0, metadataEntrySize, listLen, listLen, (*ser.StructListBuilder)(&res))
+	return
+}
+
+type metadataRequest ser.Struct
+
+func (s *metadataRequest) Metadata() (res metadataEntryList, err error) {
+	err = (*ser.Struct)(s).ReadStructList(0, (*ser.StructList)(&res))
+	return
+}
+
+var metadataResponseSize = ser.StructSize{DataSectionSize: 0, PointerSectionSize: 1}
+
+type metadataResponseBuilder ser.StructBuilder
+
+func (b *metadataResponseBuilder) SetEcho(v string) error {
+	return (*ser.StructBuilder)(b).SetData(0, []byte(v))
+}
+
+type metadataResponse ser.Struct
+
+func (s *metadataResponse) Echo() []byte {
+	return []byte((*ser.Struct)(s).Data(0))
+}
+
+type futureMetadataResult rpc.CallFuture
+
+func (fut futureMetadataResult) Wait(ctx context.Context) (res string, err error) {
+	r, rr, err := rpc.WaitShallowCopyReturnResultsStruct[metadataResponse](ctx, rpc.CallFuture(fut))
+	if err != nil {
+		return
+	}
+	res = string(r.Echo())
+	rr.Release()
+	return
+}
+
+func (api testAPI) NopWithMetadata(md []rpcbench.MetadataEntry) futureMetadataResult {
+	sizeHint := metadataEntrySize.TotalSize() * ser.WordCount(len(md))
+	for _, e := range md {
+		keySize, _ := ser.ByteCount(len(e.Key)).StorageWordCount()
+		valueSize, _ := ser.ByteCount(len(e.Value)).StorageWordCount()
+		sizeHint += keySize + valueSize
+	}
+	cs, req := rpc.SetupCallWithStructParamsGeneric[metadataRequestBuilder](
+		rpc.CallFuture(api),
+		metadataRequestSize.TotalSize()+sizeHint,
+		api_interfaceId,
+		api_metadata_methodId,
+		metadataRequestSize,
+	)
+
+	// Metadata that fails to be built is rejected by the server, as is
+	// metadata with missing entries.
+	entries, err := req.NewMetadata(len(md))
+	if err == nil {
+		for i, e := range md {
+			entry := entries.At(i)
+			entry.SetKey(e.Key)
+			entry.SetValue(e.Value)
+		}
+	}
+	cs.WantShallowReturnCopy = true
+
+	return futureMetadataResult(rpc.RemoteCall(
+		rpc.CallFuture(api),
+		cs,
+	))
+}
+
+var recordSize = ser.StructSize{DataSectionSize: 1, PointerSectionSize: 3}
+
+type recordBuilder ser.StructBuilder
+
+func (b *recordBuilder) SetRecord(r *rpcbench.Record) error {
+	sb := (*ser.StructBuilder)(b)
+	if err := sb.SetInt64(0, r.ID); err != nil {
+		return err
+	}
+	if err := sb.SetData(0, []byte(r.Name)); err != nil {
+		return err
+	}
+	if err := sb.SetData(1, []byte(r.Email)); err != nil {
+		return err
+	}
+	return sb.SetData(2, []byte(r.Bio))
+}
+
+type recordListBuilder ser.StructListBuilder
+
+func (lb *recordListBuilder) Len() int { return (*ser.StructListBuilder)(lb).Len() }
+func (lb *recordListBuilder) At(i int) recordBuilder {
+	return recordBuilder((*ser.StructListBuilder)(lb).At(i))
+}
+
+type record ser.Struct
+
+func (s *record) Record(r *rpcbench.Record) {
+	ss := (*ser.Struct)(s)
+	r.ID = ss.Int64(0)
+	r.Name = string(ss.Data(0))
+	r.Email = string(ss.Data(1))
+	r.Bio = string(ss.Data(2))
+}
+
+type recordList ser.StructList
+
+func (sl *recordList) Len() int        { return (*ser.StructList)(sl).Len() }
+func (sl *recordList) At(i int) record { return record((*ser.StructList)(sl).At(i)) }
+
+// recordsSizeHint returns the size hint of a list of records.
+func recordsSizeHint(records []rpcbench.Record) ser.WordCount {
+	sizeHint := recordSize.TotalSize() * ser.WordCount(len(records))
+	for i := range records {
+		r := &records[i]
+		for _, s := range []string{r.Name, r.Email, r.Bio} {
+			size, _ := ser.ByteCount(len(s)).StorageWordCount()
+			sizeHint += size
+		}
+	}
+	return sizeHint
+}
+
+var recordsRequestSize = ser.StructSize{DataSectionSize: 0, PointerSectionSize: 1}
+
+type recordsRequestBuilder ser.StructBuilder
+
+func (b *recordsRequestBuilder) NewRecords(listLen int) (res recordListBuilder, err error) {
+	err = ser.NewStructListBuilderField((*ser.StructBuilder)(b), 0, recordSize, listLen, listLen, (*ser.StructListBuilder)(&res))
+	return
+}
+
+type recordsRequest ser.Struct
+
+func (s *recordsRequest) Records() (res recordList, err error) {
+	err = (*ser.Struct)(s).ReadStructList(0, (*ser.StructList)(&res))
+	return
+}
+
+var recordsResponseSize = ser.StructSize{DataSectionSize: 0, PointerSectionSize: 1}
+
+type recordsResponseBuilder ser.StructBuilder
+
+func (b *recordsResponseBuilder) NewRecords(listLen int) (res recordListBuilder, err error) {
+	err = ser.NewStructListBuilderField((*ser.StructBuilder)(b), 0, recordSize, listLen, listLen, (*ser.StructListBuilder)(&res))
+	return
+}
+
+type recordsResponse ser.Struct
+
+func (s *recordsResponse) Records() (res recordList, err error) {
+	err = (*ser.Struct)(s).ReadStructList(0, (*ser.StructList)(&res))
+	return
+}
+
+type futureRecordsResult rpc.CallFuture
+
+func (fut futureRecordsResult) Wait(ctx context.Context) (recordsResponse, rpc.ReturnResults, error) {
+	return rpc.WaitShallowCopyReturnResultsStruct[recordsResponse](ctx, rpc.CallFuture(fut))
+}
+
+func (api testAPI) UpperRecords(records []rpcbench.Record) (fut futureRecordsResult, err error) {
+	cs, req := rpc.SetupCallWithStructParamsGeneric[recordsRequestBuilder](
+		rpc.CallFuture(api),
+		recordsRequestSize.TotalSize()+recordsSizeHint(records),
+		api_interfaceId,
+		api_records_methodId,
+		recordsRequestSize,
+	)
+
+	list, err := req.NewRecords(len(records))
+	if err != nil {
+		return
+	}
+	for i := range records {
+		r := list.At(i)
+		if err = r.SetRecord(&records[i]); err != nil {
+			return
+		}
+	}
+	cs.WantShallowReturnCopy = true
+
+	fut = futureRecordsResult(rpc.RemoteCall(
+		rpc.CallFuture(api),
+		cs,
+	))
+	return
+}
+
+// rowSize is the size of a row. Its data section has one int64 per numeric
+// and bool field (floats encoded with their bits), followed by optionalSet,
+// which has one bit per optional field (in declaration order) set when the
+// field is set. Its pointer section has one data field per string field.
+var rowSize = ser.StructSize{DataSectionSize: 19, PointerSectionSize: 14}
+
+const (
+	rowOptionalSetField = 18
+
+	// rowOptionalString is the pointer of the first optional string field,
+	// and rowOptionalStringBit its bit in optionalSet.
+	rowOptionalString    = 6
+	rowOptionalStringBit = 8
+)
+
+func boolToInt64(v bool) int64 {
+	if v {
+		return 1
+	}
+	return 0
+}
+
+type rowBuilder ser.StructBuilder
+
+func (b *rowBuilder) SetRow(r *rpcbench.Row) error {
+	sb := (*ser.StructBuilder)(b)
+	var set int64
+	var ints [rowOptionalSetField]int64
+	ints[0], ints[1], ints[2], ints[3], ints[4] = r.ID, r.AccountID, r.CreatedAt, r.UpdatedAt, r.Balance
+	ints[5], ints[6] = int64(r.Version), int64(r.Status)
+	ints[7] = int64(math.Float64bits(r.Score))
+	ints[8], ints[9] = boolToInt64(r.Active), boolToInt64(r.Verified)
+	for i, v := range [...]*int64{r.DeletedAt, r.ParentID, r.CreditLimit} {
+		if v != nil {
+			ints[10+i] = *v
+			set |= 1 << i
+		}
+	}
+	for i, v := range [...]*int32{r.Priority, r.Retries} {
+		if v != nil {
+			ints[13+i] = int64(*v)
+			set |= 1 << (3 + i)
+		}
+	}
+	if r.Discount != nil {
+		ints[15] = int64(math.Float64bits(*r.Discount))
+		set |= 1 << 5
+	}
+	for i, v := range [...]*bool{r.Archived, r.TwoFactor} {
+		if v != nil {
+			ints[16+i] = boolToInt64(*v)
+			set |= 1 << (6 + i)
+		}
+	}
+	for i, v := range ints {
+		if err := sb.SetInt64(i, v); err != nil {
+			return err
+		}
+	}
+
+	for i, v := range [...]string{r.Name, r.Email, r.Country, r.Currency, r.Plan, r.Region} {
+		if err := sb.SetData(i, []byte(v)); err != nil {
+			return err
+		}
+	}
+	for i, v := range [...]*string{r.ExternalID, r.Phone, r.Company, r.Title,
+		r.Locale, r.Timezone, r.Referrer, r.Notes} {
+		if v == nil {
+			continue
+		}
+		if err := sb.SetData(rowOptionalString+i, []byte(*v)); err != nil {
+			return err
+		}
+		set |= 1 << (rowOptionalStringBit + i)
+	}
+	return sb.SetInt64(rowOptionalSetField, set)
+}
+
+type rowListBuilder ser.StructListBuilder
+
+func (lb *rowListBuilder) Len() int { return (*ser.StructListBuilder)(lb).Len() }
+func (lb *rowListBuilder) At(i int) rowBuilder {
+	return rowBuilder((*ser.StructListBuilder)(lb).At(i))
+}
+
+type row ser.Struct
+
+// rowOptional returns a pointer to v, or nil when its bit is not set in set.
+func rowOptional[T any](set int64, bit int, v T) *T {
+	if set&(1<<bit) == 0 {
+		return nil
+	}
+	return &v
+}
+
+func (s *row) Row(r *rpcbench.Row) {
+	ss := (*ser.Struct)(s)
+	r.ID = ss.Int64(0)
+	r.AccountID = ss.Int64(1)
+	r.CreatedAt = ss.Int64(2)
+	r.UpdatedAt = ss.Int64(3)
+	r.Balance = ss.Int64(4)
+	r.Version = int32(ss.Int64(5))
+	r.Status = int32(ss.Int64(6))
+	r.Score = math.Float64frombits(uint64(ss.Int64(7)))
+	r.Active = ss.Int64(8) != 0
+	r.Verified = ss.Int64(9) != 0
+	for i, v := range [...]*string{&r.Name, &r.Email, &r.Country, &r.Currency, &r.Plan, &r.Region} {
+		*v = string(ss.Data(i))
+	}
+
+	set := ss.Int64(rowOptionalSetField)
+	r.DeletedAt = rowOptional(set, 0, ss.Int64(10))
+	r.ParentID = rowOptional(set, 1, ss.Int64(11))
+	r.CreditLimit = rowOptional(set, 2, ss.Int64(12))
+	r.Priority = rowOptional(set, 3, int32(ss.Int64(13)))
+	r.Retries = rowOptional(set, 4, int32(ss.Int64(14)))
+	r.Discount = rowOptional(set, 5, math.Float64frombits(uint64(ss.Int64(15))))
+	r.Archived = rowOptional(set, 6, ss.Int64(16) != 0)
+	r.TwoFactor = rowOptional(set, 7, ss.Int64(17) != 0)
+	for i, v := range [...]**string{&r.ExternalID, &r.Phone, &r.Company, &r.Title,
+		&r.Locale, &r.Timezone, &r.Referrer, &r.Notes} {
+		*v = nil
+		if set&(1<<(rowOptionalStringBit+i)) != 0 {
+			str := string(ss.Data(rowOptionalString + i))
+			*v = &str
+		}
+	}
+}
+
+type rowList ser.StructList
+
+func (sl *rowList) Len() int     { return (*ser.StructList)(sl).Len() }
+func (sl *rowList) At(i int) row { return row((*ser.StructList)(sl).At(i)) }
+
+// rowsSizeHint returns the size hint of a list of rows.
+func rowsSizeHint(rows []rpcbench.Row) ser.WordCount {
+	sizeHint := rowSize.TotalSize() * ser.WordCount(len(rows))
+	addString := func(s *string) {
+		if s != nil {
+			size, _ := ser.ByteCount(len(*s)).StorageWordCount()
+			sizeHint += size
+		}
+	}
+	for i := range rows {
+		r := &rows[i]
+		for _, s := range [...]*string{&r.Name, &r.Email, &r.Country, &r.Currency,
+			&r.Plan, &r.Region, r.ExternalID, r.Phone, r.Company, r.Title,
+			r.Locale, r.Timezone, r.Referrer, r.Notes} {
+			addString(s)
+		}
+	}
+	return sizeHint
+}
+
+var rowsRequestSize = ser.StructSize{DataSectionSize: 0, PointerSectionSize: 1}
+
+type rowsRequestBuilder ser.StructBuilder
+
+func (b *rowsRequestBuilder) NewRows(listLen int) (res rowListBuilder, err error) {
+	err = ser.NewStructListBuilderField((*ser.StructBuilder)(b), 0, rowSize, listLen, listLen, (*ser.StructListBuilder)(&res))
+	return
+}
+
+type rowsRequest ser.Struct
+
+func (s *rowsRequest) Rows() (res rowList, err error) {
+	err = (*ser.Struct)(s).ReadStructList(0, (*ser.StructList)(&res))
+	return
+}
+
+var rowsResponseSize = ser.StructSize{DataSectionSize: 0, PointerSectionSize: 1}
+
+type rowsResponseBuilder ser.StructBuilder
+
+func (b *rowsResponseBuilder) NewRows(listLen int) (res rowListBuilder, err error) {
+	err = ser.NewStructListBuilderField((*ser.StructBuilder)(b), 0, rowSize, listLen, listLen, (*ser.StructListBuilder)(&res))
+	return
+}
+
+type rowsResponse ser.Struct
+
+func (s *rowsResponse) Rows() (res rowList, err error) {
+	err = (*ser.Struct)(s).ReadStructList(0, (*ser.StructList)(&res))
+	return
+}
+
+type futureRowsResult rpc.CallFuture
+
+func (fut futureRowsResult) Wait(ctx context.Context) (rowsResponse, rpc.ReturnResults, error) {
+	return rpc.WaitShallowCopyReturnResultsStruct[rowsResponse](ctx, rpc.CallFuture(fut))
+}
+
+func (api testAPI) UpdateRows(rows []rpcbench.Row) (fut futureRowsResult, err error) {
+	cs, req := rpc.SetupCallWithStructParamsGeneric[rowsRequestBuilder](
+		rpc.CallFuture(api),
+		rowsRequestSize.TotalSize()+rowsSizeHint(rows),
+		api_interfaceId,
+		api_rows_methodId,
+		rowsRequestSize,
+	)
+
+	list, err := req.NewRows(len(rows))
+	if err != nil {
+		return
+	}
+	for i := range rows {
+		r := list.At(i)
+		if err = r.SetRow(&rows[i]); err != nil {
+			return
+		}
+	}
+	cs.WantShallowReturnCopy = true
+
+	fut = futureRowsResult(rpc.RemoteCall(
+		rpc.CallFuture(api),
+		cs,
+	))
+	return
+}
+
+var mapEntrySize = ser.StructSize{DataSectionSize: 1, PointerSectionSize: 1}
+
+type mapEntryBuilder ser.StructBuilder
+
+func (b *mapEntryBuilder) SetKey(v string) error {
+	return (*ser.StructBuilder)(b).SetData(0, []byte(v))
+}
+
+func (b *mapEntryBuilder) SetValue(v int64) error {
+	return (*ser.StructBuilder)(b).SetInt64(0, v)
+}
+
+type mapEntryListBuilder ser.StructListBuilder
+
+func (lb *mapEntryListBuilder) Len() int { return (*ser.StructListBuilder)(lb).Len() }
+func (lb *mapEntryListBuilder) At(i int) mapEntryBuilder {
+	return mapEntryBuilder((*ser.StructListBuilder)(lb).At(i))
+}
+
+type mapEntry ser.Struct
+
+func (s *mapEntry) Key() []byte {
+	return []byte((*ser.Struct)(s).Data(0))
+}
+
+func (s *mapEntry) Value() int64 {
+	return (*ser.Struct)(s).Int64(0)
+}
+
+type mapEntryList ser.StructList
+
+func (sl *mapEntryList) Len() int          { return (*ser.StructList)(sl).Len() }
+func (sl *mapEntryList) At(i int) mapEntry { return mapEntry((*ser.StructList)(sl).At(i)) }
+
+var mapRequestSize = ser.StructSize{DataSectionSize: 0, PointerSectionSize: 1}
+
+// mapRequestBuilder builds the request of a map call. The map is encoded as a
+// list of key/value entries, as CapNProto has no map type.
+type mapRequestBuilder ser.StructBuilder
+
+func (b *mapRequestBuilder) NewEntries(listLen int) (res mapEntryListBuilder, err error) {
+	err = ser.NewStructListBuilderField((*ser.StructBuilder)(b), 0, mapEntrySize, listLen, listLen, (*ser.StructListBuilder)(&res))
+	return
+}
+
+type mapRequest ser.Struct
+
+func (s *mapRequest) Entries() (res mapEntryList, err error) {
+	err = (*ser.Struct)(s).ReadStructList(0, (*ser.StructList)(&res))
+	return
+}
+
+var mapResponseSize = ser.StructSize{DataSectionSize: 0, PointerSectionSize: 1}
+
+type mapResponseBuilder ser.StructBuilder
+
+func (b *mapResponseBuilder) NewEntries(listLen int) (res mapEntryListBuilder, err error) {
+	err = ser.NewStructListBuilderField((*ser.StructBuilder)(b), 0, mapEntrySize, listLen, listLen, (*ser.StructListBuilder)(&res))
+	return
+}
+
+type mapResponse ser.Struct
+
+func (s *mapResponse) Entries() (res mapEntryList, err error) {
+	err = (*ser.Struct)(s).ReadStructList(0, (*ser.StructList)(&res))
+	return
+}
+
+type futureMapResult rpc.CallFuture
+
+func (fut futureMapResult) Wait(ctx context.Context) (mapResponse, rpc.ReturnResults, error) {
+	return rpc.WaitShallowCopyReturnResultsStruct[mapResponse](ctx, rpc.CallFuture(fut))
+}
+
+func (api testAPI) IncrementMap(m map[string]int64) (fut futureMapResult, err error) {
+	sizeHint := mapEntrySize.TotalSize() * ser.WordCount(len(m))
+	for k := range m {
+		keySize, _ := ser.ByteCount(len(k)).StorageWordCount()
+		sizeHint += keySize
+	}
+	cs, req := rpc.SetupCallWithStructParamsGeneric[mapRequestBuilder](
+		rpc.CallFuture(api),
+		mapRequestSize.TotalSize()+sizeHint,
+		api_interfaceId,
+		api_map_methodId,
+		mapRequestSize,
+	)
+
+	entries, err := req.NewEntries(len(m))
+	if err != nil {
+		return
+	}
+	var i int
+	for k, v := range m {
+		entry := entries.At(i)
+		if err = entry.SetKey(k); err != nil {
+			return
+		}
+		if err = entry.SetValue(v); err != nil {
+			return
+		}
+		i++
+	}
+	cs.WantShallowReturnCopy = true
+
+	fut = futureMapResult(rpc.RemoteCall(
+		rpc.CallFuture(api),
+		cs,
+	))
+	return
+}
+
 func testAPIFromBootstrap(boot rpc.BootstrapFuture) testAPI {
 	return testAPI(boot)
 }
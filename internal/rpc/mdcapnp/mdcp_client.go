@@ -8,17 +8,56 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/matheusd/gorpcbench/rpcbench"
 	"github.com/rs/zerolog"
+	"github.com/sourcegraph/conc/pool"
 	rpc "matheusd.com/mdcapnp/capnprpc"
 	ser "matheusd.com/mdcapnp/capnpser"
 )
 
+// writeWatchConn is a conn that can notify when it is next written to.
+type writeWatchConn struct {
+	net.Conn
+
+	// hasWaiters is set while there are waiters, so that writes only lock
+	// mu when someone is waiting for them.
+	hasWaiters atomic.Bool
+	mu         sync.Mutex
+	waiters    []chan struct{}
+}
+
+func (c *writeWatchConn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	if c.hasWaiters.Load() {
+		c.mu.Lock()
+		for _, w := range c.waiters {
+			close(w)
+		}
+		c.waiters = c.waiters[:0]
+		c.hasWaiters.Store(false)
+		c.mu.Unlock()
+	}
+	return n, err
+}
+
+// nextWrite returns a channel that is closed after the next write to the conn.
+func (c *writeWatchConn) nextWrite() <-chan struct{} {
+	w := make(chan struct{})
+	c.mu.Lock()
+	c.waiters = append(c.waiters, w)
+	c.hasWaiters.Store(true)
+	c.mu.Unlock()
+	return w
+}
+
 type client struct {
-	rv  rpc.RemoteVat
-	api testAPI
+	conn *writeWatchConn
+	rv   rpc.RemoteVat
+	api  testAPI
 }
 
 func (c *client) Nop(ctx context.Context) error {
@@ -54,7 +93,11 @@ func (c *client) MultTreeValues(ctx context.Context, mult int64, fillArgs func(r
 }
 
 func (c *client) ToHex(ctx context.Context, in, out []byte) error {
-	res, rr, err := c.api.ToHex(in).Wait(ctx)
+	fut, err := c.api.ToHex(in)
+	if err != nil {
+		return err
+	}
+	res, rr, err := fut.Wait(ctx)
 	if err != nil {
 		return err
 	}
@@ -63,15 +106,178 @@ func (c *client) ToHex(ctx context.Context, in, out []byte) error {
 	return nil
 }
 
+func (c *client) Validate(ctx context.Context, v int64, code rpcbench.AppErrorCode) (int64, error) {
+	res, err := c.api.Validate(v, code).Wait(ctx)
+	if err != nil {
+		return 0, errorAppError(err)
+	}
+	return res, nil
+}
+
+func (c *client) Echo(ctx context.Context, in, out []byte) error {
+	fut, err := c.api.Echo(in)
+	if err != nil {
+		return err
+	}
+	res, rr, err := fut.Wait(ctx)
+	if err != nil {
+		return err
+	}
+	copy(out, res.Data())
+	rr.Release()
+	return nil
+}
+
+func (c *client) ScaleVector(ctx context.Context, in []float64, scale float64, out []float64) error {
+	fut, err := c.api.ScaleVector(in, scale)
+	if err != nil {
+		return err
+	}
+	res, rr, err := fut.Wait(ctx)
+	if err != nil {
+		return err
+	}
+	defer rr.Release()
+	data := res.VectorData()
+	if len(data) != len(out)*8 {
+		return fmt.Errorf("unexpected vector data size %d", len(data))
+	}
+	decodeFloat64s(out, data)
+	return nil
+}
+
+func (c *client) UpperRecords(ctx context.Context, in, out []rpcbench.Record) error {
+	fut, err := c.api.UpperRecords(in)
+	if err != nil {
+		return err
+	}
+	res, rr, err := fut.Wait(ctx)
+	if err != nil {
+		return err
+	}
+	defer rr.Release()
+	records, err := res.Records()
+	if err != nil {
+		return err
+	}
+	if records.Len() != len(out) {
+		return fmt.Errorf("unexpected number of records %d", records.Len())
+	}
+	for i := range out {
+		r := records.At(i)
+		r.Record(&out[i])
+	}
+	return nil
+}
+
+func (c *client) UpdateRows(ctx context.Context, in, out []rpcbench.Row) error {
+	fut, err := c.api.UpdateRows(in)
+	if err != nil {
+		return err
+	}
+	res, rr, err := fut.Wait(ctx)
+	if err != nil {
+		return err
+	}
+	defer rr.Release()
+	rows, err := res.Rows()
+	if err != nil {
+		return err
+	}
+	if rows.Len() != len(out) {
+		return fmt.Errorf("unexpected number of rows %d", rows.Len())
+	}
+	for i := range out {
+		r := rows.At(i)
+		r.Row(&out[i])
+	}
+	return nil
+}
+
+func (c *client) IncrementMap(ctx context.Context, in, out map[string]int64) error {
+	fut, err := c.api.IncrementMap(in)
+	if err != nil {
+		return err
+	}
+	res, rr, err := fut.Wait(ctx)
+	if err != nil {
+		return err
+	}
+	defer rr.Release()
+	entries, err := res.Entries()
+	if err != nil {
+		return err
+	}
+	for i := range entries.Len() {
+		e := entries.At(i)
+		out[string(e.Key())] = e.Value()
+	}
+	return nil
+}
+
+func (c *client) NopWithMetadata(ctx context.Context, md []rpcbench.MetadataEntry) (string, error) {
+	echo, err := c.api.NopWithMetadata(md).Wait(ctx)
+	if err != nil {
+		return "", errorAppError(err)
+	}
+	return echo, nil
+}
+
+func (c *client) Slow(ctx context.Context, delay time.Duration) error {
+	if err := c.api.Slow(int64(delay)).Wait(ctx); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		return err
+	}
+	return nil
+}
+
+func (c *client) SlowCalls(ctx context.Context) (started, canceled int64, err error) {
+	return c.api.SlowCalls().Wait(ctx)
+}
+
+func (c *client) WorkWithNops(ctx context.Context, work rpcbench.Work, nops int, onNop func(i int)) error {
+	// Every call is made concurrently, multiplexed through the conn of the
+	// client. Calls are sent once they are waited for, so the Nop calls are
+	// only made after the Work call is written to the conn (or fails).
+	written := c.conn.nextWrite()
+	workDone := make(chan struct{})
+	g := pool.New().WithErrors()
+	g.Go(func() error {
+		defer close(workDone)
+		return c.api.Work(int64(work.Duration), work.CPU).Wait(ctx)
+	})
+	select {
+	case <-written:
+	case <-workDone:
+	}
+	for i := range nops {
+		g.Go(func() error {
+			if err := c.api.Nop().Wait(ctx); err != nil {
+				return err
+			}
+			onNop(i)
+			return nil
+		})
+	}
+	return g.Wait()
+}
+
+// Close closes the conn of the client.
+func (c *client) Close() error {
+	return c.conn.Close()
+}
+
 var clientCount atomic.Uint64
 
-func newClient(ctx context.Context, addr string) (*client, error) {
+func newClient(ctx context.Context, cfg rpcbench.ClientConfig) (*client, error) {
 	// Try to connect.
-	var dc net.Dialer
-	c, err := dc.DialContext(ctx, "tcp", addr)
+	netConn, err := cfg.Dial(ctx)
 	if err != nil {
 		return nil, err
 	}
+	c := &writeWatchConn{Conn: netConn}
 
 	l := zerolog.Nop()
 	zerolog.TimeFieldFormat = zerolog.TimeFormatUnixNano
@@ -98,5 +304,5 @@ func newClient(ctx context.Context, addr string) (*client, error) {
 		return nil, err
 	}
 
-	return &client{rv: rv, api: testAPIFromBootstrap(boot)}, nil
+	return &client{conn: c, rv: rv, api: testAPIFromBootstrap(boot)}, nil
 }
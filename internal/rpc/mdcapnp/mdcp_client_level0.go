@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"time"
 
 	"github.com/matheusd/gorpcbench/rpcbench"
 	rpc "matheusd.com/mdcapnp/capnprpc"
@@ -15,8 +16,9 @@ import (
 )
 
 type clientLevel0 struct {
-	c   *rpc.Level0ClientVat
-	api testAPI
+	conn net.Conn
+	c    *rpc.Level0ClientVat
+	api  testAPI
 
 	buildTree tnBuilderAdapterAlt
 	readTree  tnReaderAdapterAlt
@@ -68,7 +70,11 @@ func (c *clientLevel0) MultTreeValues(ctx context.Context, mult int64, fillArgs
 	return &c.readTree, nil
 }
 func (c *clientLevel0) ToHex(ctx context.Context, in, out []byte) error {
-	res, rr, err := c.api.ToHex(in).Wait(ctx)
+	fut, err := c.api.ToHex(in)
+	if err != nil {
+		return err
+	}
+	res, rr, err := fut.Wait(ctx)
 	if err != nil {
 		return err
 	}
@@ -78,20 +84,155 @@ func (c *clientLevel0) ToHex(ctx context.Context, in, out []byte) error {
 	return nil
 }
 
-func newclientLevel0(ctx context.Context, addr string) (*clientLevel0, error) {
+func (c *clientLevel0) Validate(ctx context.Context, v int64, code rpcbench.AppErrorCode) (int64, error) {
+	res, err := c.api.Validate(v, code).Wait(ctx)
+	if err != nil {
+		return 0, errorAppError(err)
+	}
+	return res, nil
+}
+
+func (c *clientLevel0) Echo(ctx context.Context, in, out []byte) error {
+	fut, err := c.api.Echo(in)
+	if err != nil {
+		return err
+	}
+	res, rr, err := fut.Wait(ctx)
+	if err != nil {
+		return err
+	}
+	copy(out, res.Data())
+	rr.Release()
+	return nil
+}
+
+func (c *clientLevel0) ScaleVector(ctx context.Context, in []float64, scale float64, out []float64) error {
+	fut, err := c.api.ScaleVector(in, scale)
+	if err != nil {
+		return err
+	}
+	res, rr, err := fut.Wait(ctx)
+	if err != nil {
+		return err
+	}
+	defer rr.Release()
+	data := res.VectorData()
+	if len(data) != len(out)*8 {
+		return fmt.Errorf("unexpected vector data size %d", len(data))
+	}
+	decodeFloat64s(out, data)
+	return nil
+}
+
+func (c *clientLevel0) UpperRecords(ctx context.Context, in, out []rpcbench.Record) error {
+	fut, err := c.api.UpperRecords(in)
+	if err != nil {
+		return err
+	}
+	res, rr, err := fut.Wait(ctx)
+	if err != nil {
+		return err
+	}
+	defer rr.Release()
+	records, err := res.Records()
+	if err != nil {
+		return err
+	}
+	if records.Len() != len(out) {
+		return fmt.Errorf("unexpected number of records %d", records.Len())
+	}
+	for i := range out {
+		r := records.At(i)
+		r.Record(&out[i])
+	}
+	return nil
+}
+
+func (c *clientLevel0) UpdateRows(ctx context.Context, in, out []rpcbench.Row) error {
+	fut, err := c.api.UpdateRows(in)
+	if err != nil {
+		return err
+	}
+	res, rr, err := fut.Wait(ctx)
+	if err != nil {
+		return err
+	}
+	defer rr.Release()
+	rows, err := res.Rows()
+	if err != nil {
+		return err
+	}
+	if rows.Len() != len(out) {
+		return fmt.Errorf("unexpected number of rows %d", rows.Len())
+	}
+	for i := range out {
+		r := rows.At(i)
+		r.Row(&out[i])
+	}
+	return nil
+}
+
+func (c *clientLevel0) IncrementMap(ctx context.Context, in, out map[string]int64) error {
+	fut, err := c.api.IncrementMap(in)
+	if err != nil {
+		return err
+	}
+	res, rr, err := fut.Wait(ctx)
+	if err != nil {
+		return err
+	}
+	defer rr.Release()
+	entries, err := res.Entries()
+	if err != nil {
+		return err
+	}
+	for i := range entries.Len() {
+		e := entries.At(i)
+		out[string(e.Key())] = e.Value()
+	}
+	return nil
+}
+
+func (c *clientLevel0) NopWithMetadata(ctx context.Context, md []rpcbench.MetadataEntry) (string, error) {
+	echo, err := c.api.NopWithMetadata(md).Wait(ctx)
+	if err != nil {
+		return "", errorAppError(err)
+	}
+	return echo, nil
+}
+
+func (c *clientLevel0) Slow(ctx context.Context, delay time.Duration) error {
+	if err := c.api.Slow(int64(delay)).Wait(ctx); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		return err
+	}
+	return nil
+}
+
+func (c *clientLevel0) SlowCalls(ctx context.Context) (started, canceled int64, err error) {
+	return c.api.SlowCalls().Wait(ctx)
+}
+
+// Close closes the conn of the client.
+func (c *clientLevel0) Close() error {
+	return c.conn.Close()
+}
+
+func newclientLevel0(ctx context.Context, cfg rpcbench.ClientConfig) (*clientLevel0, error) {
 	// Try to connect.
-	var dc net.Dialer
-	c, err := dc.DialContext(ctx, "tcp", addr)
+	c, err := cfg.Dial(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	remoteName := c.RemoteAddr().String()
 	ioc := rpc.NewIOTransport(remoteName, c)
-	cfg := rpc.Level0ClientCfg{
+	vatCfg := rpc.Level0ClientCfg{
 		Conn: ioc,
 	}
-	vat := rpc.NewLevel0ClientVat(cfg)
+	vat := rpc.NewLevel0ClientVat(vatCfg)
 
 	// Wait for bootstrap.
 	boot := vat.Bootstrap()
@@ -99,5 +240,5 @@ func newclientLevel0(ctx context.Context, addr string) (*clientLevel0, error) {
 		return nil, err
 	}
 	api := testAPIFromBootstrap(boot)
-	return &clientLevel0{c: vat, api: api}, nil
+	return &clientLevel0{conn: c, c: vat, api: api}, nil
 }
@@ -19,11 +19,11 @@ func (f mdcapFactory) NewServer(l net.Listener) (rpcbench.Server, error) {
 	return newServer(l), nil
 }
 
-func (f mdcapFactory) NewClient(ctx context.Context, addr string) (rpcbench.Client, error) {
+func (f mdcapFactory) NewClient(ctx context.Context, cfg rpcbench.ClientConfig) (rpcbench.Client, error) {
 	if f.level0 {
-		return newclientLevel0(ctx, addr)
+		return newclientLevel0(ctx, cfg)
 	}
-	return newClient(ctx, addr)
+	return newClient(ctx, cfg)
 }
 
 func MDCapNProtoFactoryIniter() rpcbench.RPCFactory {
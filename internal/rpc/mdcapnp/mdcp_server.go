@@ -6,19 +6,24 @@ package mdcapnp
 
 import (
 	"context"
+	"encoding/binary"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
 	"net"
+	"time"
 
+	"github.com/matheusd/gorpcbench/rpcbench"
 	"github.com/sourcegraph/conc/pool"
 	rpc "matheusd.com/mdcapnp/capnprpc"
 	ser "matheusd.com/mdcapnp/capnpser"
 )
 
 type server struct {
-	v *rpc.Vat
-	l net.Listener
+	v    *rpc.Vat
+	l    net.Listener
+	slow rpcbench.SlowHandler
 	// skipLog bool
 }
 
@@ -105,6 +110,231 @@ func (s *server) handleToHex(cc *rpc.CallContext) error {
 	return nil
 }
 
+func (s *server) handleEcho(cc *rpc.CallContext) error {
+	req, err := rpc.CallContextParamsStruct[echoRequest](cc)
+	if err != nil {
+		return err
+	}
+	in := req.Data()
+	resSizeHint, _ := ser.ByteCount(len(in)).StorageWordCount()
+	res, err := rpc.RespondCallAsStruct[echoResponseBuilder](cc, echoResponseSize, resSizeHint)
+	if err != nil {
+		return err
+	}
+
+	out, err := res.NewData(len(in))
+	if err != nil {
+		return err
+	}
+	copy(out, in)
+	return nil
+}
+
+func (s *server) handleVector(cc *rpc.CallContext) error {
+	req, err := rpc.CallContextParamsStruct[vectorRequest](cc)
+	if err != nil {
+		return err
+	}
+	in := req.VectorData()
+	if len(in)%8 != 0 {
+		return fmt.Errorf("vector data size %d is not a multiple of 8", len(in))
+	}
+	scale := req.Scale()
+	resSizeHint, _ := ser.ByteCount(len(in)).StorageWordCount()
+	res, err := rpc.RespondCallAsStruct[vectorResponseBuilder](cc, vectorResponseSize, resSizeHint)
+	if err != nil {
+		return err
+	}
+
+	out, err := res.NewVectorData(len(in) / 8)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < len(in); i += 8 {
+		f := math.Float64frombits(binary.LittleEndian.Uint64(in[i:]))
+		binary.LittleEndian.PutUint64(out[i:], math.Float64bits(f*scale))
+	}
+	return nil
+}
+
+func (s *server) handleMetadata(cc *rpc.CallContext) error {
+	req, err := rpc.CallContextParamsStruct[metadataRequest](cc)
+	if err != nil {
+		return err
+	}
+	md, err := req.Metadata()
+	if err != nil {
+		return err
+	}
+	echo, appErr := rpcbench.CheckMetadata(func(key string) string {
+		for i := range md.Len() {
+			e := md.At(i)
+			if string(e.Key()) == key {
+				return string(e.Value())
+			}
+		}
+		return ""
+	})
+	if appErr != nil {
+		return appErrorError(appErr)
+	}
+	resSizeHint, _ := ser.ByteCount(len(echo)).StorageWordCount()
+	res, err := rpc.RespondCallAsStruct[metadataResponseBuilder](cc, metadataResponseSize, resSizeHint)
+	if err != nil {
+		return err
+	}
+	res.SetEcho(echo)
+	return nil
+}
+
+func (s *server) handleRecords(cc *rpc.CallContext) error {
+	req, err := rpc.CallContextParamsStruct[recordsRequest](cc)
+	if err != nil {
+		return err
+	}
+	in, err := req.Records()
+	if err != nil {
+		return err
+	}
+
+	// The response is the same(ish) size of the request, so use that as a
+	// size hint of the reponse.
+	var resSizeHint ser.WordCount = (*ser.Struct)(&req).Arena().TotalSize()
+	res, err := rpc.RespondCallAsStruct[recordsResponseBuilder](cc, recordsResponseSize, resSizeHint)
+	if err != nil {
+		return err
+	}
+
+	out, err := res.NewRecords(in.Len())
+	if err != nil {
+		return err
+	}
+	var r rpcbench.Record
+	for i := range in.Len() {
+		inRecord := in.At(i)
+		inRecord.Record(&r)
+		r.Name = rpcbench.UpperName(r.Name)
+		outRecord := out.At(i)
+		if err := outRecord.SetRecord(&r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *server) handleRows(cc *rpc.CallContext) error {
+	req, err := rpc.CallContextParamsStruct[rowsRequest](cc)
+	if err != nil {
+		return err
+	}
+	in, err := req.Rows()
+	if err != nil {
+		return err
+	}
+
+	// The response is the same(ish) size of the request, so use that as a
+	// size hint of the reponse.
+	var resSizeHint ser.WordCount = (*ser.Struct)(&req).Arena().TotalSize()
+	res, err := rpc.RespondCallAsStruct[rowsResponseBuilder](cc, rowsResponseSize, resSizeHint)
+	if err != nil {
+		return err
+	}
+
+	out, err := res.NewRows(in.Len())
+	if err != nil {
+		return err
+	}
+	var r rpcbench.Row
+	for i := range in.Len() {
+		inRow := in.At(i)
+		inRow.Row(&r)
+		r.Version++
+		outRow := out.At(i)
+		if err := outRow.SetRow(&r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *server) handleMap(cc *rpc.CallContext) error {
+	req, err := rpc.CallContextParamsStruct[mapRequest](cc)
+	if err != nil {
+		return err
+	}
+	in, err := req.Entries()
+	if err != nil {
+		return err
+	}
+
+	// The response is the same(ish) size of the request, so use that as a
+	// size hint of the reponse.
+	var resSizeHint ser.WordCount = (*ser.Struct)(&req).Arena().TotalSize()
+	res, err := rpc.RespondCallAsStruct[mapResponseBuilder](cc, mapResponseSize, resSizeHint)
+	if err != nil {
+		return err
+	}
+
+	out, err := res.NewEntries(in.Len())
+	if err != nil {
+		return err
+	}
+	for i := range in.Len() {
+		inEntry, outEntry := in.At(i), out.At(i)
+		if err := outEntry.SetKey(string(inEntry.Key())); err != nil {
+			return err
+		}
+		if err := outEntry.SetValue(inEntry.Value() + 1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *server) handleSlow(ctx context.Context, cc *rpc.CallContext) error {
+	req, err := rpc.CallContextParamsStruct[slowRequest](cc)
+	if err != nil {
+		return err
+	}
+	return s.slow.Handle(ctx, time.Duration(req.Delay()))
+}
+
+func (s *server) handleSlowCalls(cc *rpc.CallContext) error {
+	res, err := rpc.RespondCallAsStruct[slowCallsResponseBuilder](cc, slowCallsResponseSize, 0)
+	if err != nil {
+		return err
+	}
+	started, canceled := s.slow.Counts()
+	res.SetStarted(started)
+	res.SetCanceled(canceled)
+	return nil
+}
+
+func (s *server) handleWork(cc *rpc.CallContext) error {
+	req, err := rpc.CallContextParamsStruct[workRequest](cc)
+	if err != nil {
+		return err
+	}
+	rpcbench.Work{Duration: time.Duration(req.Duration()), CPU: req.CPU()}.Do()
+	return nil
+}
+
+func (s *server) handleValidate(cc *rpc.CallContext) error {
+	req, err := rpc.CallContextParamsStruct[validateRequest](cc)
+	if err != nil {
+		return err
+	}
+	if code := req.Code(); code != 0 {
+		return appErrorError(rpcbench.RejectValue(code, req.Value()))
+	}
+	res, err := rpc.RespondCallAsStruct[validateResponseBuilder](cc, validateResponseSize, 0)
+	if err != nil {
+		return err
+	}
+	res.SetValue(req.Value())
+	return nil
+}
+
 func (s *server) Call(ctx context.Context, cc *rpc.CallContext) error {
 	if cc.InterfaceId() != api_interfaceId {
 		return errors.New("wrong interfaceId")
@@ -118,6 +348,26 @@ func (s *server) Call(ctx context.Context, cc *rpc.CallContext) error {
 		return s.handleMultTree(cc)
 	case api_toHex_methodId:
 		return s.handleToHex(cc)
+	case api_slow_methodId:
+		return s.handleSlow(ctx, cc)
+	case api_slowCalls_methodId:
+		return s.handleSlowCalls(cc)
+	case api_work_methodId:
+		return s.handleWork(cc)
+	case api_validate_methodId:
+		return s.handleValidate(cc)
+	case api_echo_methodId:
+		return s.handleEcho(cc)
+	case api_vector_methodId:
+		return s.handleVector(cc)
+	case api_metadata_methodId:
+		return s.handleMetadata(cc)
+	case api_records_methodId:
+		return s.handleRecords(cc)
+	case api_rows_methodId:
+		return s.handleRows(cc)
+	case api_map_methodId:
+		return s.handleMap(cc)
 	default:
 		return errors.New("unimplemented method")
 	}
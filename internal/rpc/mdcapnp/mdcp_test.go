@@ -0,0 +1,343 @@
+// Copyright (c) 2025 Matheus Degiovani
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package mdcapnp
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math"
+	"net"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/matheusd/gorpcbench/rpcbench"
+)
+
+// testClient is the part of the clients of both variants that is tested.
+type testClient interface {
+	rpcbench.Client
+	rpcbench.DeadlineClient
+}
+
+// runTestServer runs a server on a loopback listener until the test ends, and
+// returns the config to connect to it.
+func runTestServer(t *testing.T) rpcbench.ClientConfig {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := newServer(l)
+	ctx, cancel := context.WithCancel(context.Background())
+	runErr := make(chan error, 1)
+	go func() { runErr <- s.Run(ctx) }()
+	t.Cleanup(func() {
+		cancel()
+		if err := <-runErr; err != nil {
+			t.Errorf("server errored: %v", err)
+		}
+	})
+	return rpcbench.ClientConfig{Network: "tcp", Addr: l.Addr().String()}
+}
+
+// testClients returns a client of each variant, connected to a new server.
+func testClients(t *testing.T) map[string]testClient {
+	t.Helper()
+	res := make(map[string]testClient)
+	for _, level0 := range []bool{false, true} {
+		cfg := runTestServer(t)
+		var c testClient
+		var err error
+		name := "standard"
+		if level0 {
+			name = "level0"
+			c, err = newclientLevel0(t.Context(), cfg)
+		} else {
+			c, err = newClient(t.Context(), cfg)
+		}
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		t.Cleanup(func() { c.(interface{ Close() error }).Close() })
+		res[name] = c
+	}
+	return res
+}
+
+// TestSlow tests that slow calls are interrupted by their deadline, and that
+// they are counted by the server.
+func TestSlow(t *testing.T) {
+	for name, c := range testClients(t) {
+		t.Run(name, func(t *testing.T) {
+			if err := c.Slow(t.Context(), time.Millisecond); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			ctx, cancel := context.WithTimeout(t.Context(), 20*time.Millisecond)
+			defer cancel()
+			start := time.Now()
+			err := c.Slow(ctx, time.Minute)
+			if !errors.Is(err, context.DeadlineExceeded) {
+				t.Fatalf("unexpected error: got %v, want %v", err, context.DeadlineExceeded)
+			}
+			if elapsed := time.Since(start); elapsed > 10*time.Second {
+				t.Fatalf("call was not interrupted (took %v)", elapsed)
+			}
+
+			// The server sees the cancellation asynchronously.
+			var started, canceled int64
+			for range 100 {
+				started, canceled, err = c.SlowCalls(t.Context())
+				if err != nil {
+					t.Fatal(err)
+				}
+				if canceled == 1 {
+					break
+				}
+				time.Sleep(10 * time.Millisecond)
+			}
+			if started != 2 || canceled != 1 {
+				t.Fatalf("unexpected slow calls: got %d started and %d canceled, want 2 and 1",
+					started, canceled)
+			}
+		})
+	}
+}
+
+// TestWork tests that work calls take (at least) the duration of their work.
+func TestWork(t *testing.T) {
+	const duration = 20 * time.Millisecond
+	for name, c := range testClients(t) {
+		t.Run(name, func(t *testing.T) {
+			var api testAPI
+			switch c := c.(type) {
+			case *client:
+				api = c.api
+			case *clientLevel0:
+				api = c.api
+			}
+			for _, cpu := range []bool{false, true} {
+				start := time.Now()
+				if err := api.Work(int64(duration), cpu).Wait(t.Context()); err != nil {
+					t.Fatal(err)
+				}
+				if elapsed := time.Since(start); elapsed < duration {
+					t.Fatalf("work (cpu %v) took %v, less than %v", cpu, elapsed, duration)
+				}
+			}
+		})
+	}
+}
+
+// TestWorkWithNops tests that every nop call made along with a work call
+// returns.
+func TestWorkWithNops(t *testing.T) {
+	const nops = 8
+	c := testClients(t)["standard"].(rpcbench.HeadOfLineClient)
+	var done [nops]atomic.Bool
+	work := rpcbench.Work{Duration: 20 * time.Millisecond}
+	if err := c.WorkWithNops(t.Context(), work, nops, func(i int) { done[i].Store(true) }); err != nil {
+		t.Fatal(err)
+	}
+	for i := range done {
+		if !done[i].Load() {
+			t.Fatalf("nop call %d did not return", i)
+		}
+	}
+}
+
+// TestEcho tests that payloads are echoed back unmodified.
+func TestEcho(t *testing.T) {
+	for name, c := range testClients(t) {
+		t.Run(name, func(t *testing.T) {
+			lc := c.(rpcbench.LargeClient)
+			for _, size := range []int{0, 1, 7, 8, 4096, 1 << 20} {
+				in := make([]byte, size)
+				for i := range in {
+					in[i] = byte(i * 7)
+				}
+				out := make([]byte, size)
+				if err := lc.Echo(t.Context(), in, out); err != nil {
+					t.Fatalf("size %d: %v", size, err)
+				}
+				if !bytes.Equal(in, out) {
+					t.Fatalf("size %d: payload was modified", size)
+				}
+			}
+		})
+	}
+}
+
+// TestValidate tests that app errors are returned to clients with their code
+// and message.
+func TestValidate(t *testing.T) {
+	for name, c := range testClients(t) {
+		t.Run(name, func(t *testing.T) {
+			ec := c.(rpcbench.ErrorClient)
+			if v, err := ec.Validate(t.Context(), 42, 0); err != nil || v != 42 {
+				t.Fatalf("unexpected reply: got %d, %v, want 42", v, err)
+			}
+
+			_, err := ec.Validate(t.Context(), 42, 5)
+			var appErr *rpcbench.AppError
+			if !errors.As(err, &appErr) {
+				t.Fatalf("unexpected error: got %v, want an app error", err)
+			}
+			if want := rpcbench.RejectValue(5, 42); *appErr != *want {
+				t.Fatalf("unexpected app error: got %q, want %q", appErr, want)
+			}
+
+			// The conn is still usable.
+			if v, err := ec.Validate(t.Context(), 43, 0); err != nil || v != 43 {
+				t.Fatalf("unexpected reply: got %d, %v, want 43", v, err)
+			}
+		})
+	}
+}
+
+// TestScaleVector tests that vectors are scaled by the server, including their
+// NaN and ±Inf values.
+func TestScaleVector(t *testing.T) {
+	in := []float64{1, -2.5, math.NaN(), math.Inf(1), math.Inf(-1), math.Copysign(0, -1), math.MaxFloat64}
+	for name, c := range testClients(t) {
+		t.Run(name, func(t *testing.T) {
+			vc := c.(rpcbench.VectorClient)
+			for _, n := range []int{0, 1, len(in)} {
+				out := make([]float64, n)
+				if err := vc.ScaleVector(t.Context(), in[:n], 2, out); err != nil {
+					t.Fatal(err)
+				}
+				for i := range out {
+					want := in[i] * 2
+					if math.Float64bits(out[i]) != math.Float64bits(want) &&
+						!(math.IsNaN(out[i]) && math.IsNaN(want)) {
+						t.Fatalf("element %d: got %v, want %v", i, out[i], want)
+					}
+				}
+			}
+		})
+	}
+}
+
+// TestNopWithMetadata tests that the metadata of calls is validated by the
+// server, which echoes back the trace id.
+func TestNopWithMetadata(t *testing.T) {
+	const traceID = "0123456789abcdef0123456789abcdef"
+	valid := []rpcbench.MetadataEntry{
+		{Key: rpcbench.MetadataKeyAuth, Value: "Bearer " + strings.Repeat("0a", 32)},
+		{Key: rpcbench.MetadataKeyTenant, Value: "tenant-1"},
+		{Key: rpcbench.MetadataKeyTraceID, Value: traceID},
+		{Key: "x-locale", Value: ""},
+	}
+	for name, c := range testClients(t) {
+		t.Run(name, func(t *testing.T) {
+			mc := c.(rpcbench.MetadataClient)
+			echo, err := mc.NopWithMetadata(t.Context(), valid)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if echo != traceID {
+				t.Fatalf("unexpected echo: got %q, want %q", echo, traceID)
+			}
+
+			_, err = mc.NopWithMetadata(t.Context(), valid[1:])
+			var appErr *rpcbench.AppError
+			if !errors.As(err, &appErr) || appErr.Code != rpcbench.AppErrPermissionDenied {
+				t.Fatalf("unexpected error: got %v, want a permission denied app error", err)
+			}
+		})
+	}
+}
+
+// TestUpperRecords tests that records are replied with their names in upper
+// case, and their other fields unmodified.
+func TestUpperRecords(t *testing.T) {
+	in := []rpcbench.Record{
+		{ID: 1, Name: "Zoë Müller", Email: "zoe.1@example.com", Bio: "café 日本語 🚀"},
+		{ID: -2},
+		{ID: 3, Name: "Дмитрий Иванов", Email: "dmitriy.3@example.com", Bio: strings.Repeat("naïve ", 100)},
+	}
+	for name, c := range testClients(t) {
+		t.Run(name, func(t *testing.T) {
+			rc := c.(rpcbench.RecordsClient)
+			for _, n := range []int{0, 1, len(in)} {
+				out := make([]rpcbench.Record, n)
+				if err := rc.UpperRecords(t.Context(), in[:n], out); err != nil {
+					t.Fatal(err)
+				}
+				for i := range out {
+					want := in[i]
+					want.Name = rpcbench.UpperName(want.Name)
+					if out[i] != want {
+						t.Fatalf("record %d: got %+v, want %+v", i, out[i], want)
+					}
+				}
+			}
+		})
+	}
+}
+
+// TestUpdateRows tests that rows are replied with their versions incremented,
+// and that their optional fields round-trip, whether set or not.
+func TestUpdateRows(t *testing.T) {
+	var (
+		deletedAt, zero int64 = 1 << 62, 0
+		priority        int32 = -7
+		discount              = math.Inf(-1)
+		archived              = false
+		phone, empty          = "+55 123456789", ""
+	)
+	in := []rpcbench.Row{
+		{ID: 1, AccountID: -1, CreatedAt: 2, UpdatedAt: 3, Balance: math.MinInt64,
+			Version: math.MaxInt32 - 1, Status: -3, Score: -0.5, Active: true,
+			Name: "Zoë Müller", Email: "zoe@example.com", Country: "BR",
+			Currency: "BRL", Plan: "pro", Region: "sa-east-1",
+			DeletedAt: &deletedAt, CreditLimit: &zero, Priority: &priority,
+			Discount: &discount, Archived: &archived, Phone: &phone, Notes: &empty},
+		{ID: 2, Verified: true},
+	}
+	for name, c := range testClients(t) {
+		t.Run(name, func(t *testing.T) {
+			rc := c.(rpcbench.RowsClient)
+			out := make([]rpcbench.Row, len(in))
+			if err := rc.UpdateRows(t.Context(), in, out); err != nil {
+				t.Fatal(err)
+			}
+			for i := range out {
+				want := in[i]
+				want.Version++
+				if !reflect.DeepEqual(out[i], want) {
+					t.Fatalf("row %d: got %+v, want %+v", i, out[i], want)
+				}
+			}
+		})
+	}
+}
+
+// TestIncrementMap tests that maps are replied with their values incremented.
+func TestIncrementMap(t *testing.T) {
+	in := map[string]int64{"": 0, "café.über.1": -1, "日本語": math.MaxInt64 - 1}
+	for name, c := range testClients(t) {
+		t.Run(name, func(t *testing.T) {
+			mc := c.(rpcbench.MapClient)
+			out := make(map[string]int64)
+			if err := mc.IncrementMap(t.Context(), in, out); err != nil {
+				t.Fatal(err)
+			}
+			if len(out) != len(in) {
+				t.Fatalf("unexpected number of entries: got %d, want %d", len(out), len(in))
+			}
+			for k, v := range in {
+				if got, ok := out[k]; !ok || got != v+1 {
+					t.Fatalf("entry %q: got %d (found %v), want %d", k, got, ok, v+1)
+				}
+			}
+		})
+	}
+}
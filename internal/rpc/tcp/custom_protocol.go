@@ -9,4 +9,95 @@ const (
 	cmdAdd      byte = 2
 	cmdMultTree byte = 3
 	cmdToHex    byte = 4
+
+	// cmdServerStream is followed by the start and count of items. The
+	// server replies with count items, each one flushed individually.
+	cmdServerStream byte = 5
+
+	// cmdClientStream is followed by a sequence of chunks, each one
+	// prefixed by its size and flushed individually, and terminated by an
+	// empty chunk. The server replies with the checksum of all chunks.
+	cmdClientStream byte = 6
+
+	// cmdBidiStream is followed by a sequence of frames, each one starting
+	// with a frame type. The int64 of every bidiFrameMsg frame is echoed
+	// back (and flushed) by the server. A bidiFrameEnd frame ends the
+	// stream.
+	cmdBidiStream byte = 7
+
+	// cmdCallback is followed by the start and count of callbacks. The
+	// server replies with a sequence of frames, each one starting with a
+	// frame type. The client replies (and flushes) an int64 for the int64
+	// of every callbackFrameCall frame. A callbackFrameReturn frame ends
+	// the call, with the sum of the replies.
+	cmdCallback byte = 8
+
+	// cmdValidate is followed by a value and an app error code. The server
+	// replies with a reply type: a validateReplyOK reply is followed by the
+	// value, while a validateReplyError reply is followed by the app error
+	// (when the code is not zero).
+	cmdValidate byte = 9
+
+	// cmdSlow is followed by a delay and a timeout (zero when the call has
+	// no deadline). The server replies with a reply type once the delay
+	// elapses, or with slowReplyCanceled once the timeout expires.
+	cmdSlow byte = 10
+
+	// cmdSlowCalls has no arguments. The server replies with the number of
+	// cmdSlow calls that were started and the number of the ones that were
+	// canceled.
+	cmdSlowCalls byte = 11
+
+	// cmdWork is followed by the work to do. The server replies with
+	// cmdWork once the work is done. Clients may send other calls before
+	// the reply, which are only handled after it.
+	cmdWork byte = 12
+
+	// cmdLarge is followed by the size of a payload (up to the max message
+	// size of the run) and the payload itself. The server replies with the
+	// same payload.
+	cmdLarge byte = 13
+
+	// cmdRecords is followed by a list of records. The server replies with
+	// the same list, with the names converted by rpcbench.UpperName.
+	cmdRecords byte = 14
+
+	// cmdRows is followed by a list of rows. The server replies with the
+	// same list, with the versions of the rows incremented.
+	cmdRows byte = 15
+
+	// cmdMap is followed by a map. The server replies with the same map,
+	// with every value incremented.
+	cmdMap byte = 16
+
+	// cmdVector is followed by a scale and a vector. The server replies
+	// with the vector multiplied by the scale.
+	cmdVector byte = 17
+
+	// cmdMetadata is followed by a header section with the metadata of the
+	// call (see binutils.WriteMetadata). The server replies with a reply
+	// type: a validateReplyOK reply is followed by the value returned by
+	// rpcbench.CheckMetadata, while a validateReplyError reply is followed
+	// by the app error (when the metadata is invalid).
+	cmdMetadata byte = 18
+)
+
+const (
+	bidiFrameEnd byte = 0
+	bidiFrameMsg byte = 1
+)
+
+const (
+	callbackFrameReturn byte = 0
+	callbackFrameCall   byte = 1
+)
+
+const (
+	validateReplyOK    byte = 0
+	validateReplyError byte = 1
+)
+
+const (
+	slowReplyOK       byte = 0
+	slowReplyCanceled byte = 1
 )
@@ -7,10 +7,15 @@ package tcp
 import (
 	"bufio"
 	"context"
+	"errors"
+	"fmt"
 	"io"
 	"net"
+	"sync"
+	"time"
 
 	"github.com/matheusd/gorpcbench/internal/binutils"
+	"github.com/matheusd/gorpcbench/internal/netutils"
 	"github.com/matheusd/gorpcbench/rpcbench"
 )
 
@@ -20,9 +25,70 @@ type tcpClient struct {
 	reader *bufio.Reader
 	writer *bufio.Writer
 	tree   rpcbench.TreeNodeImpl
+
+	// ctx and cfg are used to reconnect to the server, after a call is
+	// interrupted by its context and leaves the conn in an unknown state.
+	ctx       context.Context
+	cfg       rpcbench.ClientConfig
+	stopClose func() bool
+	broken    bool
+	watch     netutils.CallWatch
+}
+
+// connect connects to the server, replacing the current conn (if any).
+func (c *tcpClient) connect() error {
+	conn, err := c.cfg.Dial(c.ctx)
+	if err != nil {
+		return err
+	}
+	c.c = conn
+	c.stopClose = context.AfterFunc(c.ctx, func() { conn.Close() })
+	c.reader.Reset(conn)
+	c.writer.Reset(conn)
+	c.broken = false
+	return nil
+}
+
+// begin starts a call that honors ctx. Every call that is started must be
+// ended by calling end.
+func (c *tcpClient) begin(ctx context.Context) error {
+	if c.broken {
+		if err := c.connect(); err != nil {
+			return err
+		}
+	}
+	c.watch.Start(ctx, c.c)
+	return nil
 }
 
-func (c *tcpClient) Nop(ctx context.Context) error {
+// end ends a call that returned err.
+func (c *tcpClient) end(err error) error {
+	// Errors replied by the server leave the conn in a known state.
+	ioErr := err
+	var appErr *rpcbench.AppError
+	if errors.As(err, &appErr) || errors.Is(err, context.DeadlineExceeded) {
+		ioErr = nil
+	}
+
+	reuse, ioErr := c.watch.End(ioErr)
+	if !reuse {
+		// The next call needs a new conn.
+		c.stopClose()
+		netutils.CloseBroken(c.c)
+		c.broken = true
+	}
+	if ioErr != nil {
+		return ioErr
+	}
+	return err
+}
+
+func (c *tcpClient) Nop(ctx context.Context) (err error) {
+	if err := c.begin(ctx); err != nil {
+		return err
+	}
+	defer func() { err = c.end(err) }()
+
 	if err := c.writer.WriteByte(cmdNop); err != nil {
 		return err
 	}
@@ -31,11 +97,16 @@ func (c *tcpClient) Nop(ctx context.Context) error {
 		return err
 	}
 
-	_, err := c.reader.ReadByte()
+	_, err = c.reader.ReadByte()
 	return err
 }
 
-func (c *tcpClient) Add(ctx context.Context, a int64, b int64) (int64, error) {
+func (c *tcpClient) Add(ctx context.Context, a int64, b int64) (_ int64, err error) {
+	if err := c.begin(ctx); err != nil {
+		return 0, err
+	}
+	defer func() { err = c.end(err) }()
+
 	if err := c.writer.WriteByte(cmdAdd); err != nil {
 		return 0, err
 	}
@@ -52,7 +123,12 @@ func (c *tcpClient) Add(ctx context.Context, a int64, b int64) (int64, error) {
 	return binutils.ReadInt64(c.reader, c.aux)
 }
 
-func (c *tcpClient) MultTreeValues(ctx context.Context, mult int64, fillArgs func(rpcbench.TreeNode)) (rpcbench.TreeNode, error) {
+func (c *tcpClient) MultTreeValues(ctx context.Context, mult int64, fillArgs func(rpcbench.TreeNode)) (_ rpcbench.TreeNode, err error) {
+	if err := c.begin(ctx); err != nil {
+		return nil, err
+	}
+	defer func() { err = c.end(err) }()
+
 	tree := &c.tree
 	tree.Reset()
 	fillArgs(tree)
@@ -74,7 +150,12 @@ func (c *tcpClient) MultTreeValues(ctx context.Context, mult int64, fillArgs fun
 	return tree, nil
 }
 
-func (c *tcpClient) ToHex(ctx context.Context, in, out []byte) error {
+func (c *tcpClient) ToHex(ctx context.Context, in, out []byte) (err error) {
+	if err := c.begin(ctx); err != nil {
+		return err
+	}
+	defer func() { err = c.end(err) }()
+
 	if err := c.writer.WriteByte(cmdToHex); err != nil {
 		return err
 	}
@@ -87,25 +168,488 @@ func (c *tcpClient) ToHex(ctx context.Context, in, out []byte) error {
 	if err := c.writer.Flush(); err != nil {
 		return err
 	}
-	_, err := io.ReadFull(c.reader, out)
+	_, err = io.ReadFull(c.reader, out)
 	return err
 }
 
-func newTCPClient(ctx context.Context, addr string) (*tcpClient, error) {
-	// Try to connect.
-	var dc net.Dialer
-	c, err := dc.DialContext(ctx, "tcp", addr)
+func (c *tcpClient) ServerStream(ctx context.Context, start int64, count int, onItem func(int64) error) (err error) {
+	if err := c.begin(ctx); err != nil {
+		return err
+	}
+	defer func() { err = c.end(err) }()
+
+	if err := c.writer.WriteByte(cmdServerStream); err != nil {
+		return err
+	}
+	if err := binutils.WriteInt64(c.writer, c.aux, start); err != nil {
+		return err
+	}
+	if err := binutils.WriteInt64(c.writer, c.aux, int64(count)); err != nil {
+		return err
+	}
+	if err := c.writer.Flush(); err != nil {
+		return err
+	}
+
+	for range count {
+		v, err := binutils.ReadInt64(c.reader, c.aux)
+		if err != nil {
+			return err
+		}
+		if err := onItem(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *tcpClient) ClientStream(ctx context.Context, chunks [][]byte) (_ uint32, err error) {
+	if err := c.begin(ctx); err != nil {
+		return 0, err
+	}
+	defer func() { err = c.end(err) }()
+
+	if err := c.writer.WriteByte(cmdClientStream); err != nil {
+		return 0, err
+	}
+	for _, chunk := range chunks {
+		if err := binutils.WriteInt64(c.writer, c.aux, int64(len(chunk))); err != nil {
+			return 0, err
+		}
+		if _, err := c.writer.Write(chunk); err != nil {
+			return 0, err
+		}
+		if err := c.writer.Flush(); err != nil {
+			return 0, err
+		}
+	}
+	if err := binutils.WriteInt64(c.writer, c.aux, 0); err != nil {
+		return 0, err
+	}
+	if err := c.writer.Flush(); err != nil {
+		return 0, err
+	}
+
+	sum, err := binutils.ReadInt64(c.reader, c.aux)
+	return uint32(sum), err
+}
+
+func (c *tcpClient) Callback(ctx context.Context, start int64, count int, onCall func(int64) (int64, error)) (_ int64, err error) {
+	if err := c.begin(ctx); err != nil {
+		return 0, err
+	}
+	defer func() { err = c.end(err) }()
+
+	if err := c.writer.WriteByte(cmdCallback); err != nil {
+		return 0, err
+	}
+	if err := binutils.WriteInt64(c.writer, c.aux, start); err != nil {
+		return 0, err
+	}
+	if err := binutils.WriteInt64(c.writer, c.aux, int64(count)); err != nil {
+		return 0, err
+	}
+	if err := c.writer.Flush(); err != nil {
+		return 0, err
+	}
+
+	for {
+		frame, err := c.reader.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		v, err := binutils.ReadInt64(c.reader, c.aux)
+		if err != nil {
+			return 0, err
+		}
+		switch frame {
+		case callbackFrameReturn:
+			return v, nil
+		case callbackFrameCall:
+		default:
+			return 0, fmt.Errorf("unknown callback frame %d", frame)
+		}
+
+		res, err := onCall(v)
+		if err != nil {
+			return 0, err
+		}
+		if err := binutils.WriteInt64(c.writer, c.aux, res); err != nil {
+			return 0, err
+		}
+		if err := c.writer.Flush(); err != nil {
+			return 0, err
+		}
+	}
+}
+
+func (c *tcpClient) Validate(ctx context.Context, v int64, code rpcbench.AppErrorCode) (_ int64, err error) {
+	if err := c.begin(ctx); err != nil {
+		return 0, err
+	}
+	defer func() { err = c.end(err) }()
+
+	if err := c.writer.WriteByte(cmdValidate); err != nil {
+		return 0, err
+	}
+	if err := binutils.WriteInt64(c.writer, c.aux, v); err != nil {
+		return 0, err
+	}
+	if err := binutils.WriteInt64(c.writer, c.aux, int64(code)); err != nil {
+		return 0, err
+	}
+	if err := c.writer.Flush(); err != nil {
+		return 0, err
+	}
+
+	reply, err := c.reader.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	switch reply {
+	case validateReplyOK:
+		return binutils.ReadInt64(c.reader, c.aux)
+	case validateReplyError:
+		appErr, err := binutils.ReadAppError(c.reader, c.aux)
+		if err != nil {
+			return 0, err
+		}
+		return 0, appErr
+	default:
+		return 0, fmt.Errorf("unknown validate reply %d", reply)
+	}
+}
+
+func (c *tcpClient) Slow(ctx context.Context, delay time.Duration) (err error) {
+	if err := c.begin(ctx); err != nil {
+		return err
+	}
+	defer func() { err = c.end(err) }()
+
+	if err := c.writer.WriteByte(cmdSlow); err != nil {
+		return err
+	}
+	if err := binutils.WriteInt64(c.writer, c.aux, int64(delay)); err != nil {
+		return err
+	}
+	if err := binutils.WriteInt64(c.writer, c.aux, int64(rpcbench.RemainingTimeout(ctx))); err != nil {
+		return err
+	}
+	if err := c.writer.Flush(); err != nil {
+		return err
+	}
+
+	reply, err := c.reader.ReadByte()
+	if err != nil {
+		return err
+	}
+	switch reply {
+	case slowReplyOK:
+		return nil
+	case slowReplyCanceled:
+		// The server only cancels calls when their timeout expires.
+		return context.DeadlineExceeded
+	default:
+		return fmt.Errorf("unknown slow reply %d", reply)
+	}
+}
+
+func (c *tcpClient) SlowCalls(ctx context.Context) (started, canceled int64, err error) {
+	if err := c.begin(ctx); err != nil {
+		return 0, 0, err
+	}
+	defer func() { err = c.end(err) }()
+
+	if err := c.writer.WriteByte(cmdSlowCalls); err != nil {
+		return 0, 0, err
+	}
+	if err := c.writer.Flush(); err != nil {
+		return 0, 0, err
+	}
+	if started, err = binutils.ReadInt64(c.reader, c.aux); err != nil {
+		return 0, 0, err
+	}
+	if canceled, err = binutils.ReadInt64(c.reader, c.aux); err != nil {
+		return 0, 0, err
+	}
+	return started, canceled, nil
+}
+
+func (c *tcpClient) WorkWithNops(ctx context.Context, work rpcbench.Work, nops int, onNop func(i int)) (err error) {
+	if err := c.begin(ctx); err != nil {
+		return err
+	}
+	defer func() { err = c.end(err) }()
+
+	// Every call is sent at once, and the replies are received in the
+	// same order.
+	if err := c.writer.WriteByte(cmdWork); err != nil {
+		return err
+	}
+	if err := binutils.WriteWork(c.writer, c.aux, work); err != nil {
+		return err
+	}
+	for range nops {
+		if err := c.writer.WriteByte(cmdNop); err != nil {
+			return err
+		}
+	}
+	if err := c.writer.Flush(); err != nil {
+		return err
+	}
+
+	if _, err := c.reader.ReadByte(); err != nil {
+		return err
+	}
+	for i := range nops {
+		if _, err := c.reader.ReadByte(); err != nil {
+			return err
+		}
+		onNop(i)
+	}
+	return nil
+}
+
+func (c *tcpClient) Echo(ctx context.Context, in, out []byte) (err error) {
+	if err := c.begin(ctx); err != nil {
+		return err
+	}
+	defer func() { err = c.end(err) }()
+
+	if err := c.writer.WriteByte(cmdLarge); err != nil {
+		return err
+	}
+	if err := binutils.WriteInt64(c.writer, c.aux, int64(len(in))); err != nil {
+		return err
+	}
+	if _, err := c.writer.Write(in); err != nil {
+		return err
+	}
+	if err := c.writer.Flush(); err != nil {
+		return err
+	}
+	_, err = io.ReadFull(c.reader, out)
+	return err
+}
+
+func (c *tcpClient) UpperRecords(ctx context.Context, in, out []rpcbench.Record) (err error) {
+	if err := c.begin(ctx); err != nil {
+		return err
+	}
+	defer func() { err = c.end(err) }()
+
+	if err := c.writer.WriteByte(cmdRecords); err != nil {
+		return err
+	}
+	if err := binutils.WriteRecords(c.writer, c.aux, in); err != nil {
+		return err
+	}
+	if err := c.writer.Flush(); err != nil {
+		return err
+	}
+	res, err := binutils.ReadRecords(c.reader, c.aux, out)
 	if err != nil {
+		return err
+	}
+	if len(res) != len(out) {
+		return fmt.Errorf("unexpected number of records %d", len(res))
+	}
+	return nil
+}
+
+func (c *tcpClient) UpdateRows(ctx context.Context, in, out []rpcbench.Row) (err error) {
+	if err := c.begin(ctx); err != nil {
+		return err
+	}
+	defer func() { err = c.end(err) }()
+
+	if err := c.writer.WriteByte(cmdRows); err != nil {
+		return err
+	}
+	if err := binutils.WriteRows(c.writer, c.aux, in); err != nil {
+		return err
+	}
+	if err := c.writer.Flush(); err != nil {
+		return err
+	}
+	res, err := binutils.ReadRows(c.reader, c.aux, out)
+	if err != nil {
+		return err
+	}
+	if len(res) != len(out) {
+		return fmt.Errorf("unexpected number of rows %d", len(res))
+	}
+	return nil
+}
+
+func (c *tcpClient) IncrementMap(ctx context.Context, in, out map[string]int64) (err error) {
+	if err := c.begin(ctx); err != nil {
+		return err
+	}
+	defer func() { err = c.end(err) }()
+
+	if err := c.writer.WriteByte(cmdMap); err != nil {
+		return err
+	}
+	if err := binutils.WriteMap(c.writer, c.aux, in); err != nil {
+		return err
+	}
+	if err := c.writer.Flush(); err != nil {
+		return err
+	}
+	return binutils.ReadMap(c.reader, c.aux, out)
+}
+
+func (c *tcpClient) ScaleVector(ctx context.Context, in []float64, scale float64, out []float64) (err error) {
+	if err := c.begin(ctx); err != nil {
+		return err
+	}
+	defer func() { err = c.end(err) }()
+
+	if err := c.writer.WriteByte(cmdVector); err != nil {
+		return err
+	}
+	if err := binutils.WriteFloat64(c.writer, c.aux, scale); err != nil {
+		return err
+	}
+	if err := binutils.WriteFloat64s(c.writer, in); err != nil {
+		return err
+	}
+	if err := c.writer.Flush(); err != nil {
+		return err
+	}
+	res, err := binutils.ReadFloat64s(c.reader, c.aux, out)
+	if err != nil {
+		return err
+	}
+	if len(res) != len(out) {
+		return fmt.Errorf("unexpected vector size %d", len(res))
+	}
+	return nil
+}
+
+func (c *tcpClient) NopWithMetadata(ctx context.Context, md []rpcbench.MetadataEntry) (_ string, err error) {
+	if err := c.begin(ctx); err != nil {
+		return "", err
+	}
+	defer func() { err = c.end(err) }()
+
+	if err := c.writer.WriteByte(cmdMetadata); err != nil {
+		return "", err
+	}
+	if err := binutils.WriteMetadata(c.writer, c.aux, md); err != nil {
+		return "", err
+	}
+	if err := c.writer.Flush(); err != nil {
+		return "", err
+	}
+
+	reply, err := c.reader.ReadByte()
+	if err != nil {
+		return "", err
+	}
+	switch reply {
+	case validateReplyOK:
+		return binutils.ReadString(c.reader, c.aux)
+	case validateReplyError:
+		appErr, err := binutils.ReadAppError(c.reader, c.aux)
+		if err != nil {
+			return "", err
+		}
+		return "", appErr
+	default:
+		return "", fmt.Errorf("unknown metadata reply %d", reply)
+	}
+}
+
+// tcpBidiStream is a bidi stream that takes over the connection of its client
+// until it is closed. Each direction has its own aux buffer, given Send and Recv
+// are called concurrently.
+//
+// The stream is a call that lasts until it is closed, so its I/O honors the
+// context it was opened with. The call ends once the stream is closed or fails
+// in either direction.
+type tcpBidiStream struct {
+	c       *tcpClient
+	sendAux []byte
+	recvAux []byte
+	endOnce sync.Once
+}
+
+// end ends the call of the stream, given the error that ended it.
+func (s *tcpBidiStream) end(err error) error {
+	s.endOnce.Do(func() { err = s.c.end(err) })
+	return err
+}
+
+func (s *tcpBidiStream) Send(v int64) error {
+	err := s.c.writer.WriteByte(bidiFrameMsg)
+	if err == nil {
+		err = binutils.WriteInt64(s.c.writer, s.sendAux, v)
+	}
+	if err == nil {
+		err = s.c.writer.Flush()
+	}
+	if err != nil {
+		return s.end(err)
+	}
+	return nil
+}
+
+func (s *tcpBidiStream) Recv() (int64, error) {
+	v, err := binutils.ReadInt64(s.c.reader, s.recvAux)
+	if err != nil {
+		return 0, s.end(err)
+	}
+	return v, nil
+}
+
+func (s *tcpBidiStream) Close() error {
+	err := s.c.writer.WriteByte(bidiFrameEnd)
+	if err == nil {
+		err = s.c.writer.Flush()
+	}
+	return s.end(err)
+}
+
+func (c *tcpClient) OpenBidiStream(ctx context.Context) (rpcbench.BidiStream, error) {
+	if err := c.begin(ctx); err != nil {
 		return nil, err
 	}
-	go func() {
-		<-ctx.Done()
-		c.Close()
-	}()
-	return &tcpClient{
-		c:      c,
-		reader: bufio.NewReaderSize(c, rpcbench.MaxHexEncodeSize*2),
-		writer: bufio.NewWriterSize(c, rpcbench.MaxHexEncodeSize*2),
-		aux:    make([]byte, 8),
+
+	// The command is flushed along with the first frame.
+	if err := c.writer.WriteByte(cmdBidiStream); err != nil {
+		return nil, c.end(err)
+	}
+	return &tcpBidiStream{
+		c:       c,
+		sendAux: make([]byte, 8),
+		recvAux: make([]byte, 8),
 	}, nil
 }
+
+// Close closes the conn of the client.
+func (c *tcpClient) Close() error {
+	if c.broken {
+		return nil
+	}
+	c.stopClose()
+	c.broken = true
+	return c.c.Close()
+}
+
+func newTCPClient(ctx context.Context, cfg rpcbench.ClientConfig) (*tcpClient, error) {
+	c := &tcpClient{
+		reader: bufio.NewReaderSize(nil, rpcbench.MaxHexEncodeSize*2),
+		writer: bufio.NewWriterSize(nil, rpcbench.MaxHexEncodeSize*2),
+		aux:    make([]byte, 8),
+		ctx:    ctx,
+		cfg:    cfg,
+	}
+
+	// Try to connect.
+	if err := c.connect(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
@@ -17,8 +17,8 @@ func (f tcpFactory) NewServer(l net.Listener) (rpcbench.Server, error) {
 	return newTCPServer(l), nil
 }
 
-func (f tcpFactory) NewClient(ctx context.Context, addr string) (rpcbench.Client, error) {
-	return newTCPClient(ctx, addr)
+func (f tcpFactory) NewClient(ctx context.Context, cfg rpcbench.ClientConfig) (rpcbench.Client, error) {
+	return newTCPClient(ctx, cfg)
 }
 
 func TCPFactoryIniter() rpcbench.RPCFactory {
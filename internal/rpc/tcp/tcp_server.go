@@ -10,11 +10,14 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"hash/crc32"
 	"io"
 	"log"
 	"net"
+	"time"
 
 	"github.com/matheusd/gorpcbench/internal/binutils"
+	"github.com/matheusd/gorpcbench/internal/netutils"
 	"github.com/matheusd/gorpcbench/rpcbench"
 	"github.com/sourcegraph/conc/pool"
 )
@@ -22,6 +25,7 @@ import (
 type tcpServer struct {
 	l       net.Listener
 	skipLog bool
+	slow    rpcbench.SlowHandler
 }
 
 func (s *tcpServer) runConn(ctx context.Context, c net.Conn) error {
@@ -34,6 +38,15 @@ func (s *tcpServer) runConn(ctx context.Context, c net.Conn) error {
 	readHexBuf := make([]byte, rpcbench.MaxHexEncodeSize)
 	hexEnc := hex.NewEncoder(writer)
 
+	// largeBuf is only allocated once the conn gets a cmdLarge call.
+	var largeBuf []byte
+	var records []rpcbench.Record
+	var rows []rpcbench.Row
+	counters := make(map[string]int64)
+	var vector []float64
+	var md []rpcbench.MetadataEntry
+	getMetadata := func(key string) string { return rpcbench.MetadataValue(md, key) }
+
 	for ctx.Err() == nil {
 		cmd, err := reader.ReadByte()
 		if err != nil {
@@ -93,6 +106,229 @@ func (s *tcpServer) runConn(ctx context.Context, c net.Conn) error {
 				size -= int64(n)
 			}
 
+		case cmdServerStream:
+			var start, count int64
+			if start, err = binutils.ReadInt64(reader, aux); err != nil {
+				return err
+			}
+			if count, err = binutils.ReadInt64(reader, aux); err != nil {
+				return err
+			}
+
+			// Flush every item, as if each one was produced
+			// individually.
+			for i := range count {
+				if err = binutils.WriteInt64(writer, aux, start+i); err != nil {
+					return err
+				}
+				if err = writer.Flush(); err != nil {
+					return err
+				}
+			}
+
+		case cmdClientStream:
+			var sum uint32
+			for {
+				var size int64
+				if size, err = binutils.ReadInt64(reader, aux); err != nil {
+					return err
+				}
+				if size == 0 {
+					break
+				}
+				if size > int64(len(readHexBuf)) {
+					return fmt.Errorf("chunk size %d too large", size)
+				}
+
+				chunk := readHexBuf[:size]
+				if _, err = io.ReadFull(reader, chunk); err != nil {
+					return err
+				}
+				sum = crc32.Update(sum, crc32.IEEETable, chunk)
+			}
+			err = binutils.WriteInt64(writer, aux, int64(sum))
+
+		case cmdBidiStream:
+			for {
+				var frame byte
+				if frame, err = reader.ReadByte(); err != nil {
+					return err
+				}
+				if frame == bidiFrameEnd {
+					break
+				}
+
+				var v int64
+				if v, err = binutils.ReadInt64(reader, aux); err != nil {
+					return err
+				}
+				if err = binutils.WriteInt64(writer, aux, v); err != nil {
+					return err
+				}
+				if err = writer.Flush(); err != nil {
+					return err
+				}
+			}
+
+		case cmdCallback:
+			var start, count int64
+			if start, err = binutils.ReadInt64(reader, aux); err != nil {
+				return err
+			}
+			if count, err = binutils.ReadInt64(reader, aux); err != nil {
+				return err
+			}
+
+			var sum int64
+			for i := range count {
+				if err = writer.WriteByte(callbackFrameCall); err != nil {
+					return err
+				}
+				if err = binutils.WriteInt64(writer, aux, start+i); err != nil {
+					return err
+				}
+				if err = writer.Flush(); err != nil {
+					return err
+				}
+
+				var res int64
+				if res, err = binutils.ReadInt64(reader, aux); err != nil {
+					return err
+				}
+				sum += res
+			}
+			if err = writer.WriteByte(callbackFrameReturn); err != nil {
+				return err
+			}
+			err = binutils.WriteInt64(writer, aux, sum)
+
+		case cmdValidate:
+			var v, code int64
+			if v, err = binutils.ReadInt64(reader, aux); err != nil {
+				return err
+			}
+			if code, err = binutils.ReadInt64(reader, aux); err != nil {
+				return err
+			}
+
+			if code != 0 {
+				if err = writer.WriteByte(validateReplyError); err != nil {
+					return err
+				}
+				err = binutils.WriteAppError(writer, aux, rpcbench.RejectValue(rpcbench.AppErrorCode(code), v))
+				break
+			}
+			if err = writer.WriteByte(validateReplyOK); err != nil {
+				return err
+			}
+			err = binutils.WriteInt64(writer, aux, v)
+
+		case cmdSlow:
+			var delay, timeout int64
+			if delay, err = binutils.ReadInt64(reader, aux); err != nil {
+				return err
+			}
+			if timeout, err = binutils.ReadInt64(reader, aux); err != nil {
+				return err
+			}
+
+			reply := slowReplyOK
+			if s.slow.HandleTimeout(ctx, time.Duration(delay), time.Duration(timeout)) != nil {
+				reply = slowReplyCanceled
+			}
+			err = writer.WriteByte(reply)
+
+		case cmdSlowCalls:
+			started, canceled := s.slow.Counts()
+			if err = binutils.WriteInt64(writer, aux, started); err != nil {
+				return err
+			}
+			err = binutils.WriteInt64(writer, aux, canceled)
+
+		case cmdWork:
+			var work rpcbench.Work
+			if work, err = binutils.ReadWork(reader, aux); err != nil {
+				return err
+			}
+			work.Do()
+			err = writer.WriteByte(cmdWork)
+
+		case cmdLarge:
+			var size int64
+			if size, err = binutils.ReadInt64(reader, aux); err != nil {
+				return err
+			}
+			if size < 0 || size > int64(rpcbench.MaxMessageSize()) {
+				return fmt.Errorf("payload size %d out of bounds", size)
+			}
+
+			// The whole payload is read before replying, as the
+			// client only reads the reply after sending it.
+			if int64(cap(largeBuf)) < size {
+				largeBuf = make([]byte, size)
+			}
+			payload := largeBuf[:size]
+			if _, err = io.ReadFull(reader, payload); err != nil {
+				return err
+			}
+			_, err = writer.Write(payload)
+
+		case cmdRecords:
+			if records, err = binutils.ReadRecords(reader, aux, records); err != nil {
+				return err
+			}
+			for i := range records {
+				records[i].Name = rpcbench.UpperName(records[i].Name)
+			}
+			err = binutils.WriteRecords(writer, aux, records)
+
+		case cmdRows:
+			if rows, err = binutils.ReadRows(reader, aux, rows); err != nil {
+				return err
+			}
+			for i := range rows {
+				rows[i].Version++
+			}
+			err = binutils.WriteRows(writer, aux, rows)
+
+		case cmdMap:
+			if err = binutils.ReadMap(reader, aux, counters); err != nil {
+				return err
+			}
+			for k := range counters {
+				counters[k]++
+			}
+			err = binutils.WriteMap(writer, aux, counters)
+
+		case cmdVector:
+			var scale float64
+			if scale, err = binutils.ReadFloat64(reader, aux); err != nil {
+				return err
+			}
+			if vector, err = binutils.ReadFloat64s(reader, aux, vector); err != nil {
+				return err
+			}
+			for i := range vector {
+				vector[i] *= scale
+			}
+			err = binutils.WriteFloat64s(writer, vector)
+
+		case cmdMetadata:
+			if md, err = binutils.ReadMetadata(reader, aux, md); err != nil {
+				return err
+			}
+			echo, appErr := rpcbench.CheckMetadata(getMetadata)
+			if appErr != nil {
+				if err = writer.WriteByte(validateReplyError); err != nil {
+					return err
+				}
+				err = binutils.WriteAppError(writer, aux, appErr)
+				break
+			}
+			if err = writer.WriteByte(validateReplyOK); err != nil {
+				return err
+			}
+			err = binutils.WriteString(writer, aux, echo)
 		}
 
 		if err := writer.Flush(); err != nil {
@@ -133,7 +369,17 @@ func (s *tcpServer) Run(ctx context.Context) error {
 			if !s.skipLog {
 				log.Printf("Accepted connection from %s", c.RemoteAddr())
 			}
-			connPool.Go(func(ctx context.Context) error { return s.runConn(ctx, c) })
+			connPool.Go(func(ctx context.Context) error {
+				defer c.Close()
+				err := s.runConn(ctx, c)
+				if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || netutils.IsConnClosedErr(err) {
+					// Clients close their conn when a call is
+					// interrupted, which should not stop the
+					// server.
+					return nil
+				}
+				return err
+			})
 		}
 
 		waitErr := connPool.Wait()
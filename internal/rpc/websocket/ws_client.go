@@ -7,14 +7,19 @@ package websocket
 import (
 	"bufio"
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net"
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/matheusd/gorpcbench/internal/binutils"
 	"github.com/matheusd/gorpcbench/internal/jsonutils"
+	"github.com/matheusd/gorpcbench/internal/netutils"
 	"github.com/matheusd/gorpcbench/rpcbench"
 )
 
@@ -29,9 +34,69 @@ type wsClient struct {
 	isJson bool
 	msg    jsonutils.Message
 	outMsg jsonutils.OutMessage
+
+	// ctx and dial are used to reconnect to the server, after a call is
+	// interrupted by its context and leaves the conn in an unknown state.
+	ctx       context.Context
+	dial      func(context.Context) (*websocket.Conn, error)
+	stopClose func() bool
+	broken    bool
+	watch     netutils.CallWatch
+}
+
+// connect connects to the server, replacing the current conn (if any).
+func (c *wsClient) connect() error {
+	conn, err := c.dial(c.ctx)
+	if err != nil {
+		return err
+	}
+	c.conn = conn
+	c.stopClose = context.AfterFunc(c.ctx, func() { conn.Close() })
+	c.broken = false
+	return nil
+}
+
+// begin starts a call that honors ctx. Every call that is started must be
+// ended by calling end.
+func (c *wsClient) begin(ctx context.Context) error {
+	if c.broken {
+		if err := c.connect(); err != nil {
+			return err
+		}
+	}
+	c.watch.Start(ctx, c.conn)
+	return nil
+}
+
+// end ends a call that returned err.
+func (c *wsClient) end(err error) error {
+	// Errors replied by the server leave the conn in a known state.
+	ioErr := err
+	var appErr *rpcbench.AppError
+	if errors.As(err, &appErr) || errors.Is(err, context.DeadlineExceeded) {
+		ioErr = nil
+	}
+
+	// Websocket conns cannot be used after an I/O error, so the next call
+	// needs a new conn.
+	reuse, ioErr := c.watch.End(ioErr)
+	if !reuse {
+		c.stopClose()
+		netutils.CloseBroken(c.conn.UnderlyingConn())
+		c.broken = true
+	}
+	if ioErr != nil {
+		return ioErr
+	}
+	return err
 }
 
-func (c *wsClient) Nop(ctx context.Context) error {
+func (c *wsClient) Nop(ctx context.Context) (err error) {
+	if err := c.begin(ctx); err != nil {
+		return err
+	}
+	defer func() { err = c.end(err) }()
+
 	if c.isJson {
 		c.outMsg.Command = jsonutils.CmdNop
 		c.outMsg.Payload = nil
@@ -72,7 +137,12 @@ func (c *wsClient) Nop(ctx context.Context) error {
 	return err
 }
 
-func (c *wsClient) Add(ctx context.Context, a int64, b int64) (int64, error) {
+func (c *wsClient) Add(ctx context.Context, a int64, b int64) (_ int64, err error) {
+	if err := c.begin(ctx); err != nil {
+		return 0, err
+	}
+	defer func() { err = c.end(err) }()
+
 	if c.isJson {
 		c.outMsg.Command = jsonutils.CmdAdd
 		c.outMsg.Payload = jsonutils.AddRequest{A: a, B: b}
@@ -119,7 +189,12 @@ func (c *wsClient) Add(ctx context.Context, a int64, b int64) (int64, error) {
 	return binutils.ReadInt64(c.reader, c.aux)
 }
 
-func (c *wsClient) MultTreeValues(ctx context.Context, mult int64, fillArgs func(rpcbench.TreeNode)) (rpcbench.TreeNode, error) {
+func (c *wsClient) MultTreeValues(ctx context.Context, mult int64, fillArgs func(rpcbench.TreeNode)) (_ rpcbench.TreeNode, err error) {
+	if err := c.begin(ctx); err != nil {
+		return nil, err
+	}
+	defer func() { err = c.end(err) }()
+
 	tree := &c.tree
 	tree.Reset()
 	fillArgs(tree)
@@ -172,7 +247,12 @@ func (c *wsClient) MultTreeValues(ctx context.Context, mult int64, fillArgs func
 	return tree, nil
 }
 
-func (c *wsClient) ToHex(ctx context.Context, in, out []byte) error {
+func (c *wsClient) ToHex(ctx context.Context, in, out []byte) (err error) {
+	if err := c.begin(ctx); err != nil {
+		return err
+	}
+	defer func() { err = c.end(err) }()
+
 	if c.isJson {
 		c.outMsg.Command = jsonutils.CmdToHex
 		c.outMsg.Payload = in
@@ -222,28 +302,1005 @@ func (c *wsClient) ToHex(ctx context.Context, in, out []byte) error {
 	return err
 }
 
-func (c *wsClient) Run(ctx context.Context) error {
-	<-ctx.Done()
-	return c.conn.Close()
+func (c *wsClient) ServerStream(ctx context.Context, start int64, count int, onItem func(int64) error) (err error) {
+	if err := c.begin(ctx); err != nil {
+		return err
+	}
+	defer func() { err = c.end(err) }()
+
+	if c.isJson {
+		c.outMsg.Command = jsonutils.CmdServerStream
+		c.outMsg.Payload = jsonutils.ServerStreamRequest{Start: start, Count: count}
+		if err := c.conn.WriteJSON(c.outMsg); err != nil {
+			return fmt.Errorf("unable to write JSON server stream: %v", err)
+		}
+
+		var item jsonutils.StreamItem
+		for range count {
+			if err := c.conn.ReadJSON(&item); err != nil {
+				return fmt.Errorf("unable to read JSON stream item: %v", err)
+			}
+			if err := onItem(item.Value); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	rawWriter, err := c.conn.NextWriter(websocket.BinaryMessage)
+	if err != nil {
+		return err
+	}
+	c.writer.Reset(rawWriter)
+
+	if err := c.writer.WriteByte(cmdServerStream); err != nil {
+		return err
+	}
+	if err := binutils.WriteInt64(c.writer, c.aux, start); err != nil {
+		return err
+	}
+	if err := binutils.WriteInt64(c.writer, c.aux, int64(count)); err != nil {
+		return err
+	}
+	if err := c.writer.Flush(); err != nil {
+		return err
+	}
+	if err := rawWriter.Close(); err != nil {
+		return err
+	}
+
+	for range count {
+		_, rawReader, err := c.conn.NextReader()
+		if err != nil {
+			return err
+		}
+		c.reader.Reset(rawReader)
+
+		v, err := binutils.ReadInt64(c.reader, c.aux)
+		if err != nil {
+			return err
+		}
+		if err := onItem(v); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func newWSClient(ctx context.Context, addr string, isJson bool) (*wsClient, error) {
-	url := "ws://" + addr
-	var header http.Header
-	if isJson {
-		header = make(http.Header)
-		header.Add("Content-Type", "text/json")
+func (c *wsClient) Callback(ctx context.Context, start int64, count int, onCall func(int64) (int64, error)) (_ int64, err error) {
+	if err := c.begin(ctx); err != nil {
+		return 0, err
 	}
-	conn, _, err := websocket.DefaultDialer.DialContext(ctx, url, header)
+	defer func() { err = c.end(err) }()
+
+	if c.isJson {
+		c.outMsg.Command = jsonutils.CmdCallback
+		c.outMsg.Payload = jsonutils.CallbackRequest{Start: start, Count: count}
+		if err := c.conn.WriteJSON(c.outMsg); err != nil {
+			return 0, fmt.Errorf("unable to write JSON callback: %v", err)
+		}
+
+		var msg jsonutils.CallbackMessage
+		for {
+			if err := c.conn.ReadJSON(&msg); err != nil {
+				return 0, fmt.Errorf("unable to read JSON callback message: %v", err)
+			}
+			if msg.Return {
+				return msg.Value, nil
+			}
+			res, err := onCall(msg.Value)
+			if err != nil {
+				return 0, err
+			}
+			msg.Value = res
+			if err := c.conn.WriteJSON(msg); err != nil {
+				return 0, fmt.Errorf("unable to write JSON callback reply: %v", err)
+			}
+		}
+	}
+
+	rawWriter, err := c.conn.NextWriter(websocket.BinaryMessage)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
+	c.writer.Reset(rawWriter)
 
-	return &wsClient{
-		conn:   conn,
-		aux:    make([]byte, 8),
-		reader: bufio.NewReaderSize(nil, rpcbench.MaxHexEncodeSize*2),
-		writer: bufio.NewWriterSize(nil, rpcbench.MaxHexEncodeSize*2),
-		isJson: isJson,
-	}, nil
+	if err := c.writer.WriteByte(cmdCallback); err != nil {
+		return 0, err
+	}
+	if err := binutils.WriteInt64(c.writer, c.aux, start); err != nil {
+		return 0, err
+	}
+	if err := binutils.WriteInt64(c.writer, c.aux, int64(count)); err != nil {
+		return 0, err
+	}
+	if err := c.writer.Flush(); err != nil {
+		return 0, err
+	}
+	if err := rawWriter.Close(); err != nil {
+		return 0, err
+	}
+
+	for {
+		_, rawReader, err := c.conn.NextReader()
+		if err != nil {
+			return 0, err
+		}
+		c.reader.Reset(rawReader)
+
+		frame, err := c.reader.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		v, err := binutils.ReadInt64(c.reader, c.aux)
+		if err != nil {
+			return 0, err
+		}
+		switch frame {
+		case callbackFrameReturn:
+			return v, nil
+		case callbackFrameCall:
+		default:
+			return 0, fmt.Errorf("unknown callback frame %d", frame)
+		}
+
+		res, err := onCall(v)
+		if err != nil {
+			return 0, err
+		}
+		rawWriter, err := c.conn.NextWriter(websocket.BinaryMessage)
+		if err != nil {
+			return 0, err
+		}
+		if err := binutils.WriteInt64(rawWriter, c.aux, res); err != nil {
+			return 0, err
+		}
+		if err := rawWriter.Close(); err != nil {
+			return 0, err
+		}
+	}
+}
+
+func (c *wsClient) Validate(ctx context.Context, v int64, code rpcbench.AppErrorCode) (_ int64, err error) {
+	if err := c.begin(ctx); err != nil {
+		return 0, err
+	}
+	defer func() { err = c.end(err) }()
+
+	if c.isJson {
+		c.outMsg.Command = jsonutils.CmdValidate
+		c.outMsg.Payload = jsonutils.ValidateRequest{Value: v, Code: code}
+		if err := c.conn.WriteJSON(c.outMsg); err != nil {
+			return 0, fmt.Errorf("unable to write JSON validate: %v", err)
+		}
+
+		var res jsonutils.ValidateResponse
+		if err := c.conn.ReadJSON(&res); err != nil {
+			return 0, fmt.Errorf("unable to read JSON validate: %v", err)
+		}
+		if res.Error != nil {
+			return 0, res.Error.AppError()
+		}
+		return res.Value, nil
+	}
+
+	rawWriter, err := c.conn.NextWriter(websocket.BinaryMessage)
+	if err != nil {
+		return 0, err
+	}
+	c.writer.Reset(rawWriter)
+
+	if err := c.writer.WriteByte(cmdValidate); err != nil {
+		return 0, err
+	}
+	if err := binutils.WriteInt64(c.writer, c.aux, v); err != nil {
+		return 0, err
+	}
+	if err := binutils.WriteInt64(c.writer, c.aux, int64(code)); err != nil {
+		return 0, err
+	}
+	if err := c.writer.Flush(); err != nil {
+		return 0, err
+	}
+	if err := rawWriter.Close(); err != nil {
+		return 0, err
+	}
+
+	_, rawReader, err := c.conn.NextReader()
+	if err != nil {
+		return 0, err
+	}
+	c.reader.Reset(rawReader)
+
+	reply, err := c.reader.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	switch reply {
+	case validateReplyOK:
+		return binutils.ReadInt64(c.reader, c.aux)
+	case validateReplyError:
+		appErr, err := binutils.ReadAppError(c.reader, c.aux)
+		if err != nil {
+			return 0, err
+		}
+		return 0, appErr
+	default:
+		return 0, fmt.Errorf("unknown validate reply %d", reply)
+	}
+}
+
+func (c *wsClient) ClientStream(ctx context.Context, chunks [][]byte) (_ uint32, err error) {
+	if err := c.begin(ctx); err != nil {
+		return 0, err
+	}
+	defer func() { err = c.end(err) }()
+
+	if c.isJson {
+		c.outMsg.Command = jsonutils.CmdClientStream
+		c.outMsg.Payload = nil
+		if err := c.conn.WriteJSON(c.outMsg); err != nil {
+			return 0, fmt.Errorf("unable to write JSON client stream: %v", err)
+		}
+
+		var chunk jsonutils.StreamChunk
+		for _, chunk.Data = range chunks {
+			if err := c.conn.WriteJSON(chunk); err != nil {
+				return 0, fmt.Errorf("unable to write JSON stream chunk: %v", err)
+			}
+		}
+		chunk.Data = nil
+		if err := c.conn.WriteJSON(chunk); err != nil {
+			return 0, fmt.Errorf("unable to write JSON stream end: %v", err)
+		}
+
+		var res jsonutils.ClientStreamResponse
+		if err := c.conn.ReadJSON(&res); err != nil {
+			return 0, fmt.Errorf("unable to read JSON client stream: %v", err)
+		}
+		return res.Checksum, nil
+	}
+
+	if err := c.conn.WriteMessage(websocket.BinaryMessage, []byte{cmdClientStream}); err != nil {
+		return 0, err
+	}
+	for _, chunk := range chunks {
+		if err := c.conn.WriteMessage(websocket.BinaryMessage, chunk); err != nil {
+			return 0, err
+		}
+	}
+	if err := c.conn.WriteMessage(websocket.BinaryMessage, nil); err != nil {
+		return 0, err
+	}
+
+	_, rawReader, err := c.conn.NextReader()
+	if err != nil {
+		return 0, err
+	}
+	c.reader.Reset(rawReader)
+
+	sum, err := binutils.ReadInt64(c.reader, c.aux)
+	return uint32(sum), err
+}
+
+func (c *wsClient) Slow(ctx context.Context, delay time.Duration) (err error) {
+	if err := c.begin(ctx); err != nil {
+		return err
+	}
+	defer func() { err = c.end(err) }()
+
+	timeout := rpcbench.RemainingTimeout(ctx)
+	if c.isJson {
+		c.outMsg.Command = jsonutils.CmdSlow
+		c.outMsg.Payload = jsonutils.SlowRequest{Delay: delay, Timeout: timeout}
+		if err := c.conn.WriteJSON(c.outMsg); err != nil {
+			return fmt.Errorf("unable to write JSON slow: %v", err)
+		}
+
+		var res jsonutils.SlowResponse
+		if err := c.conn.ReadJSON(&res); err != nil {
+			return fmt.Errorf("unable to read JSON slow: %v", err)
+		}
+		if res.Canceled {
+			// The server only cancels calls when their timeout
+			// expires.
+			return context.DeadlineExceeded
+		}
+		return nil
+	}
+
+	rawWriter, err := c.conn.NextWriter(websocket.BinaryMessage)
+	if err != nil {
+		return err
+	}
+	c.writer.Reset(rawWriter)
+
+	if err := c.writer.WriteByte(cmdSlow); err != nil {
+		return err
+	}
+	if err := binutils.WriteInt64(c.writer, c.aux, int64(delay)); err != nil {
+		return err
+	}
+	if err := binutils.WriteInt64(c.writer, c.aux, int64(timeout)); err != nil {
+		return err
+	}
+	if err := c.writer.Flush(); err != nil {
+		return err
+	}
+	if err := rawWriter.Close(); err != nil {
+		return err
+	}
+
+	_, rawReader, err := c.conn.NextReader()
+	if err != nil {
+		return err
+	}
+	c.reader.Reset(rawReader)
+
+	reply, err := c.reader.ReadByte()
+	if err != nil {
+		return err
+	}
+	switch reply {
+	case slowReplyOK:
+		return nil
+	case slowReplyCanceled:
+		// The server only cancels calls when their timeout expires.
+		return context.DeadlineExceeded
+	default:
+		return fmt.Errorf("unknown slow reply %d", reply)
+	}
+}
+
+func (c *wsClient) SlowCalls(ctx context.Context) (started, canceled int64, err error) {
+	if err := c.begin(ctx); err != nil {
+		return 0, 0, err
+	}
+	defer func() { err = c.end(err) }()
+
+	if c.isJson {
+		c.outMsg.Command = jsonutils.CmdSlowCalls
+		c.outMsg.Payload = nil
+		if err := c.conn.WriteJSON(c.outMsg); err != nil {
+			return 0, 0, fmt.Errorf("unable to write JSON slow calls: %v", err)
+		}
+
+		var res jsonutils.SlowCallsResponse
+		if err := c.conn.ReadJSON(&res); err != nil {
+			return 0, 0, fmt.Errorf("unable to read JSON slow calls: %v", err)
+		}
+		return res.Started, res.Canceled, nil
+	}
+
+	if err := c.conn.WriteMessage(websocket.BinaryMessage, []byte{cmdSlowCalls}); err != nil {
+		return 0, 0, err
+	}
+	_, rawReader, err := c.conn.NextReader()
+	if err != nil {
+		return 0, 0, err
+	}
+	c.reader.Reset(rawReader)
+
+	if started, err = binutils.ReadInt64(c.reader, c.aux); err != nil {
+		return 0, 0, err
+	}
+	if canceled, err = binutils.ReadInt64(c.reader, c.aux); err != nil {
+		return 0, 0, err
+	}
+	return started, canceled, nil
+}
+
+func (c *wsClient) WorkWithNops(ctx context.Context, work rpcbench.Work, nops int, onNop func(i int)) (err error) {
+	if err := c.begin(ctx); err != nil {
+		return err
+	}
+	defer func() { err = c.end(err) }()
+
+	// Every call is sent at once, and the replies are received in the
+	// same order.
+	if c.isJson {
+		c.outMsg.Command = jsonutils.CmdWork
+		c.outMsg.Payload = jsonutils.WorkRequest{Duration: work.Duration, CPU: work.CPU}
+		if err := c.conn.WriteJSON(c.outMsg); err != nil {
+			return fmt.Errorf("unable to write JSON work: %v", err)
+		}
+		c.outMsg.Command = jsonutils.CmdNop
+		c.outMsg.Payload = nil
+		for range nops {
+			if err := c.conn.WriteJSON(c.outMsg); err != nil {
+				return fmt.Errorf("unable to write JSON nop: %v", err)
+			}
+		}
+
+		if err := c.conn.ReadJSON(&c.msg); err != nil {
+			return fmt.Errorf("unable to read JSON work: %v", err)
+		}
+		for i := range nops {
+			if err := c.conn.ReadJSON(&c.msg); err != nil {
+				return fmt.Errorf("unable to read JSON nop: %v", err)
+			}
+			onNop(i)
+		}
+		return nil
+	}
+
+	rawWriter, err := c.conn.NextWriter(websocket.BinaryMessage)
+	if err != nil {
+		return err
+	}
+	c.writer.Reset(rawWriter)
+
+	if err := c.writer.WriteByte(cmdWork); err != nil {
+		return err
+	}
+	if err := binutils.WriteWork(c.writer, c.aux, work); err != nil {
+		return err
+	}
+	if err := c.writer.Flush(); err != nil {
+		return err
+	}
+	if err := rawWriter.Close(); err != nil {
+		return err
+	}
+	for range nops {
+		if err := c.conn.WriteMessage(websocket.BinaryMessage, []byte{cmdNop}); err != nil {
+			return err
+		}
+	}
+
+	if _, _, err := c.conn.NextReader(); err != nil {
+		return err
+	}
+	for i := range nops {
+		if _, _, err := c.conn.NextReader(); err != nil {
+			return err
+		}
+		onNop(i)
+	}
+	return nil
+}
+
+func (c *wsClient) Echo(ctx context.Context, in, out []byte) (err error) {
+	if err := c.begin(ctx); err != nil {
+		return err
+	}
+	defer func() { err = c.end(err) }()
+
+	if c.isJson {
+		c.outMsg.Command = jsonutils.CmdLarge
+		c.outMsg.Payload = jsonutils.LargeMessage{Data: in}
+		if err := c.conn.WriteJSON(c.outMsg); err != nil {
+			return fmt.Errorf("unable to write JSON large message: %v", err)
+		}
+
+		var reply jsonutils.LargeMessage
+		if err := c.conn.ReadJSON(&reply); err != nil {
+			return fmt.Errorf("unable to read JSON large message: %v", err)
+		}
+		if len(reply.Data) != len(out) {
+			return fmt.Errorf("unexpected reply size %d", len(reply.Data))
+		}
+		copy(out, reply.Data) // Json cannot decode directly into out.
+		return nil
+	}
+
+	rawWriter, err := c.conn.NextWriter(websocket.BinaryMessage)
+	if err != nil {
+		return err
+	}
+	c.writer.Reset(rawWriter)
+
+	if err := c.writer.WriteByte(cmdLarge); err != nil {
+		return err
+	}
+	if _, err := c.writer.Write(in); err != nil {
+		return err
+	}
+	if err := c.writer.Flush(); err != nil {
+		return err
+	}
+	if err := rawWriter.Close(); err != nil {
+		return err
+	}
+
+	_, rawReader, err := c.conn.NextReader()
+	if err != nil {
+		return err
+	}
+	c.reader.Reset(rawReader)
+
+	_, err = io.ReadFull(c.reader, out)
+	return err
+}
+
+func (c *wsClient) UpperRecords(ctx context.Context, in, out []rpcbench.Record) (err error) {
+	if err := c.begin(ctx); err != nil {
+		return err
+	}
+	defer func() { err = c.end(err) }()
+
+	if c.isJson {
+		c.outMsg.Command = jsonutils.CmdRecords
+		c.outMsg.Payload = jsonutils.RecordsMessage{Records: in}
+		if err := c.conn.WriteJSON(c.outMsg); err != nil {
+			return fmt.Errorf("unable to write JSON records: %v", err)
+		}
+
+		// The records are decoded directly into out, as long as the
+		// reply has the same number of records.
+		reply := jsonutils.RecordsMessage{Records: out[:0]}
+		if err := c.conn.ReadJSON(&reply); err != nil {
+			return fmt.Errorf("unable to read JSON records: %v", err)
+		}
+		if len(reply.Records) != len(out) {
+			return fmt.Errorf("unexpected number of records %d", len(reply.Records))
+		}
+		return nil
+	}
+
+	rawWriter, err := c.conn.NextWriter(websocket.BinaryMessage)
+	if err != nil {
+		return err
+	}
+	c.writer.Reset(rawWriter)
+
+	if err := c.writer.WriteByte(cmdRecords); err != nil {
+		return err
+	}
+	if err := binutils.WriteRecords(c.writer, c.aux, in); err != nil {
+		return err
+	}
+	if err := c.writer.Flush(); err != nil {
+		return err
+	}
+	if err := rawWriter.Close(); err != nil {
+		return err
+	}
+
+	_, rawReader, err := c.conn.NextReader()
+	if err != nil {
+		return err
+	}
+	c.reader.Reset(rawReader)
+
+	res, err := binutils.ReadRecords(c.reader, c.aux, out)
+	if err != nil {
+		return err
+	}
+	if len(res) != len(out) {
+		return fmt.Errorf("unexpected number of records %d", len(res))
+	}
+	return nil
+}
+
+func (c *wsClient) UpdateRows(ctx context.Context, in, out []rpcbench.Row) (err error) {
+	if err := c.begin(ctx); err != nil {
+		return err
+	}
+	defer func() { err = c.end(err) }()
+
+	if c.isJson {
+		c.outMsg.Command = jsonutils.CmdRows
+		c.outMsg.Payload = jsonutils.RowsMessage{Rows: in}
+		if err := c.conn.WriteJSON(c.outMsg); err != nil {
+			return fmt.Errorf("unable to write JSON rows: %v", err)
+		}
+
+		// The rows are decoded directly into out, which is reset first
+		// given the unset optional fields are omitted from the reply.
+		clear(out)
+		reply := jsonutils.RowsMessage{Rows: out[:0]}
+		if err := c.conn.ReadJSON(&reply); err != nil {
+			return fmt.Errorf("unable to read JSON rows: %v", err)
+		}
+		if len(reply.Rows) != len(out) {
+			return fmt.Errorf("unexpected number of rows %d", len(reply.Rows))
+		}
+		return nil
+	}
+
+	rawWriter, err := c.conn.NextWriter(websocket.BinaryMessage)
+	if err != nil {
+		return err
+	}
+	c.writer.Reset(rawWriter)
+
+	if err := c.writer.WriteByte(cmdRows); err != nil {
+		return err
+	}
+	if err := binutils.WriteRows(c.writer, c.aux, in); err != nil {
+		return err
+	}
+	if err := c.writer.Flush(); err != nil {
+		return err
+	}
+	if err := rawWriter.Close(); err != nil {
+		return err
+	}
+
+	_, rawReader, err := c.conn.NextReader()
+	if err != nil {
+		return err
+	}
+	c.reader.Reset(rawReader)
+
+	res, err := binutils.ReadRows(c.reader, c.aux, out)
+	if err != nil {
+		return err
+	}
+	if len(res) != len(out) {
+		return fmt.Errorf("unexpected number of rows %d", len(res))
+	}
+	return nil
+}
+
+func (c *wsClient) IncrementMap(ctx context.Context, in, out map[string]int64) (err error) {
+	if err := c.begin(ctx); err != nil {
+		return err
+	}
+	defer func() { err = c.end(err) }()
+
+	if c.isJson {
+		c.outMsg.Command = jsonutils.CmdMap
+		c.outMsg.Payload = jsonutils.MapMessage{Values: in}
+		if err := c.conn.WriteJSON(c.outMsg); err != nil {
+			return fmt.Errorf("unable to write JSON map: %v", err)
+		}
+
+		// The map is decoded directly into out.
+		reply := jsonutils.MapMessage{Values: out}
+		if err := c.conn.ReadJSON(&reply); err != nil {
+			return fmt.Errorf("unable to read JSON map: %v", err)
+		}
+		return nil
+	}
+
+	rawWriter, err := c.conn.NextWriter(websocket.BinaryMessage)
+	if err != nil {
+		return err
+	}
+	c.writer.Reset(rawWriter)
+
+	if err := c.writer.WriteByte(cmdMap); err != nil {
+		return err
+	}
+	if err := binutils.WriteMap(c.writer, c.aux, in); err != nil {
+		return err
+	}
+	if err := c.writer.Flush(); err != nil {
+		return err
+	}
+	if err := rawWriter.Close(); err != nil {
+		return err
+	}
+
+	_, rawReader, err := c.conn.NextReader()
+	if err != nil {
+		return err
+	}
+	c.reader.Reset(rawReader)
+
+	return binutils.ReadMap(c.reader, c.aux, out)
+}
+
+func (c *wsClient) ScaleVector(ctx context.Context, in []float64, scale float64, out []float64) (err error) {
+	if c.isJson {
+		// Checked before the call begins, so that the conn is left
+		// usable.
+		for _, f := range in {
+			if math.IsNaN(f) || math.IsInf(f, 0) {
+				return fmt.Errorf("%w: JSON cannot encode NaN or ±Inf", errors.ErrUnsupported)
+			}
+		}
+	}
+
+	if err := c.begin(ctx); err != nil {
+		return err
+	}
+	defer func() { err = c.end(err) }()
+
+	if c.isJson {
+		c.outMsg.Command = jsonutils.CmdVector
+		c.outMsg.Payload = jsonutils.VectorMessage{Values: in, Scale: scale}
+		if err := c.conn.WriteJSON(c.outMsg); err != nil {
+			return fmt.Errorf("unable to write JSON vector: %v", err)
+		}
+
+		// The vector is decoded directly into out, as long as the
+		// reply has the same number of elements.
+		reply := jsonutils.VectorMessage{Values: out[:0]}
+		if err := c.conn.ReadJSON(&reply); err != nil {
+			return fmt.Errorf("unable to read JSON vector: %v", err)
+		}
+		if len(reply.Values) != len(out) {
+			return fmt.Errorf("unexpected vector size %d", len(reply.Values))
+		}
+		return nil
+	}
+
+	rawWriter, err := c.conn.NextWriter(websocket.BinaryMessage)
+	if err != nil {
+		return err
+	}
+	c.writer.Reset(rawWriter)
+
+	if err := c.writer.WriteByte(cmdVector); err != nil {
+		return err
+	}
+	if err := binutils.WriteFloat64(c.writer, c.aux, scale); err != nil {
+		return err
+	}
+	if err := binutils.WriteFloat64s(c.writer, in); err != nil {
+		return err
+	}
+	if err := c.writer.Flush(); err != nil {
+		return err
+	}
+	if err := rawWriter.Close(); err != nil {
+		return err
+	}
+
+	_, rawReader, err := c.conn.NextReader()
+	if err != nil {
+		return err
+	}
+	c.reader.Reset(rawReader)
+
+	res, err := binutils.ReadFloat64s(c.reader, c.aux, out)
+	if err != nil {
+		return err
+	}
+	if len(res) != len(out) {
+		return fmt.Errorf("unexpected vector size %d", len(res))
+	}
+	return nil
+}
+
+func (c *wsClient) NopWithMetadata(ctx context.Context, md []rpcbench.MetadataEntry) (_ string, err error) {
+	if err := c.begin(ctx); err != nil {
+		return "", err
+	}
+	defer func() { err = c.end(err) }()
+
+	if c.isJson {
+		// The metadata is sent in the message itself, along with the
+		// command.
+		c.outMsg.Command = jsonutils.CmdMetadata
+		c.outMsg.Metadata = md
+		c.outMsg.Payload = nil
+		err := c.conn.WriteJSON(c.outMsg)
+		c.outMsg.Metadata = nil
+		if err != nil {
+			return "", fmt.Errorf("unable to write JSON metadata: %v", err)
+		}
+
+		var res jsonutils.MetadataResponse
+		if err := c.conn.ReadJSON(&res); err != nil {
+			return "", fmt.Errorf("unable to read JSON metadata: %v", err)
+		}
+		if res.Error != nil {
+			return "", res.Error.AppError()
+		}
+		return res.Echo, nil
+	}
+
+	rawWriter, err := c.conn.NextWriter(websocket.BinaryMessage)
+	if err != nil {
+		return "", err
+	}
+	c.writer.Reset(rawWriter)
+
+	if err := c.writer.WriteByte(cmdMetadata); err != nil {
+		return "", err
+	}
+	if err := binutils.WriteMetadata(c.writer, c.aux, md); err != nil {
+		return "", err
+	}
+	if err := c.writer.Flush(); err != nil {
+		return "", err
+	}
+	if err := rawWriter.Close(); err != nil {
+		return "", err
+	}
+
+	_, rawReader, err := c.conn.NextReader()
+	if err != nil {
+		return "", err
+	}
+	c.reader.Reset(rawReader)
+
+	reply, err := c.reader.ReadByte()
+	if err != nil {
+		return "", err
+	}
+	switch reply {
+	case validateReplyOK:
+		return binutils.ReadString(c.reader, c.aux)
+	case validateReplyError:
+		appErr, err := binutils.ReadAppError(c.reader, c.aux)
+		if err != nil {
+			return "", err
+		}
+		return "", appErr
+	default:
+		return "", fmt.Errorf("unknown metadata reply %d", reply)
+	}
+}
+
+// wsBidiStream is a bidi stream that takes over the connection of its client
+// until it is closed. Each direction has its own buffer, given Send and Recv are
+// called concurrently.
+//
+// The stream is a call that lasts until it is closed, so its I/O honors the
+// context it was opened with. The call ends once the stream is closed or fails
+// in either direction.
+type wsBidiStream struct {
+	c       *wsClient
+	sendAux []byte
+	recvAux []byte
+	sendMsg jsonutils.BidiMessage
+	recvMsg jsonutils.BidiMessage
+	endOnce sync.Once
+}
+
+// end ends the call of the stream, given the error that ended it.
+func (s *wsBidiStream) end(err error) error {
+	s.endOnce.Do(func() { err = s.c.end(err) })
+	return err
+}
+
+func (s *wsBidiStream) Send(v int64) error {
+	if err := s.send(v); err != nil {
+		return s.end(err)
+	}
+	return nil
+}
+
+func (s *wsBidiStream) send(v int64) error {
+	if s.c.isJson {
+		s.sendMsg.Value = v
+		if err := s.c.conn.WriteJSON(s.sendMsg); err != nil {
+			return fmt.Errorf("unable to write JSON bidi message: %v", err)
+		}
+		return nil
+	}
+
+	rawWriter, err := s.c.conn.NextWriter(websocket.BinaryMessage)
+	if err != nil {
+		return err
+	}
+	if err := binutils.WriteInt64(rawWriter, s.sendAux, v); err != nil {
+		return err
+	}
+	return rawWriter.Close()
+}
+
+func (s *wsBidiStream) Recv() (int64, error) {
+	v, err := s.recv()
+	if err != nil {
+		return 0, s.end(err)
+	}
+	return v, nil
+}
+
+func (s *wsBidiStream) recv() (int64, error) {
+	if s.c.isJson {
+		if err := s.c.conn.ReadJSON(&s.recvMsg); err != nil {
+			return 0, fmt.Errorf("unable to read JSON bidi message: %v", err)
+		}
+		return s.recvMsg.Value, nil
+	}
+
+	_, rawReader, err := s.c.conn.NextReader()
+	if err != nil {
+		return 0, err
+	}
+	return binutils.ReadInt64(rawReader, s.recvAux)
+}
+
+func (s *wsBidiStream) Close() error {
+	return s.end(s.endStream())
+}
+
+// endStream ends the stream, waiting for the server to end it as well.
+func (s *wsBidiStream) endStream() error {
+	if s.c.isJson {
+		s.sendMsg.End = true
+		if err := s.c.conn.WriteJSON(s.sendMsg); err != nil {
+			return fmt.Errorf("unable to write JSON bidi end: %v", err)
+		}
+		if err := s.c.conn.ReadJSON(&s.recvMsg); err != nil {
+			return fmt.Errorf("unable to read JSON bidi end: %v", err)
+		}
+		if !s.recvMsg.End {
+			return errors.New("bidi stream not ended by server")
+		}
+		return nil
+	}
+
+	if err := s.c.conn.WriteMessage(websocket.BinaryMessage, nil); err != nil {
+		return err
+	}
+	_, rawReader, err := s.c.conn.NextReader()
+	if err != nil {
+		return err
+	}
+	if n, _ := rawReader.Read(s.recvAux); n != 0 {
+		return errors.New("bidi stream not ended by server")
+	}
+	return nil
+}
+
+func (c *wsClient) OpenBidiStream(ctx context.Context) (rpcbench.BidiStream, error) {
+	if err := c.begin(ctx); err != nil {
+		return nil, err
+	}
+
+	if c.isJson {
+		c.outMsg.Command = jsonutils.CmdBidiStream
+		c.outMsg.Payload = nil
+		if err := c.conn.WriteJSON(c.outMsg); err != nil {
+			return nil, c.end(fmt.Errorf("unable to write JSON bidi stream: %v", err))
+		}
+	} else if err := c.conn.WriteMessage(websocket.BinaryMessage, []byte{cmdBidiStream}); err != nil {
+		return nil, c.end(err)
+	}
+
+	return &wsBidiStream{
+		c:       c,
+		sendAux: make([]byte, 8),
+		recvAux: make([]byte, 8),
+	}, nil
+}
+
+func (c *wsClient) Run(ctx context.Context) error {
+	<-ctx.Done()
+	return c.conn.Close()
+}
+
+// Close closes the conn of the client.
+func (c *wsClient) Close() error {
+	if c.broken {
+		return nil
+	}
+	c.stopClose()
+	c.broken = true
+	return c.conn.Close()
+}
+
+func newWSClient(ctx context.Context, cfg rpcbench.ClientConfig, isJson bool) (*wsClient, error) {
+	url := "ws://" + cfg.HTTPHost()
+	if cfg.TLSConfig != nil {
+		url = "wss://" + cfg.HTTPHost()
+	}
+	var header http.Header
+	if isJson {
+		header = make(http.Header)
+		header.Add("Content-Type", "text/json")
+	}
+	dialer := *websocket.DefaultDialer
+	dialer.NetDialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
+		return cfg.DialNet(ctx)
+	}
+	dialer.TLSClientConfig = cfg.TLSConfig
+
+	c := &wsClient{
+		aux:    make([]byte, 8),
+		reader: bufio.NewReaderSize(nil, rpcbench.MaxHexEncodeSize*2),
+		writer: bufio.NewWriterSize(nil, rpcbench.MaxHexEncodeSize*2),
+		isJson: isJson,
+		ctx:    ctx,
+		dial: func(ctx context.Context) (*websocket.Conn, error) {
+			conn, _, err := dialer.DialContext(ctx, url, header)
+			if err != nil {
+				return nil, err
+			}
+			conn.SetReadLimit(readLimit())
+			return conn, nil
+		},
+	}
+	if err := c.connect(); err != nil {
+		return nil, err
+	}
+	return c, nil
 }
@@ -19,8 +19,8 @@ func (f wsFactory) NewServer(l net.Listener) (rpcbench.Server, error) {
 	return newWSServer(l), nil
 }
 
-func (f wsFactory) NewClient(ctx context.Context, addr string) (rpcbench.Client, error) {
-	return newWSClient(ctx, addr, f.isJson)
+func (f wsFactory) NewClient(ctx context.Context, cfg rpcbench.ClientConfig) (rpcbench.Client, error) {
+	return newWSClient(ctx, cfg, f.isJson)
 }
 
 func WSFactoryIniter() rpcbench.RPCFactory {
@@ -6,11 +6,14 @@ package websocket
 
 import (
 	"bufio"
+	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"hash/crc32"
 	"io"
 	"log"
 	"net"
@@ -28,20 +31,119 @@ const (
 	cmdAdd      byte = 2
 	cmdMultTree byte = 3
 	cmdToHex    byte = 4
+
+	// cmdServerStream is followed by the start and count of items. The
+	// server replies with count messages, one per item.
+	cmdServerStream byte = 5
+
+	// cmdClientStream is followed by one message per chunk and an empty
+	// message that ends the stream. The server replies with the checksum of
+	// all chunks.
+	cmdClientStream byte = 6
+
+	// cmdBidiStream is followed by one message per int64, each one echoed
+	// back by the server in its own message. An empty message ends the
+	// stream, and is echoed back as well.
+	cmdBidiStream byte = 7
+
+	// cmdCallback is followed by the start and count of callbacks. The
+	// server replies with one message per callback, each one starting with
+	// a frame type and answered by the client with an int64 message. A
+	// callbackFrameReturn message ends the call, with the sum of the
+	// replies.
+	cmdCallback byte = 8
+
+	// cmdValidate is followed by a value and an app error code. The server
+	// replies with a reply type: a validateReplyOK reply is followed by the
+	// value, while a validateReplyError reply is followed by the app error
+	// (when the code is not zero).
+	cmdValidate byte = 9
+
+	// cmdSlow is followed by a delay and a timeout (zero when the call has
+	// no deadline). The server replies with a reply type once the delay
+	// elapses, or with slowReplyCanceled once the timeout expires.
+	cmdSlow byte = 10
+
+	// cmdSlowCalls has no arguments. The server replies with the number of
+	// cmdSlow calls that were started and the number of the ones that were
+	// canceled.
+	cmdSlowCalls byte = 11
+
+	// cmdWork is followed by the work to do. The server replies with
+	// cmdWork once the work is done. Clients may send other calls before
+	// the reply, which are only handled after it.
+	cmdWork byte = 12
+
+	// cmdLarge is followed by a payload, up to the end of the message. The
+	// server replies with the same payload.
+	cmdLarge byte = 13
+
+	// cmdRecords is followed by a list of records. The server replies with
+	// the same list, with the names converted by rpcbench.UpperName.
+	cmdRecords byte = 14
+
+	// cmdRows is followed by a list of rows. The server replies with the
+	// same list, with the versions of the rows incremented.
+	cmdRows byte = 15
+
+	// cmdMap is followed by a map. The server replies with the same map,
+	// with every value incremented.
+	cmdMap byte = 16
+
+	// cmdVector is followed by a scale and a vector. The server replies
+	// with the vector multiplied by the scale.
+	cmdVector byte = 17
+
+	// cmdMetadata is followed by a header section with the metadata of the
+	// call (see binutils.WriteMetadata). The server replies with a reply
+	// type: a validateReplyOK reply is followed by the value returned by
+	// rpcbench.CheckMetadata, while a validateReplyError reply is followed
+	// by the app error (when the metadata is invalid).
+	cmdMetadata byte = 18
+)
+
+const (
+	callbackFrameReturn byte = 0
+	callbackFrameCall   byte = 1
+)
+
+const (
+	validateReplyOK    byte = 0
+	validateReplyError byte = 1
 )
 
+const (
+	slowReplyOK       byte = 0
+	slowReplyCanceled byte = 1
+)
+
+// readLimit is the max size of messages read by both ends of conns, which fits
+// a JSON encoded payload of the max message size.
+func readLimit() int64 {
+	return int64(base64.StdEncoding.EncodedLen(rpcbench.MaxMessageSize()) + rpcbench.MaxMessageOverhead)
+}
+
 type wsServer struct {
 	l        net.Listener
 	skipLog  bool
 	upgrader *websocket.Upgrader
+	slow     rpcbench.SlowHandler
 }
 
-func (s *wsServer) runBinaryConn(conn *websocket.Conn) error {
+func (s *wsServer) runBinaryConn(ctx context.Context, conn *websocket.Conn) error {
 	nopReplyBuf := []byte{cmdNop}
 	aux := make([]byte, 8)
 	reader := &bufio.Reader{}
 	readHexBuf := make([]byte, rpcbench.MaxHexEncodeSize)
 	writeHexBuf := make([]byte, len(readHexBuf)*2)
+	checksum := crc32.NewIEEE()
+	var largeBuf bytes.Buffer
+	var records []rpcbench.Record
+	var rows []rpcbench.Row
+	counters := make(map[string]int64)
+	var vector []float64
+	var md []rpcbench.MetadataEntry
+	getMetadata := func(key string) string { return rpcbench.MetadataValue(md, key) }
 	for {
 		// Read message from client
 		_, rawReader, err := conn.NextReader()
@@ -103,6 +205,229 @@ func (s *wsServer) runBinaryConn(conn *websocket.Conn) error {
 				size -= int64(n)
 			}
 
+		case cmdServerStream:
+			var start, count int64
+			if start, err = binutils.ReadInt64(reader, aux); err != nil {
+				return err
+			}
+			if count, err = binutils.ReadInt64(reader, aux); err != nil {
+				return err
+			}
+
+			for i := range count {
+				if i > 0 {
+					// Every item is sent in its own message.
+					if err := writer.Close(); err != nil {
+						return fmt.Errorf("error closing writer: %w", err)
+					}
+					if writer, err = conn.NextWriter(websocket.BinaryMessage); err != nil {
+						return fmt.Errorf("error obtaining writer: %w", err)
+					}
+				}
+				if err = binutils.WriteInt64(writer, aux, start+i); err != nil {
+					return err
+				}
+			}
+
+		case cmdClientStream:
+			checksum.Reset()
+			for {
+				_, rawReader, err := conn.NextReader()
+				if err != nil {
+					return fmt.Errorf("error obtaining reader: %w", err)
+				}
+				n, err := io.CopyBuffer(checksum, rawReader, readHexBuf)
+				if err != nil {
+					return err
+				}
+				if n == 0 {
+					break
+				}
+			}
+			err = binutils.WriteInt64(writer, aux, int64(checksum.Sum32()))
+
+		case cmdBidiStream:
+			for {
+				_, rawReader, err := conn.NextReader()
+				if err != nil {
+					return fmt.Errorf("error obtaining reader: %w", err)
+				}
+				n, err := io.ReadFull(rawReader, aux)
+				if n == 0 && errors.Is(err, io.EOF) {
+					// The echo of the end of the stream is sent
+					// when the writer is closed below.
+					break
+				}
+				if err != nil {
+					return err
+				}
+
+				if _, err := writer.Write(aux); err != nil {
+					return err
+				}
+				if err := writer.Close(); err != nil {
+					return fmt.Errorf("error closing writer: %w", err)
+				}
+				if writer, err = conn.NextWriter(websocket.BinaryMessage); err != nil {
+					return fmt.Errorf("error obtaining writer: %w", err)
+				}
+			}
+
+		case cmdCallback:
+			var start, count int64
+			if start, err = binutils.ReadInt64(reader, aux); err != nil {
+				return err
+			}
+			if count, err = binutils.ReadInt64(reader, aux); err != nil {
+				return err
+			}
+
+			var sum int64
+			for i := range count {
+				if _, err := writer.Write([]byte{callbackFrameCall}); err != nil {
+					return err
+				}
+				if err := binutils.WriteInt64(writer, aux, start+i); err != nil {
+					return err
+				}
+				if err := writer.Close(); err != nil {
+					return fmt.Errorf("error closing writer: %w", err)
+				}
+
+				_, rawReader, err := conn.NextReader()
+				if err != nil {
+					return fmt.Errorf("error obtaining reader: %w", err)
+				}
+				reader.Reset(rawReader)
+				res, err := binutils.ReadInt64(reader, aux)
+				if err != nil {
+					return err
+				}
+				sum += res
+
+				if writer, err = conn.NextWriter(websocket.BinaryMessage); err != nil {
+					return fmt.Errorf("error obtaining writer: %w", err)
+				}
+			}
+			if _, err = writer.Write([]byte{callbackFrameReturn}); err != nil {
+				return err
+			}
+			err = binutils.WriteInt64(writer, aux, sum)
+
+		case cmdValidate:
+			var v, code int64
+			if v, err = binutils.ReadInt64(reader, aux); err != nil {
+				return err
+			}
+			if code, err = binutils.ReadInt64(reader, aux); err != nil {
+				return err
+			}
+
+			if code != 0 {
+				if _, err = writer.Write([]byte{validateReplyError}); err != nil {
+					return err
+				}
+				err = binutils.WriteAppError(writer, aux, rpcbench.RejectValue(rpcbench.AppErrorCode(code), v))
+				break
+			}
+			if _, err = writer.Write([]byte{validateReplyOK}); err != nil {
+				return err
+			}
+			err = binutils.WriteInt64(writer, aux, v)
+
+		case cmdSlow:
+			var delay, timeout int64
+			if delay, err = binutils.ReadInt64(reader, aux); err != nil {
+				return err
+			}
+			if timeout, err = binutils.ReadInt64(reader, aux); err != nil {
+				return err
+			}
+
+			reply := slowReplyOK
+			if s.slow.HandleTimeout(ctx, time.Duration(delay), time.Duration(timeout)) != nil {
+				reply = slowReplyCanceled
+			}
+			_, err = writer.Write([]byte{reply})
+
+		case cmdSlowCalls:
+			started, canceled := s.slow.Counts()
+			if err = binutils.WriteInt64(writer, aux, started); err != nil {
+				return err
+			}
+			err = binutils.WriteInt64(writer, aux, canceled)
+
+		case cmdWork:
+			var work rpcbench.Work
+			if work, err = binutils.ReadWork(reader, aux); err != nil {
+				return err
+			}
+			work.Do()
+			_, err = writer.Write([]byte{cmdWork})
+
+		case cmdLarge:
+			largeBuf.Reset()
+			if _, err = largeBuf.ReadFrom(reader); err != nil {
+				return err
+			}
+			_, err = writer.Write(largeBuf.Bytes())
+
+		case cmdRecords:
+			if records, err = binutils.ReadRecords(reader, aux, records); err != nil {
+				return err
+			}
+			for i := range records {
+				records[i].Name = rpcbench.UpperName(records[i].Name)
+			}
+			err = binutils.WriteRecords(writer, aux, records)
+
+		case cmdRows:
+			if rows, err = binutils.ReadRows(reader, aux, rows); err != nil {
+				return err
+			}
+			for i := range rows {
+				rows[i].Version++
+			}
+			err = binutils.WriteRows(writer, aux, rows)
+
+		case cmdMap:
+			if err = binutils.ReadMap(reader, aux, counters); err != nil {
+				return err
+			}
+			for k := range counters {
+				counters[k]++
+			}
+			err = binutils.WriteMap(writer, aux, counters)
+
+		case cmdVector:
+			var scale float64
+			if scale, err = binutils.ReadFloat64(reader, aux); err != nil {
+				return err
+			}
+			if vector, err = binutils.ReadFloat64s(reader, aux, vector); err != nil {
+				return err
+			}
+			for i := range vector {
+				vector[i] *= scale
+			}
+			err = binutils.WriteFloat64s(writer, vector)
+
+		case cmdMetadata:
+			if md, err = binutils.ReadMetadata(reader, aux, md); err != nil {
+				return err
+			}
+			echo, appErr := rpcbench.CheckMetadata(getMetadata)
+			if appErr != nil {
+				if _, err = writer.Write([]byte{validateReplyError}); err != nil {
+					return err
+				}
+				err = binutils.WriteAppError(writer, aux, appErr)
+				break
+			}
+			if _, err = writer.Write([]byte{validateReplyOK}); err != nil {
+				return err
+			}
+			err = binutils.WriteString(writer, aux, echo)
 		}
 
 		if err := writer.Close(); err != nil {
@@ -111,15 +436,38 @@ func (s *wsServer) runBinaryConn(conn *websocket.Conn) error {
 	}
 }
 
-func (s *wsServer) runJsonConn(conn *websocket.Conn) error {
+func (s *wsServer) runJsonConn(ctx context.Context, conn *websocket.Conn) error {
 	var msg jsonutils.Message
 	var addReq jsonutils.AddRequest
 	var addRes jsonutils.AddResponse
 	var multReq jsonutils.MultTreeRequest
+	var streamReq jsonutils.ServerStreamRequest
+	var streamItem jsonutils.StreamItem
+	var streamChunk jsonutils.StreamChunk
+	var streamRes jsonutils.ClientStreamResponse
+	var bidiMsg jsonutils.BidiMessage
+	var callbackReq jsonutils.CallbackRequest
+	var callbackMsg jsonutils.CallbackMessage
+	var validateReq jsonutils.ValidateRequest
+	var validateRes jsonutils.ValidateResponse
+	var slowReq jsonutils.SlowRequest
+	var slowRes jsonutils.SlowResponse
+	var slowCallsRes jsonutils.SlowCallsResponse
+	var workReq jsonutils.WorkRequest
+	var largeMsg jsonutils.LargeMessage
+	var recordsMsg jsonutils.RecordsMessage
+	var rowsMsg jsonutils.RowsMessage
+	mapMsg := jsonutils.MapMessage{Values: make(map[string]int64)}
+	var vectorMsg jsonutils.VectorMessage
+	var metadataRes jsonutils.MetadataResponse
+	getMetadata := func(key string) string { return rpcbench.MetadataValue(msg.Metadata, key) }
 	toHexInBuf := make([]byte, rpcbench.MaxHexEncodeSize)
 	toHexOutBuf := make([]byte, rpcbench.MaxHexEncodeSize*2)
 
 	for {
+		// The metadata is omitted from most messages, so the one of
+		// the previous message must be reset.
+		msg.Metadata = msg.Metadata[:0]
 		if err := conn.ReadJSON(&msg); err != nil {
 			return err
 		}
@@ -157,6 +505,180 @@ func (s *wsServer) runJsonConn(conn *websocket.Conn) error {
 			if err := conn.WriteJSON(toHexOutBuf[:n]); err != nil {
 				return err
 			}
+
+		case jsonutils.CmdServerStream:
+			if err := json.Unmarshal(msg.Payload, &streamReq); err != nil {
+				return err
+			}
+			for i := range streamReq.Count {
+				streamItem.Value = streamReq.Start + int64(i)
+				if err := conn.WriteJSON(streamItem); err != nil {
+					return err
+				}
+			}
+
+		case jsonutils.CmdClientStream:
+			streamRes.Checksum = 0
+			for {
+				if err := conn.ReadJSON(&streamChunk); err != nil {
+					return err
+				}
+				if len(streamChunk.Data) == 0 {
+					break
+				}
+				streamRes.Checksum = crc32.Update(streamRes.Checksum, crc32.IEEETable, streamChunk.Data)
+			}
+			if err := conn.WriteJSON(streamRes); err != nil {
+				return err
+			}
+
+		case jsonutils.CmdBidiStream:
+			for {
+				if err := conn.ReadJSON(&bidiMsg); err != nil {
+					return err
+				}
+				if err := conn.WriteJSON(bidiMsg); err != nil {
+					return err
+				}
+				if bidiMsg.End {
+					break
+				}
+			}
+
+		case jsonutils.CmdCallback:
+			if err := json.Unmarshal(msg.Payload, &callbackReq); err != nil {
+				return err
+			}
+			var sum int64
+			for i := range callbackReq.Count {
+				callbackMsg.Value = callbackReq.Start + int64(i)
+				callbackMsg.Return = false
+				if err := conn.WriteJSON(callbackMsg); err != nil {
+					return err
+				}
+				if err := conn.ReadJSON(&callbackMsg); err != nil {
+					return err
+				}
+				sum += callbackMsg.Value
+			}
+			callbackMsg.Value = sum
+			callbackMsg.Return = true
+			if err := conn.WriteJSON(callbackMsg); err != nil {
+				return err
+			}
+
+		case jsonutils.CmdValidate:
+			// The code is omitted when zero, so it must be reset.
+			validateReq = jsonutils.ValidateRequest{}
+			if err := json.Unmarshal(msg.Payload, &validateReq); err != nil {
+				return err
+			}
+			validateRes.Value, validateRes.Error = validateReq.Value, nil
+			if validateReq.Code != 0 {
+				validateRes.Value = 0
+				validateRes.Error = jsonutils.NewAppError(rpcbench.RejectValue(validateReq.Code, validateReq.Value))
+			}
+			if err := conn.WriteJSON(validateRes); err != nil {
+				return err
+			}
+
+		case jsonutils.CmdSlow:
+			// The timeout is omitted when zero, so it must be reset.
+			slowReq = jsonutils.SlowRequest{}
+			if err := json.Unmarshal(msg.Payload, &slowReq); err != nil {
+				return err
+			}
+			slowRes.Canceled = s.slow.HandleTimeout(ctx, slowReq.Delay, slowReq.Timeout) != nil
+			if err := conn.WriteJSON(slowRes); err != nil {
+				return err
+			}
+
+		case jsonutils.CmdSlowCalls:
+			slowCallsRes.Started, slowCallsRes.Canceled = s.slow.Counts()
+			if err := conn.WriteJSON(slowCallsRes); err != nil {
+				return err
+			}
+
+		case jsonutils.CmdWork:
+			if err := json.Unmarshal(msg.Payload, &workReq); err != nil {
+				return err
+			}
+			rpcbench.Work{Duration: workReq.Duration, CPU: workReq.CPU}.Do()
+			if err := conn.WriteJSON(msg); err != nil {
+				return err
+			}
+
+		case jsonutils.CmdLarge:
+			if err := json.Unmarshal(msg.Payload, &largeMsg); err != nil {
+				return err
+			}
+			if err := conn.WriteJSON(largeMsg); err != nil {
+				return err
+			}
+
+		case jsonutils.CmdRecords:
+			if err := json.Unmarshal(msg.Payload, &recordsMsg); err != nil {
+				return err
+			}
+			for i := range recordsMsg.Records {
+				recordsMsg.Records[i].Name = rpcbench.UpperName(recordsMsg.Records[i].Name)
+			}
+			if err := conn.WriteJSON(recordsMsg); err != nil {
+				return err
+			}
+
+		case jsonutils.CmdRows:
+			// The optional fields of the rows are omitted when
+			// unset, so the rows of the previous call must be reset.
+			clear(rowsMsg.Rows[:cap(rowsMsg.Rows)])
+			rowsMsg.Rows = rowsMsg.Rows[:0]
+			if err := json.Unmarshal(msg.Payload, &rowsMsg); err != nil {
+				return err
+			}
+			for i := range rowsMsg.Rows {
+				rowsMsg.Rows[i].Version++
+			}
+			if err := conn.WriteJSON(rowsMsg); err != nil {
+				return err
+			}
+
+		case jsonutils.CmdMap:
+			// Unmarshal adds to the existing map, so the entries of
+			// the previous call must be removed.
+			clear(mapMsg.Values)
+			if err := json.Unmarshal(msg.Payload, &mapMsg); err != nil {
+				return err
+			}
+			for k := range mapMsg.Values {
+				mapMsg.Values[k]++
+			}
+			if err := conn.WriteJSON(mapMsg); err != nil {
+				return err
+			}
+
+		case jsonutils.CmdVector:
+			// The scale is omitted when zero, so it must be reset.
+			vectorMsg.Scale = 0
+			if err := json.Unmarshal(msg.Payload, &vectorMsg); err != nil {
+				return err
+			}
+			for i := range vectorMsg.Values {
+				vectorMsg.Values[i] *= vectorMsg.Scale
+			}
+			vectorMsg.Scale = 0
+			if err := conn.WriteJSON(vectorMsg); err != nil {
+				return err
+			}
+
+		case jsonutils.CmdMetadata:
+			echo, appErr := rpcbench.CheckMetadata(getMetadata)
+			metadataRes.Echo, metadataRes.Error = echo, nil
+			if appErr != nil {
+				metadataRes.Error = jsonutils.NewAppError(appErr)
+			}
+			if err := conn.WriteJSON(metadataRes); err != nil {
+				return err
+			}
 		}
 	}
 }
@@ -170,13 +692,14 @@ func (s *wsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	defer conn.Close()
+	conn.SetReadLimit(readLimit())
 
 	isJson := r.Header.Get("Content-Type") == "text/json"
 
 	if isJson {
-		err = s.runJsonConn(conn)
+		err = s.runJsonConn(r.Context(), conn)
 	} else {
-		err = s.runBinaryConn(conn)
+		err = s.runBinaryConn(r.Context(), conn)
 	}
 
 	if err != nil && !s.skipLog && !errors.Is(err, io.EOF) {
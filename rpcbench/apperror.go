@@ -0,0 +1,58 @@
+// Copyright (c) 2025 Matheus Degiovani
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcbench
+
+import "fmt"
+
+// AppErrorCode is the code of an application error. The codes match the
+// canonical gRPC status codes, so systems with their own status codes may map
+// them directly.
+type AppErrorCode uint32
+
+const (
+	AppErrInvalidArgument    AppErrorCode = 3
+	AppErrNotFound           AppErrorCode = 5
+	AppErrPermissionDenied   AppErrorCode = 7
+	AppErrFailedPrecondition AppErrorCode = 9
+)
+
+func (c AppErrorCode) String() string {
+	switch c {
+	case AppErrInvalidArgument:
+		return "invalid argument"
+	case AppErrNotFound:
+		return "not found"
+	case AppErrPermissionDenied:
+		return "permission denied"
+	case AppErrFailedPrecondition:
+		return "failed precondition"
+	default:
+		return fmt.Sprintf("code %d", uint32(c))
+	}
+}
+
+// AppErrorCodes returns the list of codes of application errors.
+func AppErrorCodes() []AppErrorCode {
+	return []AppErrorCode{AppErrInvalidArgument, AppErrNotFound,
+		AppErrPermissionDenied, AppErrFailedPrecondition}
+}
+
+// AppError is an error returned by the application (as opposed to the RPC
+// system) while handling a call. Clients should convert the errors returned by
+// their system back into an *AppError, with the same code and message as the
+// one returned by the server.
+type AppError struct {
+	Code    AppErrorCode
+	Message string
+}
+
+func (e *AppError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// RejectValue returns the error servers should fail Validate calls with.
+func RejectValue(code AppErrorCode, v int64) *AppError {
+	return &AppError{Code: code, Message: fmt.Sprintf("value %d rejected", v)}
+}
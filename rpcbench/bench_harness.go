@@ -6,17 +6,25 @@ package rpcbench
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/binary"
 	"errors"
+	"fmt"
 	"io"
 	"math/rand/v2"
 	"net"
+	"os"
+	"path/filepath"
+	"sync/atomic"
 	"testing"
 	"time"
+
+	"github.com/matheusd/gorpcbench/internal/netutils"
 )
 
 // MaxHexEncodeSize is the maximum size of a toHex message. This can be
-// considered as the max message size in a particular RPC implementation.
+// considered as the max message size in a particular RPC implementation, for
+// every workload other than ClientCallLarge (see [MaxMessageSize]).
 const MaxHexEncodeSize = 128 * 1024
 
 type ClientCall int
@@ -26,8 +34,90 @@ const (
 	ClientCallAdd
 	ClientCallTreeMult
 	ClientCallToHex
+	ClientCallServerStream
+	ClientCallClientStream
+	ClientCallBidiStream
+	ClientCallAddChain
+	ClientCallCallback
+	ClientCallError
+	ClientCallDeadline
+	ClientCallHeadOfLine
+	ClientCallLarge
+	ClientCallRecords
+	ClientCallRows
+	ClientCallMap
+	ClientCallVector
+	ClientCallMetadata
+)
+
+// serverStreamItems is the number of items streamed by the server on each
+// ClientCallServerStream call.
+const serverStreamItems = 128
+
+const (
+	// clientStreamChunks is the number of chunks streamed by the client on
+	// each ClientCallClientStream call.
+	clientStreamChunks = 64
+
+	// clientStreamChunkSize is the size of each chunk streamed by the
+	// client.
+	clientStreamChunkSize = 1024
+)
+
+// defaultBidiWindow is the number of in-flight messages of
+// ClientCallBidiStream cases that do not specify a window.
+const defaultBidiWindow = 16
+
+// defaultChainLength is the number of dependent calls of ClientCallAddChain
+// cases that do not specify a chain length.
+const defaultChainLength = 4
+
+// callbackCount is the number of times the server calls back into the client on
+// each ClientCallCallback call.
+const callbackCount = 16
+
+// defaultErrorFraction is the fraction of the calls of ClientCallError cases
+// that fail, when the case does not specify one.
+const defaultErrorFraction = 0.1
+
+const (
+	// slowCallDelay is the time servers take to handle the calls of
+	// ClientCallDeadline cases, when they are not canceled.
+	slowCallDelay = 50 * time.Millisecond
+
+	// slowCallTimeout is the timeout of the calls of ClientCallDeadline
+	// cases.
+	slowCallTimeout = time.Millisecond
+)
+
+const (
+	// headOfLineNops is the number of Nop calls sent along with the Work
+	// call of each ClientCallHeadOfLine call.
+	headOfLineNops = 4
+
+	// defaultWork is the time servers spend handling the Work call of
+	// ClientCallHeadOfLine cases that do not specify one.
+	defaultWork = time.Millisecond
 )
 
+// recordsPerCall is the number of records sent on each ClientCallRecords call.
+const recordsPerCall = 32
+
+// rowsPerCall is the number of rows sent on each ClientCallRows call.
+const rowsPerCall = 16
+
+// defaultMapSize is the number of entries of the map of ClientCallMap cases
+// that do not specify a map size.
+const defaultMapSize = 64
+
+// vectorLen is the number of elements of the vector sent on each
+// ClientCallVector call.
+const vectorLen = 4096
+
+// defaultLargeMessageSize is the size of the payload of ClientCallLarge cases
+// that do not specify one.
+const defaultLargeMessageSize = 4 << 20
+
 func (cc ClientCall) String() string {
 	switch cc {
 	case ClientCallNop:
@@ -38,13 +128,82 @@ func (cc ClientCall) String() string {
 		return "tree"
 	case ClientCallToHex:
 		return "hex"
+	case ClientCallServerStream:
+		return "sstream"
+	case ClientCallClientStream:
+		return "cstream"
+	case ClientCallBidiStream:
+		return "bidi"
+	case ClientCallAddChain:
+		return "chain"
+	case ClientCallCallback:
+		return "callback"
+	case ClientCallError:
+		return "error"
+	case ClientCallDeadline:
+		return "deadline"
+	case ClientCallHeadOfLine:
+		return "hol"
+	case ClientCallLarge:
+		return "large"
+	case ClientCallRecords:
+		return "records"
+	case ClientCallRows:
+		return "rows"
+	case ClientCallMap:
+		return "map"
+	case ClientCallVector:
+		return "vector"
+	case ClientCallMetadata:
+		return "metadata"
 	default:
 		panic("unknown cc")
 	}
 }
 
+// ClientCallMatrix returns the calls run in every mode. ClientCallLarge is not
+// part of it, given its buffers are too large to be allocated for each client
+// of the cases with many clients.
 func ClientCallMatrix() []ClientCall {
-	return []ClientCall{ClientCallNop, ClientCallAdd, ClientCallTreeMult, ClientCallToHex}
+	return []ClientCall{ClientCallNop, ClientCallAdd, ClientCallTreeMult, ClientCallToHex,
+		ClientCallServerStream, ClientCallClientStream, ClientCallBidiStream, ClientCallAddChain,
+		ClientCallCallback, ClientCallError, ClientCallDeadline, ClientCallHeadOfLine,
+		ClientCallRecords, ClientCallRows, ClientCallMap, ClientCallVector,
+		ClientCallMetadata}
+}
+
+// requiredCaps returns the capabilities a system needs to make the call.
+func (cc ClientCall) requiredCaps() Capability {
+	switch cc {
+	case ClientCallServerStream:
+		return CapServerStream
+	case ClientCallClientStream:
+		return CapClientStream
+	case ClientCallBidiStream:
+		return CapBidiStream
+	case ClientCallCallback:
+		return CapCallback
+	case ClientCallError:
+		return CapAppError
+	case ClientCallDeadline:
+		return CapCancel
+	case ClientCallHeadOfLine:
+		return CapOverlap
+	case ClientCallLarge:
+		return CapLargeMessage
+	case ClientCallRecords:
+		return CapRecords
+	case ClientCallRows:
+		return CapRows
+	case ClientCallMap:
+		return CapMap
+	case ClientCallVector:
+		return CapVector
+	case ClientCallMetadata:
+		return CapMetadata
+	default:
+		return 0
+	}
 }
 
 type benchClient struct {
@@ -57,6 +216,60 @@ type benchClient struct {
 	hexOutBuf      []byte
 	hexCheckBuf    []byte
 	fillTreeArgs   func(node TreeNode) // Storing here avoids one alloc per call.
+	chainDeltas    []int64
+	errors         int64 // Calls that failed with the expected app error.
+	deadlines      int64 // Calls that failed with an expired deadline.
+	lat            latencyHistogram
+
+	// Stream state. items counts the items (or chunks) of every stream,
+	// the callbacks of callback calls and the Nop calls of head-of-line
+	// calls.
+	recvItem func(v int64) error // Storing here avoids one alloc per call.
+	onCall   func(v int64) (int64, error)
+	nextItem int64
+	lastItem time.Time
+	items    int64
+	itemLat  latencyHistogram
+	chunks   [][]byte
+	chunkBuf []byte
+
+	// nopDone is the time each Nop call of a head-of-line call returned.
+	onNop   func(i int)
+	nopDone []time.Time
+
+	// largeIn and largeOut are the buffers of large message calls. They
+	// are only allocated by the cases that need them.
+	largeIn  []byte
+	largeOut []byte
+
+	// records are the records of records calls, along with the names the
+	// server is expected to reply with. They are only created by the cases
+	// that need them.
+	records     []Record
+	recordsOut  []Record
+	upperNames  []string
+	recordsSize int
+
+	// rows are the rows of rows calls. They are only created by the cases
+	// that need them.
+	rows     []Row
+	rowsOut  []Row
+	rowsSize int
+
+	// mapIn and mapOut are the maps of map calls. They are only created by
+	// the cases that need them.
+	mapIn   map[string]int64
+	mapOut  map[string]int64
+	mapSize int
+
+	// vectorIn and vectorOut are the vectors of vector calls. They are only
+	// created by the cases that need them.
+	vectorIn  []float64
+	vectorOut []float64
+
+	// metadata is the metadata of metadata calls. It is only created by
+	// the cases that need it.
+	metadata []MetadataEntry
 }
 
 func (bcli *benchClient) fillRequestTree(node TreeNode) {
@@ -64,19 +277,183 @@ func (bcli *benchClient) fillRequestTree(node TreeNode) {
 	copyTree(tgt, node)
 }
 
+// recvStreamItem checks every item received in a server stream and records the
+// time since the previous item (or since the start of the call, for the first
+// one).
+func (bcli *benchClient) recvStreamItem(v int64) error {
+	now := time.Now()
+	if v != bcli.nextItem {
+		return fmt.Errorf("received stream item %d, want %d", v, bcli.nextItem)
+	}
+	bcli.itemLat.record(now.Sub(bcli.lastItem))
+	bcli.lastItem = now
+	bcli.nextItem++
+	return nil
+}
+
+// recvCallback checks every callback made by the server, records the time since
+// the previous one (or since the start of the call, for the first one) and
+// returns the reply to the server.
+func (bcli *benchClient) recvCallback(v int64) (int64, error) {
+	if err := bcli.recvStreamItem(v); err != nil {
+		return 0, err
+	}
+	return callbackReply(v), nil
+}
+
+// recvNop records the time the Nop call i of a head-of-line call returned.
+// Different Nop calls may return concurrently.
+func (bcli *benchClient) recvNop(i int) {
+	bcli.nopDone[i] = time.Now()
+}
+
+// callbackReply is the reply of clients to a callback made by the server with
+// value v.
+func callbackReply(v int64) int64 {
+	return v * 2
+}
+
 type clientsHarness struct {
+	// clients has one entry per goroutine of the test. When the client is
+	// shared, every entry uses the same Client, but still has its own test
+	// data and buffers.
 	clients []*benchClient
+
+	// connectTime is the total time taken by clients to connect to the
+	// server and complete their first call.
+	connectTime time.Duration
+
+	// slowStarted and slowCanceled are the number of Slow calls of
+	// ClientCallDeadline cases the server started and saw canceled, before
+	// (and, once checked, during) the test.
+	slowStarted  int64
+	slowCanceled int64
+
+	// heap tracks the peak size of the heap during ClientCallLarge cases.
+	heap *heapSampler
 }
 
-func newClientHarness(ctx context.Context, saddr string, fac RPCFactory, nbClients int) (*clientsHarness, error) {
+// slowCalls returns the number of Slow calls the server started and saw
+// canceled.
+func (ch *clientsHarness) slowCalls(ctx context.Context) (started, canceled int64, err error) {
+	dc, ok := ch.clients[0].c.(DeadlineClient)
+	if !ok {
+		return 0, 0, errors.New("client does not implement DeadlineClient")
+	}
+	return dc.SlowCalls(ctx)
+}
+
+// checkSlowCalls checks that the server saw every Slow call of a
+// ClientCallDeadline case it started canceled. The deadline of some calls may
+// expire before they reach the server, so the server may start fewer calls than
+// the ones made by the clients. Servers may also only see the cancellation
+// after the client has returned from the call, so this waits for a while for
+// them to catch up.
+func (ch *clientsHarness) checkSlowCalls(ctx context.Context, bc BenchCase) error {
+	if bc.Call != ClientCallDeadline {
+		return nil
+	}
+	var made int64
+	for _, bcli := range ch.clients {
+		made += bcli.deadlines
+	}
+
+	deadline := time.Now().Add(2 * slowCallDelay)
+	for {
+		started, canceled, err := ch.slowCalls(ctx)
+		if err != nil {
+			return err
+		}
+		started -= ch.slowStarted
+		canceled -= ch.slowCanceled
+		if started > made {
+			return fmt.Errorf("server started %d calls, but only %d were made", started, made)
+		}
+		if canceled == started {
+			ch.slowStarted, ch.slowCanceled = started, canceled
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("server saw %d of %d started calls canceled", canceled, started)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// latencies returns the merged latency histogram of all clients.
+func (ch *clientsHarness) latencies() *latencyHistogram {
+	var h latencyHistogram
+	for _, bcli := range ch.clients {
+		h.merge(&bcli.lat)
+	}
+	return &h
+}
+
+// report adds the metrics tracked by the clients to the benchmark.
+func (ch *clientsHarness) report(b *testing.B, bc BenchCase) {
+	ch.latencies().report(b)
+	if bc.Call == ClientCallServerStream || bc.Call == ClientCallClientStream || bc.Call == ClientCallCallback || bc.Call == ClientCallHeadOfLine {
+		var items int64
+		var itemLat latencyHistogram
+		for _, bcli := range ch.clients {
+			items += bcli.items
+			itemLat.merge(&bcli.itemLat)
+		}
+		unit := "item"
+		switch bc.Call {
+		case ClientCallCallback:
+			unit = "callback"
+		case ClientCallHeadOfLine:
+			unit = "nop"
+		}
+		b.ReportMetric(float64(items)/b.Elapsed().Seconds(), unit+"s/s")
+		itemLat.reportAs(b, unit+"-")
+	}
+	if bc.Call == ClientCallError {
+		var errors int64
+		for _, bcli := range ch.clients {
+			errors += bcli.errors
+		}
+		b.ReportMetric(float64(errors)/b.Elapsed().Seconds(), "errors/s")
+	}
+	if bc.Call == ClientCallDeadline {
+		b.ReportMetric(float64(ch.slowCanceled)/b.Elapsed().Seconds(), "canceled/s")
+	}
+	if ch.heap != nil {
+		b.ReportMetric(float64(ch.heap.peak()), "peak-heap-B")
+	}
+	if bc.TLS != TLSOff {
+		nbConns := len(ch.clients)
+		if bc.SharedClient {
+			nbConns = 1
+		}
+		connectTime := ch.connectTime / time.Duration(nbConns)
+		b.ReportMetric(float64(connectTime.Nanoseconds()), "connect-ns")
+	}
+}
+
+// newClientHarness creates nbClients bench clients. When shared is true, a
+// single Client is created and used by all of them.
+func newClientHarness(ctx context.Context, cfg ClientConfig, fac RPCFactory, nbClients int, shared bool) (*clientsHarness, error) {
 	ch := &clientsHarness{
 		clients: make([]*benchClient, 0, nbClients),
 	}
 
+	var c Client
 	for i := range nbClients {
-		c, err := fac.NewClient(ctx, saddr)
-		if err != nil {
-			return nil, err
+		if c == nil || !shared {
+			// Some clients only connect on their first call, so do
+			// one before the test starts.
+			start := time.Now()
+			var err error
+			c, err = fac.NewClient(ctx, cfg)
+			if err != nil {
+				return nil, err
+			}
+			if err := c.Nop(ctx); err != nil {
+				return nil, err
+			}
+			ch.connectTime += time.Since(start)
 		}
 
 		// Deterministic rng per client.
@@ -117,39 +494,155 @@ func newClientHarness(ctx context.Context, saddr string, fac RPCFactory, nbClien
 			hexOutBuf:   make([]byte, MaxHexEncodeSize*2),
 		}
 		bcli.fillTreeArgs = bcli.fillRequestTree
+		bcli.recvItem = bcli.recvStreamItem
+		bcli.onCall = bcli.recvCallback
+		bcli.onNop = bcli.recvNop
+		bcli.nopDone = make([]time.Time, headOfLineNops)
+		bcli.chunkBuf = make([]byte, clientStreamChunks*clientStreamChunkSize)
+		bcli.chunks = make([][]byte, clientStreamChunks)
+		for i := range bcli.chunks {
+			bcli.chunks[i] = bcli.chunkBuf[i*clientStreamChunkSize : (i+1)*clientStreamChunkSize]
+		}
 		ch.clients = append(ch.clients, bcli)
 	}
 
 	return ch, nil
 }
 
+// warmTLSSessionCache makes one call with a throwaway client, such that the
+// session cache in the client's TLS config holds a ticket to resume sessions
+// with the server.
+func warmTLSSessionCache(ctx context.Context, cfg ClientConfig, fac RPCFactory) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	c, err := fac.NewClient(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	err = c.Nop(ctx)
+	if closer, ok := c.(io.Closer); ok {
+		if closeErr := closer.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+// serverConfig is the configuration of the server of a test.
+type serverConfig struct {
+	network string
+	link    *LinkProfile
+
+	tlsMode TLSMode
+	certPEM []byte // Server certificate chain and key.
+}
+
+// listen creates the listener for the server, on a local address. The returned
+// function removes any resources created for the listener, after it is closed.
+func (cfg serverConfig) listen() (net.Listener, func(), error) {
+	var l net.Listener
+	var err error
+	cleanup := func() {}
+	switch cfg.network {
+	case "tcp":
+		l, err = net.Listen("tcp", "127.0.0.1:0")
+	case "unix":
+		var dir string
+		dir, err = os.MkdirTemp("", "gorpcbench")
+		if err != nil {
+			return nil, nil, err
+		}
+		cleanup = func() { os.RemoveAll(dir) }
+		l, err = net.Listen("unix", filepath.Join(dir, "server.sock"))
+	default:
+		err = fmt.Errorf("unsupported network %q", cfg.network)
+	}
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+
+	if cfg.link != nil {
+		l = NewLinkListener(l, cfg.link)
+	}
+	if cfg.tlsMode != TLSOff {
+		tlsCfg, err := serverTLSConfig(cfg.tlsMode, cfg.certPEM)
+		if err != nil {
+			l.Close()
+			cleanup()
+			return nil, nil, err
+		}
+		l = tls.NewListener(l, tlsCfg)
+	}
+	return l, cleanup, nil
+}
+
 type serverHarness struct {
-	s    Server
-	addr string
+	s       Server
+	network string
+	addr    string
+
+	// proc is set when the server is running in a child process.
+	proc       *serverProcess
+	clientBase procCost
+}
+
+// resetCosts marks the start of the section of the test for which client and
+// server costs are reported.
+func (sh *serverHarness) resetCosts() error {
+	if sh.proc == nil {
+		return nil
+	}
+	if err := sh.proc.resetCost(); err != nil {
+		return err
+	}
+	sh.clientBase = readProcCost()
+	return nil
 }
 
-func newServerHarness(ctx context.Context, t testing.TB, fac RPCFactory) (*serverHarness, error) {
-	l, err := net.Listen("tcp", "127.0.0.1:0")
+// reportCosts reports the per-op client and server costs incurred since the
+// last call to resetCosts. This is only done when the server is running in a
+// child process, given that otherwise the costs of both ends are mingled.
+func (sh *serverHarness) reportCosts(b *testing.B, n int64) error {
+	if sh.proc == nil {
+		return nil
+	}
+	clientCost := readProcCost().sub(sh.clientBase)
+	serverCost, err := sh.proc.cost()
+	if err != nil {
+		return err
+	}
+	clientCost.report(b, "client", n)
+	serverCost.report(b, "server", n)
+	return nil
+}
+
+func newServerHarness(ctx context.Context, t testing.TB, fac RPCFactory, cfg serverConfig) (*serverHarness, error) {
+	l, cleanupListener, err := cfg.listen()
 	if err != nil {
 		return nil, err
 	}
 
 	s, err := fac.NewServer(l)
 	if err != nil {
+		l.Close()
+		cleanupListener()
 		return nil, err
 	}
 
 	sh := &serverHarness{
-		s:    s,
-		addr: l.Addr().String(),
+		s:       s,
+		network: cfg.network,
+		addr:    l.Addr().String(),
 	}
 
 	runChan := make(chan error, 1)
 	go func() { runChan <- s.Run(ctx) }()
 	t.Cleanup(func() {
+		defer cleanupListener()
 		select {
 		case runErr := <-runChan:
-			if runErr != nil && !errors.Is(err, context.Canceled) {
+			if runErr != nil && !errors.Is(runErr, context.Canceled) && !netutils.IsConnClosedErr(runErr) {
 				t.Errorf("Error running server: %v", runErr)
 				if !t.Failed() {
 					t.FailNow()
@@ -162,3 +655,75 @@ func newServerHarness(ctx context.Context, t testing.TB, fac RPCFactory) (*serve
 
 	return sh, nil
 }
+
+func newServerProcHarness(t testing.TB, sys *RPCSystem, cfg serverConfig) (*serverHarness, error) {
+	proc, addr, err := startServerProcess(t, sys, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &serverHarness{proc: proc, network: cfg.network, addr: addr}, nil
+}
+
+// newCaseHarness creates the server and client harnesses for a test case.
+func newCaseHarness(b *testing.B, bc BenchCase, nbClients int) (*serverHarness, *clientsHarness, error) {
+	if err := bc.checkSupported(); err != nil {
+		return nil, nil, err
+	}
+
+	fac := bc.Sys.Initer()
+	ctx := b.Context()
+
+	cfg := serverConfig{network: bc.network(), link: bc.Link, tlsMode: bc.TLS}
+	var clientTLS *tls.Config
+	var tlsResumed atomic.Int64
+	if bc.TLS != TLSOff {
+		certs, err := getTestCerts()
+		if err != nil {
+			return nil, nil, err
+		}
+		cfg.certPEM = certs.pem
+		clientTLS = clientTLSConfig(bc.TLS, certs, &tlsResumed)
+	}
+
+	var sh *serverHarness
+	var err error
+	switch {
+	case bc.ServerAddr != "":
+		sh = &serverHarness{network: cfg.network, addr: bc.ServerAddr}
+	case bc.ServerProcess:
+		sh, err = newServerProcHarness(b, bc.Sys, cfg)
+	default:
+		sh, err = newServerHarness(ctx, b, fac, cfg)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ccfg := ClientConfig{Network: sh.network, Addr: sh.addr, TLSConfig: clientTLS}
+	if bc.TLS == TLSResume {
+		if err := warmTLSSessionCache(ctx, ccfg, fac); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	ch, err := newClientHarness(ctx, ccfg, fac, nbClients, bc.SharedClient)
+	if err != nil {
+		return nil, nil, err
+	}
+	if bc.TLS == TLSResume && tlsResumed.Load() == 0 {
+		// Clients ignored the session cache, so the test would measure
+		// full handshakes.
+		return nil, nil, fmt.Errorf("no TLS sessions were resumed by clients of %s", bc.Sys.Name)
+	}
+	if bc.Call == ClientCallDeadline {
+		// The server may be shared with other tests, so only the calls
+		// canceled from now on are checked.
+		if ch.slowStarted, ch.slowCanceled, err = ch.slowCalls(ctx); err != nil {
+			return nil, nil, err
+		}
+	}
+	if bc.Call == ClientCallLarge {
+		ch.heap = startHeapSampler(ctx)
+	}
+	return sh, ch, nil
+}
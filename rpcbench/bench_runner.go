@@ -7,34 +7,249 @@ package rpcbench
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"hash/crc32"
 	"os"
 	"runtime"
+	"sync/atomic"
 	"testing"
+	"time"
+
+	"github.com/sourcegraph/conc/pool"
 )
 
 type RPCSystem struct {
 	Name   string
 	Initer FactoryIniter
 	Notes  string
+
+	// Caps are the capabilities of the system. Cases that need features
+	// the system does not support are not run.
+	Caps Capability
+
+	// Pending are the capabilities the system is meant to support, but
+	// whose implementation is still pending. Cases that need them are kept
+	// in the test matrix and reported as skipped, so that the gap shows up
+	// in every run instead of the cases silently disappearing.
+	Pending Capability
+
+	// Unsupported are the capabilities the system cannot support, along
+	// with the reason why. Cases that need them are kept in the test matrix
+	// and reported as skipped with the reason, so that the limitation shows
+	// up in every run.
+	Unsupported map[Capability]string
 }
 
 type BenchCase struct {
 	Sys      *RPCSystem
 	Call     ClientCall
 	Parallel bool
+
+	// Clients is the number of clients (each with its own connection to the
+	// server) and of goroutines driving them in parallel and open-loop
+	// cases. Defaults to runtime.GOMAXPROCS(0).
+	Clients int
+
+	// SharedClient makes all goroutines of the case use a single client
+	// (and therefore a single connection) concurrently, instead of one
+	// client each. Only supported by systems with CapMultiplex.
+	SharedClient bool
+
+	// Rate is the target number of calls per second, across all clients.
+	// When non-zero, the case is run in open-loop mode: calls are
+	// scheduled at a constant rate, regardless of how long previous calls
+	// took to complete, and latencies are measured from the time each
+	// call was supposed to be sent.
+	Rate int
+
+	// ServerProcess runs the server in a child process (by re-executing the
+	// test binary), instead of in the same process as the clients. This
+	// allows reporting the client and server costs separately.
+	ServerProcess bool
+
+	// ServerAddr is the address of an already running server (for example,
+	// one started in another host). When set, the harness does not start
+	// a server and only runs the clients, which connect to this address.
+	ServerAddr string
+
+	// Network is the network used by clients to connect to the server
+	// ("tcp" or "unix"). Defaults to "tcp".
+	Network string
+
+	// TLS is the TLS configuration of clients and server.
+	TLS TLSMode
+
+	// Link, when set, is the profile of the network link emulated between
+	// the clients and the server.
+	Link *LinkProfile
+
+	// Window is the maximum number of in-flight messages on each stream of
+	// ClientCallBidiStream cases. Defaults to 16.
+	Window int
+
+	// ChainLength is the number of dependent calls of ClientCallAddChain
+	// cases. Defaults to 4.
+	ChainLength int
+
+	// ErrorFraction is the fraction (between 0 and 1) of the calls of
+	// ClientCallError cases that the server fails. Defaults to 0.1.
+	ErrorFraction float64
+
+	// CallTimeout, when set, is the timeout of the context of every call.
+	// Calls are not expected to hit it, so this measures the overhead of
+	// carrying a deadline on calls. Only supported by systems with
+	// CapCancel.
+	CallTimeout time.Duration
+
+	// Work is the time the server spends handling the Work call of
+	// ClientCallHeadOfLine cases. Defaults to 1ms.
+	Work time.Duration
+
+	// WorkCPU makes the server of ClientCallHeadOfLine cases spend Work
+	// busy on the CPU, instead of sleeping.
+	WorkCPU bool
+
+	// MessageSize is the size of the payload of ClientCallLarge cases.
+	// Defaults to 4 MiB (or the max message size of the run, if smaller).
+	MessageSize int
+
+	// MapSize is the number of entries of the map of ClientCallMap cases.
+	// Defaults to 64.
+	MapSize int
 }
 
 func (bc BenchCase) Name() string {
-	if !bc.Parallel {
-		return fmt.Sprintf("sequential/%s/%s", bc.Call, bc.Sys.Name)
+	var mode string
+	switch {
+	case bc.Rate > 0:
+		mode = fmt.Sprintf("openloop-r%d", bc.Rate)
+	case bc.Parallel:
+		mode = "parallel"
+	default:
+		mode = "sequential"
+	}
+	if bc.Clients > 0 && (bc.Rate > 0 || bc.Parallel) {
+		mode += fmt.Sprintf("-c%d", bc.Clients)
+	}
+	if bc.SharedClient {
+		mode += "-shared"
+	}
+	if bc.ServerProcess {
+		mode += "-proc"
+	}
+	if bc.network() != "tcp" {
+		mode += "-" + bc.Network
+	}
+	if bc.TLS != TLSOff {
+		mode += "-" + bc.TLS.String()
+	}
+	if bc.Link != nil {
+		mode += "-" + bc.Link.Name
+	}
+	if bc.CallTimeout > 0 {
+		mode += "-deadline"
+	}
+	call := bc.Call.String()
+	if bc.Call == ClientCallBidiStream && bc.Window > 0 {
+		call += fmt.Sprintf("-w%d", bc.Window)
+	}
+	if bc.Call == ClientCallAddChain && bc.ChainLength > 0 {
+		call += fmt.Sprintf("-k%d", bc.ChainLength)
+	}
+	if bc.Call == ClientCallError && bc.ErrorFraction > 0 {
+		call += fmt.Sprintf("-f%g", bc.ErrorFraction)
+	}
+	if bc.Call == ClientCallHeadOfLine && bc.WorkCPU {
+		call += "-cpu"
+	}
+	if bc.Call == ClientCallHeadOfLine && bc.Work > 0 {
+		call += fmt.Sprintf("-d%dus", bc.Work.Microseconds())
+	}
+	if bc.Call == ClientCallLarge && bc.MessageSize > 0 {
+		call += "-" + formatSize(bc.MessageSize)
+	}
+	if bc.Call == ClientCallMap && bc.MapSize > 0 {
+		call += fmt.Sprintf("-n%d", bc.MapSize)
+	}
+	return fmt.Sprintf("%s/%s/%s", mode, call, bc.Sys.Name)
+}
+
+func (bc BenchCase) network() string {
+	if bc.Network == "" {
+		return "tcp"
+	}
+	return bc.Network
+}
+
+func (bc BenchCase) nbClients() int {
+	if bc.Clients > 0 {
+		return bc.Clients
+	}
+	return runtime.GOMAXPROCS(0)
+}
+
+func (bc BenchCase) window() int {
+	if bc.Window > 0 {
+		return bc.Window
 	}
-	return fmt.Sprintf("parallel/%s/%s", bc.Call, bc.Sys.Name)
+	return defaultBidiWindow
+}
+
+func (bc BenchCase) chainLength() int {
+	if bc.ChainLength > 0 {
+		return bc.ChainLength
+	}
+	return defaultChainLength
+}
+
+func (bc BenchCase) errorFraction() float64 {
+	if bc.ErrorFraction > 0 {
+		return bc.ErrorFraction
+	}
+	return defaultErrorFraction
+}
+
+func (bc BenchCase) work() Work {
+	w := Work{Duration: bc.Work, CPU: bc.WorkCPU}
+	if w.Duration <= 0 {
+		w.Duration = defaultWork
+	}
+	return w
+}
+
+func (bc BenchCase) messageSize() int {
+	if bc.MessageSize > 0 {
+		return bc.MessageSize
+	}
+	return min(defaultLargeMessageSize, MaxMessageSize())
+}
+
+func (bc BenchCase) mapSize() int {
+	if bc.MapSize > 0 {
+		return bc.MapSize
+	}
+	return defaultMapSize
+}
+
+// callContext returns the context of the calls made under ctx. Calls are only
+// meant to be interrupted by the timeout of the cases that set one, so they are
+// made with a context that cannot be canceled, which spares systems from
+// watching for its cancellation on every call. Clients are still closed once
+// the test ends.
+func callContext(ctx context.Context) context.Context {
+	return context.WithoutCancel(ctx)
 }
 
 func makeCall(ctx context.Context, bc BenchCase, bcli *benchClient) (int, error) {
+	if bc.CallTimeout > 0 {
+		var cancel func()
+		ctx, cancel = context.WithTimeout(ctx, bc.CallTimeout)
+		defer cancel()
+	}
+
 	switch bc.Call {
 	case ClientCallNop:
 		return 0, bcli.c.Nop(ctx)
@@ -94,101 +309,614 @@ func makeCall(ctx context.Context, bc BenchCase, bcli *benchClient) (int, error)
 		}
 		return len(bcli.hexInBuf) + len(bcli.hexOutBuf), nil
 
+	case ClientCallServerStream:
+		ssc, ok := bcli.c.(ServerStreamClient)
+		if !ok {
+			return 0, errors.New("client does not implement ServerStreamClient")
+		}
+		start := int64(bcli.rng.Uint32())
+		bcli.nextItem = start
+		bcli.lastItem = time.Now()
+		if err := ssc.ServerStream(ctx, start, serverStreamItems, bcli.recvItem); err != nil {
+			return 0, err
+		}
+		if got := bcli.nextItem - start; got != serverStreamItems {
+			return 0, fmt.Errorf("received %d stream items, want %d", got, serverStreamItems)
+		}
+		bcli.items += serverStreamItems
+		return serverStreamItems * 8, nil
+
+	case ClientCallClientStream:
+		csc, ok := bcli.c.(ClientStreamClient)
+		if !ok {
+			return 0, errors.New("client does not implement ClientStreamClient")
+		}
+		bcli.rngReader.Read(bcli.chunkBuf)
+		res, err := csc.ClientStream(ctx, bcli.chunks)
+		if err != nil {
+			return 0, err
+		}
+		if want := crc32.ChecksumIEEE(bcli.chunkBuf); res != want {
+			return 0, fmt.Errorf("wrong checksum: got %08x, want %08x", res, want)
+		}
+		bcli.items += clientStreamChunks
+		return len(bcli.chunkBuf), nil
+
+	case ClientCallBidiStream:
+		return 0, errors.New("bidi stream cases are not run through makeCall()")
+
+	case ClientCallAddChain:
+		if len(bcli.chainDeltas) != bc.chainLength() {
+			bcli.chainDeltas = make([]int64, bc.chainLength())
+		}
+		start := bcli.rng.Int64()
+		want := start
+		for i := range bcli.chainDeltas {
+			bcli.chainDeltas[i] = bcli.rng.Int64()
+			want += bcli.chainDeltas[i]
+		}
+
+		// Systems that support pipelining make the whole chain at
+		// once. Others need one round trip per call.
+		res := start
+		if pc, ok := bcli.c.(PipelineClient); ok && bc.Sys.Caps.Has(CapPipeline) {
+			var err error
+			if res, err = pc.AddChain(ctx, start, bcli.chainDeltas); err != nil {
+				return 0, err
+			}
+		} else {
+			for _, delta := range bcli.chainDeltas {
+				var err error
+				if res, err = bcli.c.Add(ctx, res, delta); err != nil {
+					return 0, err
+				}
+			}
+		}
+		if res != want {
+			return 0, fmt.Errorf("wrong chain result: got %v, want %v", res, want)
+		}
+		return 0, nil
+
+	case ClientCallCallback:
+		cc, ok := bcli.c.(CallbackClient)
+		if !ok {
+			return 0, errors.New("client does not implement CallbackClient")
+		}
+		start := int64(bcli.rng.Uint32())
+		var want int64
+		for i := range int64(callbackCount) {
+			want += callbackReply(start + i)
+		}
+		bcli.nextItem = start
+		bcli.lastItem = time.Now()
+		res, err := cc.Callback(ctx, start, callbackCount, bcli.onCall)
+		if err != nil {
+			return 0, err
+		}
+		if got := bcli.nextItem - start; got != callbackCount {
+			return 0, fmt.Errorf("received %d callbacks, want %d", got, callbackCount)
+		}
+		if res != want {
+			return 0, fmt.Errorf("wrong callback sum: got %d, want %d", res, want)
+		}
+		bcli.items += callbackCount
+		return callbackCount * 16, nil
+
+	case ClientCallError:
+		ec, ok := bcli.c.(ErrorClient)
+		if !ok {
+			return 0, errors.New("client does not implement ErrorClient")
+		}
+		v := bcli.rng.Int64()
+		var code AppErrorCode
+		if bcli.rng.Float64() < bc.errorFraction() {
+			codes := AppErrorCodes()
+			code = codes[bcli.rng.IntN(len(codes))]
+		}
+		res, err := ec.Validate(ctx, v, code)
+		if code == 0 {
+			if err != nil {
+				return 0, err
+			}
+			if res != v {
+				return 0, fmt.Errorf("wrong validated value: got %d, want %d", res, v)
+			}
+			return 16, nil
+		}
+
+		var appErr *AppError
+		if !errors.As(err, &appErr) {
+			return 0, fmt.Errorf("call did not fail with an app error: %v", err)
+		}
+		if want := RejectValue(code, v); *appErr != *want {
+			return 0, fmt.Errorf("wrong app error: got %q, want %q", appErr, want)
+		}
+		bcli.errors++
+		return 16, nil
+
+	case ClientCallDeadline:
+		dc, ok := bcli.c.(DeadlineClient)
+		if !ok {
+			return 0, errors.New("client does not implement DeadlineClient")
+		}
+		callCtx, cancel := context.WithTimeout(ctx, slowCallTimeout)
+		start := time.Now()
+		err := dc.Slow(callCtx, slowCallDelay)
+		elapsed := time.Since(start)
+		cancel()
+		if !errors.Is(err, context.DeadlineExceeded) {
+			return 0, fmt.Errorf("call did not fail with an expired deadline: %v", err)
+		}
+		if elapsed >= slowCallDelay {
+			return 0, fmt.Errorf("call took %s to return after its deadline expired", elapsed)
+		}
+		bcli.deadlines++
+
+		// Clients of some systems need a new conn after a call is
+		// interrupted. Make a call without the short deadline right
+		// away, so that reconnecting is not charged to the deadline of
+		// the next call (which could then expire before the server
+		// sees it). This is part of the cost of the op.
+		if err := bcli.c.Nop(ctx); err != nil {
+			return 0, err
+		}
+		return 0, nil
+
+	case ClientCallHeadOfLine:
+		hc, ok := bcli.c.(HeadOfLineClient)
+		if !ok {
+			return 0, errors.New("client does not implement HeadOfLineClient")
+		}
+		clear(bcli.nopDone)
+		start := time.Now()
+		if err := hc.WorkWithNops(ctx, bc.work(), headOfLineNops, bcli.onNop); err != nil {
+			return 0, err
+		}
+
+		// Every Nop call is sent along with the Work call, so its
+		// latency is measured from the start of the call.
+		for i, done := range bcli.nopDone {
+			if done.IsZero() {
+				return 0, fmt.Errorf("nop call %d did not return", i)
+			}
+			bcli.itemLat.record(done.Sub(start))
+		}
+		bcli.items += headOfLineNops
+		return 0, nil
+
+	case ClientCallLarge:
+		lc, ok := bcli.c.(LargeClient)
+		if !ok {
+			return 0, errors.New("client does not implement LargeClient")
+		}
+		size := bc.messageSize()
+		if len(bcli.largeIn) != size {
+			bcli.largeIn = make([]byte, size)
+			bcli.largeOut = make([]byte, size)
+			bcli.rngReader.Read(bcli.largeIn)
+		}
+
+		// Filling the whole payload would dominate the cost of the
+		// call, so only its ends change between calls.
+		binary.LittleEndian.PutUint64(bcli.largeIn, bcli.rng.Uint64())
+		binary.LittleEndian.PutUint64(bcli.largeIn[size-8:], bcli.rng.Uint64())
+		if err := lc.Echo(ctx, bcli.largeIn, bcli.largeOut); err != nil {
+			return 0, err
+		}
+		if !bytes.Equal(bcli.largeOut, bcli.largeIn) {
+			return 0, errors.New("mismatch in request and response payloads")
+		}
+		return 2 * size, nil
+
+	case ClientCallRecords:
+		rc, ok := bcli.c.(RecordsClient)
+		if !ok {
+			return 0, errors.New("client does not implement RecordsClient")
+		}
+		if bcli.records == nil {
+			bcli.records = makeRecords(bcli.rng, recordsPerCall)
+			bcli.recordsOut = make([]Record, recordsPerCall)
+			bcli.upperNames = make([]string, recordsPerCall)
+			for i, r := range bcli.records {
+				bcli.upperNames[i] = UpperName(r.Name)
+			}
+			bcli.recordsSize = recordsSize(bcli.records)
+		}
+		for i := range bcli.records {
+			bcli.records[i].ID = bcli.rng.Int64()
+		}
+		clear(bcli.recordsOut)
+		if err := rc.UpperRecords(ctx, bcli.records, bcli.recordsOut); err != nil {
+			return 0, err
+		}
+		if err := checkRecords(bcli.records, bcli.recordsOut, bcli.upperNames); err != nil {
+			return 0, err
+		}
+		return 2 * bcli.recordsSize, nil
+
+	case ClientCallRows:
+		rc, ok := bcli.c.(RowsClient)
+		if !ok {
+			return 0, errors.New("client does not implement RowsClient")
+		}
+		if bcli.rows == nil {
+			bcli.rows = makeRows(bcli.rng, rowsPerCall)
+			bcli.rowsOut = make([]Row, rowsPerCall)
+			bcli.rowsSize = rowsSize(bcli.rows)
+		}
+		for i := range bcli.rows {
+			bcli.rows[i].ID = bcli.rng.Int64()
+			bcli.rows[i].Version = bcli.rng.Int32N(1 << 30)
+		}
+		clear(bcli.rowsOut)
+		if err := rc.UpdateRows(ctx, bcli.rows, bcli.rowsOut); err != nil {
+			return 0, err
+		}
+		if err := checkRows(bcli.rows, bcli.rowsOut); err != nil {
+			return 0, err
+		}
+		return 2 * bcli.rowsSize, nil
+
+	case ClientCallMap:
+		mc, ok := bcli.c.(MapClient)
+		if !ok {
+			return 0, errors.New("client does not implement MapClient")
+		}
+		if n := bc.mapSize(); len(bcli.mapIn) != n {
+			bcli.mapIn = makeMap(bcli.rng, n)
+			bcli.mapOut = make(map[string]int64, n)
+			bcli.mapSize = mapSize(bcli.mapIn)
+		}
+		randomizeMap(bcli.rng, bcli.mapIn)
+		clear(bcli.mapOut)
+		if err := mc.IncrementMap(ctx, bcli.mapIn, bcli.mapOut); err != nil {
+			return 0, err
+		}
+		if err := checkMap(bcli.mapIn, bcli.mapOut); err != nil {
+			return 0, err
+		}
+		return 2 * bcli.mapSize, nil
+
+	case ClientCallVector:
+		vc, ok := bcli.c.(VectorClient)
+		if !ok {
+			return 0, errors.New("client does not implement VectorClient")
+		}
+		if bcli.vectorIn == nil {
+			bcli.vectorIn = makeVector(bcli.rng, vectorLen)
+			bcli.vectorOut = make([]float64, vectorLen)
+		}
+		scale := bcli.rng.NormFloat64()
+		clear(bcli.vectorOut)
+		if err := vc.ScaleVector(ctx, bcli.vectorIn, scale, bcli.vectorOut); err != nil {
+			return 0, err
+		}
+		if err := checkVector(bcli.vectorIn, scale, bcli.vectorOut); err != nil {
+			return 0, err
+		}
+		return 2 * 8 * vectorLen, nil
+
+	case ClientCallMetadata:
+		mc, ok := bcli.c.(MetadataClient)
+		if !ok {
+			return 0, errors.New("client does not implement MetadataClient")
+		}
+		if bcli.metadata == nil {
+			bcli.metadata = makeMetadata(bcli.rng)
+		}
+		traceID := setTraceID(bcli.rng, bcli.metadata)
+		echo, err := mc.NopWithMetadata(ctx, bcli.metadata)
+		if err != nil {
+			return 0, err
+		}
+		if echo != traceID {
+			return 0, fmt.Errorf("wrong echoed metadata: got %q, want %q", echo, traceID)
+		}
+		return metadataSize(bcli.metadata) + len(echo), nil
+
 	default:
 		return 0, fmt.Errorf("unknown call in makeCall(): %d", bc.Call)
 	}
 }
 
 func runSequentialBench(b *testing.B, bc BenchCase) error {
-	fac := bc.Sys.Initer()
-
 	ctx := b.Context()
-	sh, err := newServerHarness(ctx, b, fac)
+	sh, ch, err := newCaseHarness(b, bc, 1)
 	if err != nil {
 		return err
 	}
 
-	ch, err := newClientHarness(ctx, sh.addr, fac, 1)
-	if err != nil {
+	b.ReportAllocs()
+	if err := sh.resetCosts(); err != nil {
 		return err
 	}
 
-	b.ReportAllocs()
-
 	var N, totalBytes int64
+	bcli := ch.clients[0]
+	callCtx := callContext(ctx)
 	for b.Loop() {
-		if bytes, err := makeCall(ctx, bc, ch.clients[0]); err != nil {
+		start := time.Now()
+		if bytes, err := makeCall(callCtx, bc, bcli); err != nil {
 			return err
 		} else {
 			totalBytes += int64(bytes)
 		}
+		bcli.lat.record(time.Since(start))
 		N++
 	}
 
 	b.SetBytes(totalBytes / N)
+	if err := ch.checkSlowCalls(ctx, bc); err != nil {
+		return err
+	}
+	ch.report(b, bc)
 
-	return nil
+	return sh.reportCosts(b, N)
 }
 
+// runParallelBench runs the calls concurrently, with one goroutine driving each
+// client of the harness.
 func runParallelBench(b *testing.B, bc BenchCase) error {
-	fac := bc.Sys.Initer()
-
 	ctx := b.Context()
-	sh, err := newServerHarness(ctx, b, fac)
+	sh, ch, err := newCaseHarness(b, bc, bc.nbClients())
 	if err != nil {
 		return err
 	}
 
-	nbClients := runtime.GOMAXPROCS(0)
-	ch, err := newClientHarness(ctx, sh.addr, fac, nbClients)
-	if err != nil {
+	var nextCall, totalBytes atomic.Int64
+
+	b.ReportAllocs()
+	if err := sh.resetCosts(); err != nil {
 		return err
 	}
-
-	clients := make(chan *benchClient, nbClients)
-	for i := range ch.clients {
-		clients <- ch.clients[i]
+	b.ResetTimer()
+
+	g := pool.New().WithContext(ctx).WithCancelOnError().WithFirstError()
+	for _, c := range ch.clients {
+		g.Go(func(ctx context.Context) error {
+			var clientBytes int64
+			callCtx := callContext(ctx)
+
+			// Calls stop once another client fails.
+			for ctx.Err() == nil && nextCall.Add(1) <= int64(b.N) {
+				start := time.Now()
+				bytes, err := makeCall(callCtx, bc, c)
+				if err != nil {
+					return err
+				}
+				c.lat.record(time.Since(start))
+				clientBytes += int64(bytes)
+			}
+			totalBytes.Add(clientBytes)
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return err
 	}
 
-	type procTotals struct {
-		N          int64
-		totalBytes int64
+	b.StopTimer()
+	b.SetBytes(totalBytes.Load() / int64(b.N))
+	if err := ch.checkSlowCalls(ctx, bc); err != nil {
+		return err
 	}
-	totalsChan := make(chan procTotals, nbClients)
+	ch.report(b, bc)
 
-	b.ReportAllocs()
+	return sh.reportCosts(b, int64(b.N))
+}
 
-	b.RunParallel(func(p *testing.PB) {
-		var N, totalBytes int64
-		c := <-clients
-		for p.Next() {
-			if bytes, err := makeCall(ctx, bc, c); err != nil {
-				b.Fatal(err)
-			} else {
-				totalBytes += int64(bytes)
+// paceCalls sends the indices of n calls on the (unbuffered) calls channel,
+// each one at its intended send time (start + i*interval), and then closes it.
+// Calls are only handed out once a client is available to make them. This
+// stops early (closing the channel) once ctx is done.
+//
+// A single goroutine (sleeping on a timer) paces every call, so waiting for
+// the send times does not take CPU time from the system being tested. When the
+// interval is below the resolution of timers, calls are handed out in small
+// bursts, each one at (or right after) its intended time.
+func paceCalls(ctx context.Context, calls chan<- int64, start time.Time, interval time.Duration, n int64) {
+	defer close(calls)
+	timer := newStoppedTimer()
+	defer timer.Stop()
+	for i := range n {
+		if wait := time.Until(start.Add(time.Duration(i) * interval)); wait > 0 {
+			timer.Reset(wait)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				return
 			}
-			N++
 		}
-		totalsChan <- procTotals{N: N, totalBytes: totalBytes}
+		select {
+		case calls <- i:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// runOpenLoopBench runs the calls at a constant rate (bc.Rate), spread across
+// the clients of the harness.
+//
+// The time at which each call should be sent is fixed in advance, and calls
+// are handed out to the clients at that time by paceCalls. When every client is
+// still busy by the time a call should be sent, the call is delayed until a
+// client becomes available, but its latency is still measured from its
+// intended send time. This avoids the coordinated omission problem, where a
+// slow system ends up being tested at a lower load than the target one.
+func runOpenLoopBench(b *testing.B, bc BenchCase) error {
+	ctx := b.Context()
+	sh, ch, err := newCaseHarness(b, bc, bc.nbClients())
+	if err != nil {
+		return err
+	}
+
+	interval := time.Second / time.Duration(bc.Rate)
+	var totalBytes atomic.Int64
+
+	b.ReportAllocs()
+	if err := sh.resetCosts(); err != nil {
+		return err
+	}
+	b.ResetTimer()
+
+	start := time.Now()
+	g := pool.New().WithContext(ctx).WithCancelOnError().WithFirstError()
+	calls := make(chan int64)
+	g.Go(func(ctx context.Context) error {
+		paceCalls(ctx, calls, start, interval, int64(b.N))
+		return nil
 	})
+	for _, c := range ch.clients {
+		g.Go(func(ctx context.Context) error {
+			callCtx := callContext(ctx)
+			for i := range calls {
+				intended := start.Add(time.Duration(i) * interval)
+				bytes, err := makeCall(callCtx, bc, c)
+				if err != nil {
+					return err
+				}
+				c.lat.record(time.Since(intended))
+				totalBytes.Add(int64(bytes))
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return err
+	}
+	elapsed := time.Since(start)
 
-	var N int64
-	var totalBytes int64
-	for range nbClients {
-		tot := <-totalsChan
-		N += tot.N
-		totalBytes += tot.totalBytes
+	b.StopTimer()
+	b.SetBytes(totalBytes.Load() / int64(b.N))
+	b.ReportMetric(float64(b.N)/elapsed.Seconds(), "calls/s")
+	if err := ch.checkSlowCalls(ctx, bc); err != nil {
+		return err
 	}
-	b.SetBytes(totalBytes / N)
+	ch.report(b, bc)
 
-	return nil
+	return sh.reportCosts(b, int64(b.N))
+}
 
+// runBidiStreamBench runs the ClientCallBidiStream cases, where each op is one
+// message echoed back by the server.
+//
+// Every client opens a single stream, used for the whole test. One goroutine
+// per client sends the messages, keeping at most bc.window() of them in flight,
+// while another receives their echoes and records the round-trip latency.
+func runBidiStreamBench(b *testing.B, bc BenchCase) error {
+	ctx := b.Context()
+	nbClients := 1
+	if bc.Parallel {
+		nbClients = bc.nbClients()
+	}
+	sh, ch, err := newCaseHarness(b, bc, nbClients)
+	if err != nil {
+		return err
+	}
+
+	// Errors are not returned by one end of the stream while the other is
+	// blocked, so abort the streams when either one fails.
+	streamCtx, cancelStreams := context.WithCancel(ctx)
+	defer cancelStreams()
+	streams := make([]BidiStream, len(ch.clients))
+	for i, bcli := range ch.clients {
+		bsc, ok := bcli.c.(BidiStreamClient)
+		if !ok {
+			return errors.New("client does not implement BidiStreamClient")
+		}
+		if streams[i], err = bsc.OpenBidiStream(streamCtx); err != nil {
+			return err
+		}
+	}
+
+	window := bc.window()
+	var nextMsg atomic.Int64
+
+	b.ReportAllocs()
+	if err := sh.resetCosts(); err != nil {
+		return err
+	}
+	b.ResetTimer()
+
+	start := time.Now()
+	g := pool.New().WithContext(ctx).WithCancelOnError().WithFirstError()
+	for i, bcli := range ch.clients {
+		stream := streams[i]
+
+		// The sender hands one slot to the receiver for every message,
+		// which bounds the number of messages in flight to window. The
+		// send time of a message is stored in the ring, as an offset
+		// from start.
+		slots := make(chan struct{}, window-1)
+		sentAt := make([]atomic.Int64, window)
+
+		g.Go(func(context.Context) error {
+			defer close(slots)
+			for seq := int64(0); nextMsg.Add(1) <= int64(b.N); seq++ {
+				select {
+				case slots <- struct{}{}:
+				case <-streamCtx.Done():
+					return streamCtx.Err()
+				}
+				sentAt[seq%int64(window)].Store(int64(time.Since(start)))
+				if err := stream.Send(seq); err != nil {
+					cancelStreams()
+					return err
+				}
+			}
+			return nil
+		})
+		g.Go(func(context.Context) error {
+			var seq int64
+			for range slots {
+				v, err := stream.Recv()
+				if err != nil {
+					cancelStreams()
+					return err
+				}
+				if v != seq {
+					cancelStreams()
+					return fmt.Errorf("received echo %d, want %d", v, seq)
+				}
+				sendTime := time.Duration(sentAt[seq%int64(window)].Load())
+				bcli.lat.record(time.Since(start) - sendTime)
+				seq++
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return err
+	}
+	elapsed := time.Since(start)
+
+	b.StopTimer()
+	for _, stream := range streams {
+		if err := stream.Close(); err != nil {
+			return err
+		}
+	}
+	b.SetBytes(16)
+	b.ReportMetric(float64(b.N)/elapsed.Seconds(), "msgs/s")
+	ch.report(b, bc)
+
+	return sh.reportCosts(b, int64(b.N))
 }
 
 func RunCase(b *testing.B, bc BenchCase) error {
-	if !bc.Parallel {
-		return runSequentialBench(b, bc)
+	if reason := bc.SkipReason(); reason != "" {
+		b.Skip(reason)
 	}
 
-	return runParallelBench(b, bc)
+	switch {
+	case bc.Call == ClientCallBidiStream:
+		return runBidiStreamBench(b, bc)
+	case bc.Rate > 0:
+		return runOpenLoopBench(b, bc)
+	case bc.Parallel:
+		return runParallelBench(b, bc)
+	default:
+		return runSequentialBench(b, bc)
+	}
 }
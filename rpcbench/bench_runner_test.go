@@ -0,0 +1,66 @@
+// Copyright (c) 2025 Matheus Degiovani
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcbench
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// TestPaceCalls tests that calls are handed out in order, and not before their
+// intended send time.
+func TestPaceCalls(t *testing.T) {
+	tests := []struct {
+		name     string
+		interval time.Duration
+		n        int64
+	}{
+		{name: "below timer resolution", interval: 10 * time.Microsecond, n: 1000},
+		{name: "1ms", interval: time.Millisecond, n: 50},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := make(chan int64)
+			start := time.Now()
+			go paceCalls(t.Context(), calls, start, tc.interval, tc.n)
+
+			var want int64
+			for i := range calls {
+				if i != want {
+					t.Fatalf("unexpected call: got %d, want %d", i, want)
+				}
+				if early := time.Until(start.Add(time.Duration(i) * tc.interval)); early > 0 {
+					t.Fatalf("call %d handed out %v early", i, early)
+				}
+				want++
+			}
+			if want != tc.n {
+				t.Fatalf("unexpected number of calls: got %d, want %d", want, tc.n)
+			}
+		})
+	}
+}
+
+// TestPaceCallsCanceled tests that pacing stops once its context is done.
+func TestPaceCallsCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	calls := make(chan int64)
+	go paceCalls(ctx, calls, time.Now(), time.Hour, 10)
+
+	if i := <-calls; i != 0 {
+		t.Fatalf("unexpected first call %d", i)
+	}
+	cancel()
+	select {
+	case i, ok := <-calls:
+		if ok {
+			t.Fatalf("unexpected call %d after cancellation", i)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("calls were not closed after cancellation")
+	}
+}
@@ -0,0 +1,290 @@
+// Copyright (c) 2025 Matheus Degiovani
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcbench
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Capability is a set of features supported by the implementation of an RPC
+// system. Test cases that depend on a feature are only run for systems that
+// declare the corresponding capability.
+//
+// Features that require calls beyond the ones in [Client] are implemented by
+// clients through optional interfaces, which are asserted on by the cases that
+// need them.
+type Capability uint32
+
+const (
+	// CapMultiplex means a single client is safe for concurrent use by
+	// multiple goroutines, by multiplexing calls through a single
+	// connection.
+	CapMultiplex Capability = 1 << iota
+
+	// CapServerStream means the server can stream a sequence of replies
+	// for a single request.
+	CapServerStream
+
+	// CapClientStream means the client can stream a sequence of requests
+	// that get a single reply.
+	CapClientStream
+
+	// CapBidiStream means client and server can stream messages in both
+	// directions at the same time.
+	CapBidiStream
+
+	// CapPipeline means calls can be made on the (not yet returned) results
+	// of previous calls, without waiting for them.
+	CapPipeline
+
+	// CapCallback means the server can call back into the client while
+	// handling a call.
+	CapCallback
+
+	// CapAppError means servers can fail calls with an application error,
+	// whose code and message are returned to the client.
+	CapAppError
+
+	// CapTLS means clients and server can communicate through TLS
+	// connections.
+	CapTLS
+
+	// CapCancel means calls honor the cancellation and deadline of their
+	// context.
+	CapCancel
+
+	// CapOverlap means calls can be sent on a connection while previous
+	// ones are still in flight, without waiting for their replies.
+	CapOverlap
+
+	// CapLargeMessage means clients and server accept messages of up to
+	// [MaxMessageSize].
+	CapLargeMessage
+
+	// CapRecords means calls can carry lists of structured records, with
+	// string fields that hold multi-byte UTF-8 characters.
+	CapRecords
+
+	// CapRows means calls can carry lists of wide rows, with fields of
+	// mixed types, half of which are optional and must round-trip as
+	// unset.
+	CapRows
+
+	// CapMap means calls can carry maps, with string keys.
+	CapMap
+
+	// CapVector means calls can carry vectors of float64s, including NaN
+	// and ±Inf values.
+	CapVector
+
+	// CapMetadata means calls can carry metadata (key/values), separate
+	// from their payload.
+	CapMetadata
+
+	// capEnd is the end of the list of capabilities.
+	capEnd
+)
+
+func (c Capability) String() string {
+	switch c {
+	case CapMultiplex:
+		return "multiplex"
+	case CapServerStream:
+		return "sstream"
+	case CapClientStream:
+		return "cstream"
+	case CapBidiStream:
+		return "bidi"
+	case CapPipeline:
+		return "pipeline"
+	case CapCallback:
+		return "callback"
+	case CapAppError:
+		return "apperr"
+	case CapTLS:
+		return "tls"
+	case CapCancel:
+		return "cancel"
+	case CapOverlap:
+		return "overlap"
+	case CapLargeMessage:
+		return "large"
+	case CapRecords:
+		return "records"
+	case CapRows:
+		return "rows"
+	case CapMap:
+		return "map"
+	case CapVector:
+		return "vector"
+	case CapMetadata:
+		return "metadata"
+	}
+
+	var names []string
+	for _, one := range AllCapabilities() {
+		if c.Has(one) {
+			names = append(names, one.String())
+		}
+	}
+	return strings.Join(names, ",")
+}
+
+// Has returns true if every capability in other is also in c.
+func (c Capability) Has(other Capability) bool {
+	return c&other == other
+}
+
+// AllCapabilities returns the list of individual capabilities.
+func AllCapabilities() []Capability {
+	var caps []Capability
+	for c := Capability(1); c < capEnd; c <<= 1 {
+		caps = append(caps, c)
+	}
+	return caps
+}
+
+// requiredCaps returns the capabilities a system needs to run the case.
+func (bc BenchCase) requiredCaps() Capability {
+	caps := bc.Call.requiredCaps()
+	if bc.SharedClient {
+		caps |= CapMultiplex
+	}
+	if bc.TLS != TLSOff {
+		caps |= CapTLS
+	}
+	if bc.CallTimeout > 0 {
+		caps |= CapCancel
+	}
+	return caps
+}
+
+// Supported returns true if the system of the case supports every feature
+// needed to run it, and its call can be run in the mode of the case.
+func (bc BenchCase) Supported() bool {
+	return bc.checkSupported() == nil
+}
+
+// PendingCaps returns the capabilities needed to run the case whose
+// implementation is still pending in its system (see [RPCSystem.Pending]). It
+// returns zero when the case is supported, or when it cannot be run for other
+// reasons.
+func (bc BenchCase) PendingCaps() Capability {
+	missing := bc.requiredCaps() &^ bc.Sys.Caps
+	if missing == 0 || !bc.Sys.Pending.Has(missing) {
+		return 0
+	}
+
+	// The case must be supported once the capabilities are implemented.
+	sys := *bc.Sys
+	sys.Caps |= missing
+	bc.Sys = &sys
+	if !bc.Supported() {
+		return 0
+	}
+	return missing
+}
+
+// UnsupportedCaps returns the capabilities needed to run the case that its
+// system cannot support (see [RPCSystem.Unsupported]). It returns zero when the
+// case is supported, or when it cannot be run for other reasons.
+func (bc BenchCase) UnsupportedCaps() Capability {
+	missing := bc.requiredCaps() &^ bc.Sys.Caps
+	if missing == 0 {
+		return 0
+	}
+	for _, c := range AllCapabilities() {
+		if _, ok := bc.Sys.Unsupported[c]; missing.Has(c) && !ok {
+			return 0
+		}
+	}
+
+	// The case must be supported if the system supported the capabilities.
+	sys := *bc.Sys
+	sys.Caps |= missing
+	bc.Sys = &sys
+	if !bc.Supported() {
+		return 0
+	}
+	return missing
+}
+
+// SkipReason returns the reason why the case is reported as skipped: either
+// its implementation is pending in its system, or its system cannot support
+// it. It returns an empty string for every other case.
+func (bc BenchCase) SkipReason() string {
+	if caps := bc.PendingCaps(); caps != 0 {
+		return fmt.Sprintf("system %s has not implemented %s yet", bc.Sys.Name, caps)
+	}
+	caps := bc.UnsupportedCaps()
+	if caps == 0 {
+		return ""
+	}
+	var reasons []string
+	for _, c := range AllCapabilities() {
+		if caps.Has(c) {
+			reasons = append(reasons, bc.Sys.Unsupported[c])
+		}
+	}
+	return fmt.Sprintf("system %s does not support %s: %s", bc.Sys.Name, caps,
+		strings.Join(reasons, "; "))
+}
+
+// checkSupported returns an error if the case cannot be run.
+func (bc BenchCase) checkSupported() error {
+	if bc.Call == ClientCallBidiStream && bc.Rate > 0 {
+		// The load of bidi stream cases is set by their window.
+		return errors.New("bidi stream cases cannot be run in open-loop mode")
+	}
+	if bc.Call == ClientCallBidiStream && bc.CallTimeout > 0 {
+		// Streams are long-lived, so they are not opened with a timeout.
+		return errors.New("bidi stream cases cannot be run with a call timeout")
+	}
+	if bc.Call == ClientCallLarge && bc.messageSize() > MaxMessageSize() {
+		return fmt.Errorf("message size %d is larger than the max message size %d", bc.messageSize(), MaxMessageSize())
+	}
+	if missing := bc.requiredCaps() &^ bc.Sys.Caps; missing != 0 {
+		return fmt.Errorf("system %s does not support %s", bc.Sys.Name, missing)
+	}
+	return nil
+}
+
+// WriteCapabilityTable writes a table (in markdown format) with the
+// capabilities of each system. Capabilities whose implementation is pending are
+// marked as "todo".
+func WriteCapabilityTable(w io.Writer, systems []RPCSystem) error {
+	caps := AllCapabilities()
+	var b strings.Builder
+	b.WriteString("| System |")
+	for _, c := range caps {
+		fmt.Fprintf(&b, " %s |", c)
+	}
+	b.WriteString(" Notes |\n|---|")
+	for range caps {
+		b.WriteString(":---:|")
+	}
+	b.WriteString("---|\n")
+
+	for _, sys := range systems {
+		fmt.Fprintf(&b, "| %s |", sys.Name)
+		for _, c := range caps {
+			mark := "-"
+			switch {
+			case sys.Caps.Has(c):
+				mark = "yes"
+			case sys.Pending.Has(c):
+				mark = "todo"
+			}
+			fmt.Fprintf(&b, " %s |", mark)
+		}
+		fmt.Fprintf(&b, " %s |\n", sys.Notes)
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2025 Matheus Degiovani
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcbench
+
+import "testing"
+
+// TestPendingCaps tests which cases are reported as pending in their system.
+func TestPendingCaps(t *testing.T) {
+	sys := &RPCSystem{
+		Name:    "test",
+		Caps:    CapTLS | CapCancel,
+		Pending: CapServerStream | CapBidiStream | CapMultiplex,
+	}
+
+	tests := []struct {
+		name string
+		bc   BenchCase
+		want Capability
+	}{{
+		name: "supported",
+		bc:   BenchCase{Call: ClientCallNop},
+		want: 0,
+	}, {
+		name: "pending",
+		bc:   BenchCase{Call: ClientCallServerStream},
+		want: CapServerStream,
+	}, {
+		name: "all pending",
+		bc:   BenchCase{Call: ClientCallServerStream, SharedClient: true},
+		want: CapServerStream | CapMultiplex,
+	}, {
+		name: "partly pending",
+		bc:   BenchCase{Call: ClientCallClientStream, SharedClient: true},
+		want: 0,
+	}, {
+		name: "not pending",
+		bc:   BenchCase{Call: ClientCallClientStream},
+		want: 0,
+	}, {
+		name: "unsupported mode",
+		bc:   BenchCase{Call: ClientCallBidiStream, Rate: 1000},
+		want: 0,
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.bc.Sys = sys
+			if got := tc.bc.PendingCaps(); got != tc.want {
+				t.Fatalf("unexpected pending caps: got %q, want %q", got, tc.want)
+			}
+			if tc.bc.Supported() && tc.want != 0 {
+				t.Fatal("pending case is supported")
+			}
+		})
+	}
+}
+
+// TestSkipReason tests which cases are reported as skipped, and why.
+func TestSkipReason(t *testing.T) {
+	sys := &RPCSystem{
+		Name:        "test",
+		Caps:        CapTLS,
+		Pending:     CapServerStream,
+		Unsupported: map[Capability]string{CapVector: "no vectors", CapMultiplex: "no multiplexing"},
+	}
+
+	tests := []struct {
+		name        string
+		bc          BenchCase
+		unsupported Capability
+		want        string
+	}{{
+		name: "supported",
+		bc:   BenchCase{Call: ClientCallNop},
+	}, {
+		name: "pending",
+		bc:   BenchCase{Call: ClientCallServerStream},
+		want: "system test has not implemented sstream yet",
+	}, {
+		name:        "unsupported",
+		bc:          BenchCase{Call: ClientCallVector},
+		unsupported: CapVector,
+		want:        "system test does not support vector: no vectors",
+	}, {
+		name:        "all unsupported",
+		bc:          BenchCase{Call: ClientCallVector, SharedClient: true},
+		unsupported: CapMultiplex | CapVector,
+		want:        "system test does not support multiplex,vector: no multiplexing; no vectors",
+	}, {
+		name: "pending and unsupported",
+		bc:   BenchCase{Call: ClientCallServerStream, SharedClient: true},
+	}, {
+		name: "partly unsupported",
+		bc:   BenchCase{Call: ClientCallVector, CallTimeout: 1},
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.bc.Sys = sys
+			if got := tc.bc.UnsupportedCaps(); got != tc.unsupported {
+				t.Fatalf("unexpected unsupported caps: got %q, want %q", got, tc.unsupported)
+			}
+			if got := tc.bc.SkipReason(); got != tc.want {
+				t.Fatalf("unexpected skip reason: got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
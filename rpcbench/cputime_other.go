@@ -0,0 +1,15 @@
+// Copyright (c) 2025 Matheus Degiovani
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+//go:build !unix
+
+package rpcbench
+
+import "time"
+
+// processCPUTime is not supported on this platform, so the CPU time of the
+// process is always reported as zero.
+func processCPUTime() time.Duration {
+	return 0
+}
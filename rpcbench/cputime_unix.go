@@ -0,0 +1,22 @@
+// Copyright (c) 2025 Matheus Degiovani
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+//go:build unix
+
+package rpcbench
+
+import (
+	"syscall"
+	"time"
+)
+
+// processCPUTime returns the total (user + system) CPU time used by the current
+// process.
+func processCPUTime() time.Duration {
+	var ru syscall.Rusage
+	if err := syscall.Getrusage(syscall.RUSAGE_SELF, &ru); err != nil {
+		return 0
+	}
+	return time.Duration(ru.Utime.Nano() + ru.Stime.Nano())
+}
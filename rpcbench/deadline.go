@@ -0,0 +1,65 @@
+// Copyright (c) 2025 Matheus Degiovani
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcbench
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// SlowHandler handles the Slow calls of servers, keeping track of the number
+// of calls that were canceled before they completed. Its zero value is ready to
+// be used.
+type SlowHandler struct {
+	started  atomic.Int64
+	canceled atomic.Int64
+}
+
+// Handle blocks until either delay elapses or ctx is done. In the latter case,
+// the call is counted as canceled and the error of ctx is returned.
+func (h *SlowHandler) Handle(ctx context.Context, delay time.Duration) error {
+	h.started.Add(1)
+	t := time.NewTimer(delay)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		h.canceled.Add(1)
+		return ctx.Err()
+	}
+}
+
+// HandleTimeout is like Handle, for systems where the remaining time until the
+// deadline of the call is sent by the client along with the call. A zero
+// timeout means the call has no deadline.
+func (h *SlowHandler) HandleTimeout(ctx context.Context, delay, timeout time.Duration) error {
+	if timeout > 0 {
+		var cancel func()
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	return h.Handle(ctx, delay)
+}
+
+// Counts returns the number of calls that were started and the number of the
+// ones that were canceled.
+func (h *SlowHandler) Counts() (started, canceled int64) {
+	return h.started.Load(), h.canceled.Load()
+}
+
+// RemainingTimeout returns the time remaining until the deadline of ctx, for
+// systems that send it along with calls. It returns zero when ctx has no
+// deadline.
+func RemainingTimeout(ctx context.Context) time.Duration {
+	d, ok := ctx.Deadline()
+	if !ok {
+		return 0
+	}
+	// Calls past their deadline are sent with the smallest timeout, so
+	// that they are not mistaken for calls without one.
+	return max(time.Until(d), 1)
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2025 Matheus Degiovani
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcbench
+
+import (
+	"math"
+	"math/bits"
+	"testing"
+	"time"
+)
+
+const (
+	// histSubBucketBits is the number of bits of precision kept for each
+	// recorded value. 7 bits means values are recorded with an error of at
+	// most 1/64 (~1.5%).
+	histSubBucketBits  = 7
+	histSubBucketCount = 1 << histSubBucketBits
+	histSubBucketHalf  = histSubBucketCount / 2
+
+	// histMaxExp is the largest power of two (above the sub bucket count)
+	// tracked by the histogram. Values larger than 2^(histMaxExp +
+	// histSubBucketBits) ns (~10 hours) are clamped.
+	histMaxExp = 38
+
+	histBucketCount = histSubBucketCount + histMaxExp*histSubBucketHalf
+)
+
+// latencyHistogram is an HDR-style histogram of call latencies (in
+// nanoseconds). Values are tracked in log-linear buckets, such that small
+// latencies are recorded exactly and larger ones with a bounded relative error.
+//
+// The zero value is ready for use. latencyHistogram is not safe for concurrent
+// access; each client records its own latencies, which are merged after the
+// test.
+type latencyHistogram struct {
+	counts [histBucketCount]uint64
+	total  uint64
+	max    int64
+}
+
+func histIndex(v int64) int {
+	if v < histSubBucketCount {
+		return int(v)
+	}
+	exp := bits.Len64(uint64(v)) - histSubBucketBits
+	if exp > histMaxExp {
+		return histBucketCount - 1
+	}
+	sub := int(v >> exp) // In [histSubBucketHalf, histSubBucketCount).
+	return histSubBucketCount + (exp-1)*histSubBucketHalf + sub - histSubBucketHalf
+}
+
+// histValue returns the highest value that would be recorded in bucket i.
+func histValue(i int) int64 {
+	if i < histSubBucketCount {
+		return int64(i)
+	}
+	exp := (i-histSubBucketCount)/histSubBucketHalf + 1
+	sub := int64((i-histSubBucketCount)%histSubBucketHalf + histSubBucketHalf)
+	return (sub+1)<<exp - 1
+}
+
+// record adds a latency to the histogram.
+func (h *latencyHistogram) record(d time.Duration) {
+	v := int64(d)
+	if v < 0 {
+		v = 0
+	}
+	h.counts[histIndex(v)]++
+	h.total++
+	if v > h.max {
+		h.max = v
+	}
+}
+
+// merge adds all recorded values of other into h.
+func (h *latencyHistogram) merge(other *latencyHistogram) {
+	for i := range other.counts {
+		h.counts[i] += other.counts[i]
+	}
+	h.total += other.total
+	h.max = max(h.max, other.max)
+}
+
+// percentile returns the latency below which q (in [0, 1]) of the recorded
+// values fall.
+func (h *latencyHistogram) percentile(q float64) time.Duration {
+	if h.total == 0 {
+		return 0
+	}
+	rank := max(uint64(math.Ceil(q*float64(h.total))), 1)
+	var seen uint64
+	for i, c := range h.counts {
+		seen += c
+		if seen >= rank {
+			return time.Duration(min(histValue(i), h.max))
+		}
+	}
+	return time.Duration(h.max)
+}
+
+// latencyPercentiles are the percentiles reported for every test.
+var latencyPercentiles = []struct {
+	q    float64
+	unit string
+}{
+	{0.50, "p50-ns"},
+	{0.90, "p90-ns"},
+	{0.99, "p99-ns"},
+	{0.999, "p99.9-ns"},
+}
+
+// report adds the latency percentiles as metrics of the benchmark.
+func (h *latencyHistogram) report(b *testing.B) {
+	h.reportAs(b, "")
+}
+
+// reportAs adds the latency percentiles as metrics of the benchmark, with the
+// given prefix in their units.
+func (h *latencyHistogram) reportAs(b *testing.B, prefix string) {
+	if h.total == 0 {
+		return
+	}
+	for _, p := range latencyPercentiles {
+		b.ReportMetric(float64(h.percentile(p.q)), prefix+p.unit)
+	}
+	b.ReportMetric(float64(h.max), prefix+"max-ns")
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2025 Matheus Degiovani
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcbench
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// TestHistBuckets tests that values are recorded in buckets whose (highest)
+// value is within the precision of the histogram.
+func TestHistBuckets(t *testing.T) {
+	tests := []int64{0, 1, 127, 128, 129, 255, 256, 1000, 1023, 1024,
+		123456, 1e9, 1e12, 1<<44 - 1}
+	for _, v := range tests {
+		i := histIndex(v)
+		got := histValue(i)
+		if got < v {
+			t.Errorf("value %d: bucket %d has lower value %d", v, i, got)
+		}
+		if v < histSubBucketCount && got != v {
+			t.Errorf("value %d: small values should be exact, got %d", v, got)
+		}
+		if maxErr := v / histSubBucketHalf; got-v > maxErr {
+			t.Errorf("value %d: bucket value %d has error larger than %d", v, got, maxErr)
+		}
+		if i > 0 && histValue(i-1) >= v {
+			t.Errorf("value %d: previous bucket %d already covers it", v, i-1)
+		}
+	}
+
+	// Values above the tracked range are clamped to the last bucket.
+	if got := histIndex(math.MaxInt64); got != histBucketCount-1 {
+		t.Errorf("unexpected index of max value: got %d, want %d", got, histBucketCount-1)
+	}
+}
+
+// TestHistPercentile tests the percentiles of histograms.
+func TestHistPercentile(t *testing.T) {
+	seq := func(from, to int64) []time.Duration {
+		var res []time.Duration
+		for v := from; v <= to; v++ {
+			res = append(res, time.Duration(v))
+		}
+		return res
+	}
+
+	tests := []struct {
+		name   string
+		values []time.Duration
+		q      float64
+		want   time.Duration
+	}{
+		{name: "empty", q: 0.5, want: 0},
+		{name: "single", values: []time.Duration{42}, q: 0.99, want: 42},
+		{name: "p0 is the min", values: seq(10, 100), q: 0, want: 10},
+		{name: "p50", values: seq(1, 100), q: 0.5, want: 50},
+		{name: "p90", values: seq(1, 100), q: 0.9, want: 90},
+		{name: "p99", values: seq(1, 100), q: 0.99, want: 99},
+		{name: "p100 is the max", values: seq(1, 100), q: 1, want: 100},
+		{name: "negative as zero", values: []time.Duration{-5, -1}, q: 1, want: 0},
+		{name: "max clamps bucket", values: []time.Duration{1000}, q: 0.5, want: 1000},
+		{name: "huge is clamped", values: []time.Duration{math.MaxInt64}, q: 0.5, want: time.Duration(histValue(histBucketCount - 1))},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var h latencyHistogram
+			for _, v := range tc.values {
+				h.record(v)
+			}
+			if got := h.percentile(tc.q); got != tc.want {
+				t.Fatalf("unexpected percentile %v: got %d, want %d", tc.q, got, tc.want)
+			}
+		})
+	}
+}
+
+// TestHistPercentileError tests that percentiles of large values are within
+// the precision of the histogram.
+func TestHistPercentileError(t *testing.T) {
+	var h latencyHistogram
+	for v := range 1000 {
+		h.record(time.Duration(v+1) * time.Microsecond)
+	}
+	for _, q := range []float64{0.5, 0.9, 0.99} {
+		want := time.Duration(math.Ceil(q*1000)) * time.Microsecond
+		got := h.percentile(q)
+		if got < want || got-want > want/histSubBucketHalf {
+			t.Errorf("unexpected percentile %v: got %v, want %v", q, got, want)
+		}
+	}
+}
+
+// TestHistMerge tests that merging histograms is the same as recording every
+// value in a single one.
+func TestHistMerge(t *testing.T) {
+	var a, b, all latencyHistogram
+	for v := range time.Duration(500) {
+		a.record(v * 3)
+		all.record(v * 3)
+	}
+	for v := range time.Duration(300) {
+		b.record(v*7 + 5000)
+		all.record(v*7 + 5000)
+	}
+	a.merge(&b)
+	if a != all {
+		t.Fatalf("merged histogram differs: total %d max %d, want total %d max %d",
+			a.total, a.max, all.total, all.max)
+	}
+}
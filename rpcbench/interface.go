@@ -6,7 +6,9 @@ package rpcbench
 
 import (
 	"context"
+	"crypto/tls"
 	"net"
+	"time"
 )
 
 // Runnable is an interface to objects that can run.
@@ -23,7 +25,8 @@ type Server interface {
 // opposed to a direct struct) to allow RPC systems to use their preferred
 // representation for data.
 //
-// TreeNode values are reused across tests, but not across different clients.
+// TreeNode values are reused across tests, but not across different clients (or
+// goroutines sharing a client).
 type TreeNode interface {
 	// SetValue should set the int value of the node.
 	SetValue(v int64)
@@ -50,6 +53,14 @@ type TreeNode interface {
 // Client is the interface to an RPC client with specific functions. If client
 // implements Runnable, the Run() method will be called after the client is
 // created and before any calls are made.
+//
+// Clients are closed once the context passed to NewClient is done. If client
+// implements io.Closer, the Close() method will be called to close clients
+// that are discarded before that (such as the one used to warm up the TLS
+// session cache).
+//
+// Clients of systems that declare [CapMultiplex] must be safe for concurrent
+// use by multiple goroutines.
 type Client interface {
 	// Nop is a no-op call. It is used to measure the minimum overhead
 	// imposed by the RPC subsystem to calls.
@@ -93,6 +104,53 @@ type Client interface {
 	ToHex(ctx context.Context, in, out []byte) error
 }
 
+// ClientConfig is the configuration used to create a client.
+type ClientConfig struct {
+	// Network is the network of the server's listener (e.g. "tcp" or
+	// "unix").
+	Network string
+
+	// Addr is the address of the server's listener.
+	Addr string
+
+	// TLSConfig, when set, is the config clients must use to establish
+	// TLS connections to the server.
+	TLSConfig *tls.Config
+}
+
+// DialNet connects to the server, without establishing a TLS session (even if
+// TLSConfig is set). This is meant for clients that use TLS through their own
+// mechanisms.
+func (cfg ClientConfig) DialNet(ctx context.Context) (net.Conn, error) {
+	var dc net.Dialer
+	return dc.DialContext(ctx, cfg.Network, cfg.Addr)
+}
+
+// Dial connects to the server and, if TLSConfig is set, performs the TLS
+// handshake.
+func (cfg ClientConfig) Dial(ctx context.Context) (net.Conn, error) {
+	c, err := cfg.DialNet(ctx)
+	if err != nil || cfg.TLSConfig == nil {
+		return c, err
+	}
+	tc := tls.Client(c, cfg.TLSConfig)
+	if err := tc.HandshakeContext(ctx); err != nil {
+		c.Close()
+		return nil, err
+	}
+	return tc, nil
+}
+
+// HTTPHost returns the host to use in URLs that refer to the server. For
+// networks other than TCP, this returns a placeholder host, given the
+// connection is established by Dial.
+func (cfg ClientConfig) HTTPHost() string {
+	if cfg.Network == "tcp" {
+		return cfg.Addr
+	}
+	return "localhost"
+}
+
 // RPCFactory is the interface to a test RPC system.
 type RPCFactory interface {
 	// NewServer should create a new server, bound to the given network
@@ -106,8 +164,164 @@ type RPCFactory interface {
 	// If a client is safe for concurrent access by multiple goroutines and
 	// is able to multiplex multiple concurrent calls, then implementations
 	// are free to return a single client on every call.
-	NewClient(context.Context, string) (Client, error)
+	//
+	// Clients must connect to the server using the network and address
+	// specified in the config.
+	NewClient(context.Context, ClientConfig) (Client, error)
 }
 
 // Signature for a func that initializes an RPCFactory.
 type FactoryIniter func() RPCFactory
+
+// ServerStreamClient is implemented by clients of systems with
+// [CapServerStream].
+type ServerStreamClient interface {
+	// ServerStream requests count items from the server. Servers should
+	// stream back the values start, start+1, ..., start+count-1, in
+	// order, as individual messages. Clients should call onItem for every
+	// value as soon as it is received, and fail the call if onItem
+	// returns an error.
+	ServerStream(ctx context.Context, start int64, count int, onItem func(v int64) error) error
+}
+
+// ClientStreamClient is implemented by clients of systems with
+// [CapClientStream].
+type ClientStreamClient interface {
+	// ClientStream sends every chunk to the server, in order, as
+	// individual messages. The server should reply (once the stream ends)
+	// with the CRC-32 (IEEE) checksum of the concatenation of all chunks.
+	// The chunks are not modified and are reused after the call returns.
+	ClientStream(ctx context.Context, chunks [][]byte) (uint32, error)
+}
+
+// PipelineClient is implemented by clients of systems with [CapPipeline].
+type PipelineClient interface {
+	// AddChain returns the result of a chain of dependent Add calls, where
+	// the first call adds deltas[0] to start, and each of the next ones
+	// adds a delta to the result of the previous call. Every call should
+	// be made on the (not yet returned) result of the previous one,
+	// without waiting for it.
+	AddChain(ctx context.Context, start int64, deltas []int64) (int64, error)
+}
+
+// CallbackClient is implemented by clients of systems with [CapCallback].
+type CallbackClient interface {
+	// Callback makes a call during which the server calls back into the
+	// client count times, one after the other, with the values start,
+	// start+1, ..., start+count-1. Clients should reply to every callback
+	// with the result of onCall for its value (failing the call if onCall
+	// returns an error), and the server should return the sum of all
+	// replies.
+	Callback(ctx context.Context, start int64, count int, onCall func(v int64) (int64, error)) (int64, error)
+}
+
+// ErrorClient is implemented by clients of systems with [CapAppError].
+type ErrorClient interface {
+	// Validate makes a call on which the server replies with v or, when
+	// code is not zero, fails with the error returned by
+	// RejectValue(code, v). Clients should return failed calls as an
+	// *AppError, with the code and message of the server error.
+	Validate(ctx context.Context, v int64, code AppErrorCode) (int64, error)
+}
+
+// DeadlineClient is implemented by clients of systems with [CapCancel].
+type DeadlineClient interface {
+	// Slow makes a call that the server takes delay to handle. Servers
+	// should handle it with a [SlowHandler], such that calls canceled (or
+	// whose deadline expires) before delay elapses are stopped and
+	// counted. Clients should return the error of ctx for calls
+	// interrupted by it.
+	Slow(ctx context.Context, delay time.Duration) error
+
+	// SlowCalls returns the number of Slow calls the server started, and
+	// the number of the ones it saw canceled.
+	SlowCalls(ctx context.Context) (started, canceled int64, err error)
+}
+
+// HeadOfLineClient is implemented by clients of systems with [CapOverlap].
+type HeadOfLineClient interface {
+	// WorkWithNops makes a Work call, which the server handles by doing
+	// work, followed by nops Nop calls on the same connection. The Nop
+	// calls are sent right after the Work call, without waiting for it
+	// (or for each other) to return. Clients should call onNop with the
+	// index of each Nop call as soon as it returns, possibly concurrently
+	// for different calls.
+	WorkWithNops(ctx context.Context, work Work, nops int, onNop func(i int)) error
+}
+
+// LargeClient is implemented by clients of systems with [CapLargeMessage].
+type LargeClient interface {
+	// Echo sends in to the server as a single message, to which the server
+	// replies with the same data. Clients should fill out (which has the
+	// same size as in) with the reply. The size of in may be up to
+	// [MaxMessageSize].
+	Echo(ctx context.Context, in, out []byte) error
+}
+
+// RecordsClient is implemented by clients of systems with [CapRecords].
+type RecordsClient interface {
+	// UpperRecords sends the records to the server, which should reply
+	// with the same records, except with the name of each one replaced by
+	// the result of UpperName. Clients should fill out (which has the same
+	// length as in) with the replied records.
+	UpperRecords(ctx context.Context, in, out []Record) error
+}
+
+// RowsClient is implemented by clients of systems with [CapRows].
+type RowsClient interface {
+	// UpdateRows sends a page of rows to the server, which should reply
+	// with the same rows, except with the version of each one incremented.
+	// Optional fields must be set on the reply if (and only if) they are
+	// set on the request. Clients should fill out (which has the same
+	// length as in) with the replied rows.
+	UpdateRows(ctx context.Context, in, out []Row) error
+}
+
+// MapClient is implemented by clients of systems with [CapMap].
+type MapClient interface {
+	// IncrementMap sends the map to the server, which should reply with a
+	// map with the same keys, except with every value incremented by one.
+	// Clients should fill out (which is empty) with the replied map.
+	IncrementMap(ctx context.Context, in, out map[string]int64) error
+}
+
+// VectorClient is implemented by clients of systems with [CapVector].
+type VectorClient interface {
+	// ScaleVector sends a vector and a scale to the server, which should
+	// reply with the vector multiplied by the scale. Clients should fill
+	// out (which has the same length as in) with the replied vector.
+	ScaleVector(ctx context.Context, in []float64, scale float64, out []float64) error
+}
+
+// MetadataClient is implemented by clients of systems with [CapMetadata].
+type MetadataClient interface {
+	// NopWithMetadata makes a call with no payload that carries the
+	// metadata. The server should validate the metadata with CheckMetadata
+	// (failing the call with its app error if it is invalid) and reply with
+	// the value it returns, which is returned by this call.
+	NopWithMetadata(ctx context.Context, md []MetadataEntry) (string, error)
+}
+
+// BidiStreamClient is implemented by clients of systems with
+// [CapBidiStream].
+type BidiStreamClient interface {
+	// OpenBidiStream opens a stream on which the server echoes back every
+	// message sent by the client, in order. The client is not used for
+	// other calls until the stream is closed (unless it is safe for
+	// concurrent use).
+	OpenBidiStream(ctx context.Context) (BidiStream, error)
+}
+
+// BidiStream is a long-lived stream opened by a [BidiStreamClient].
+type BidiStream interface {
+	// Send sends one message. It may be called concurrently with Recv,
+	// but not with other calls to Send.
+	Send(v int64) error
+
+	// Recv returns the next message echoed back by the server.
+	Recv() (int64, error)
+
+	// Close ends the stream. It is only called after the echoes of every
+	// sent message have been received.
+	Close() error
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2025 Matheus Degiovani
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcbench
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/metrics"
+	"strconv"
+	"time"
+)
+
+// maxMessageSizeEnv is the environment variable with the max message size of
+// the run (in bytes). It is also used to pass the max message size to server
+// processes.
+const maxMessageSizeEnv = "GORPCBENCH_MAX_MSG_SIZE"
+
+const (
+	// DefaultMaxMessageSize is the max message size of runs that do not
+	// set one.
+	DefaultMaxMessageSize = 64 << 20
+
+	// MinMaxMessageSize is the smallest max message size of a run, which is
+	// the size of the largest message of the workloads other than
+	// ClientCallLarge (the reply of ToHex calls).
+	MinMaxMessageSize = 2 * MaxHexEncodeSize
+
+	// MaxMessageOverhead is the room systems leave in their message size
+	// limits for the encoding of calls, on top of their payload.
+	MaxMessageOverhead = 64 * 1024
+)
+
+// maxMessageSize is the max message size of the run, and maxMessageSizeEnvErr
+// the error of the max message size set in the environment, if it is invalid.
+var maxMessageSize, maxMessageSizeEnvErr = maxMessageSizeFromEnv()
+
+// maxMessageSizeFromEnv returns the max message size set in the environment, or
+// the default one. When the one in the environment is invalid, this returns
+// the default one along with the error.
+func maxMessageSizeFromEnv() (int, error) {
+	s := os.Getenv(maxMessageSizeEnv)
+	if s == "" {
+		return DefaultMaxMessageSize, nil
+	}
+	size, err := strconv.Atoi(s)
+	if err == nil {
+		err = checkMaxMessageSize(size)
+	}
+	if err != nil {
+		return DefaultMaxMessageSize, fmt.Errorf("invalid %s %q (using the default of %d): %v",
+			maxMessageSizeEnv, s, DefaultMaxMessageSize, err)
+	}
+	return size, nil
+}
+
+// MaxMessageSizeEnvErr returns the error of the max message size set in the
+// GORPCBENCH_MAX_MSG_SIZE environment variable, if it is invalid. In that case,
+// the max message size defaults to DefaultMaxMessageSize.
+func MaxMessageSizeEnvErr() error {
+	return maxMessageSizeEnvErr
+}
+
+func checkMaxMessageSize(size int) error {
+	if size < MinMaxMessageSize {
+		return fmt.Errorf("max message size %d is smaller than the minimum of %d", size, MinMaxMessageSize)
+	}
+	return nil
+}
+
+// MaxMessageSize returns the max size of the payload of calls of the run.
+// Systems must be configured to accept messages of this size (plus
+// MaxMessageOverhead).
+//
+// This defaults to DefaultMaxMessageSize, and may be set through the
+// GORPCBENCH_MAX_MSG_SIZE environment variable or with SetMaxMessageSize.
+func MaxMessageSize() int {
+	return maxMessageSize
+}
+
+// SetMaxMessageSize sets the max message size of the run. This MUST be called
+// before any client or server is created.
+func SetMaxMessageSize(size int) error {
+	if err := checkMaxMessageSize(size); err != nil {
+		return err
+	}
+	maxMessageSize = size
+	return nil
+}
+
+// formatSize returns size in the largest unit that represents it exactly.
+func formatSize(size int) string {
+	switch {
+	case size >= 1<<20 && size%(1<<20) == 0:
+		return fmt.Sprintf("%dMiB", size>>20)
+	case size >= 1<<10 && size%(1<<10) == 0:
+		return fmt.Sprintf("%dKiB", size>>10)
+	default:
+		return fmt.Sprintf("%dB", size)
+	}
+}
+
+// heapSampleInterval is the interval between samples of the size of the heap.
+const heapSampleInterval = time.Millisecond
+
+// heapSampler tracks the peak size of the heap (of live and not yet collected
+// objects) while it runs.
+type heapSampler struct {
+	stop chan struct{}
+	done chan uint64
+}
+
+// startHeapSampler starts sampling the size of the heap, until the sampler is
+// stopped or ctx is done.
+func startHeapSampler(ctx context.Context) *heapSampler {
+	// Collect the garbage of previous tests, so that it is not accounted
+	// for in this one.
+	runtime.GC()
+
+	hs := &heapSampler{stop: make(chan struct{}), done: make(chan uint64, 1)}
+	go func() {
+		samples := []metrics.Sample{{Name: "/memory/classes/heap/objects:bytes"}}
+		ticker := time.NewTicker(heapSampleInterval)
+		defer ticker.Stop()
+		var peak uint64
+		for {
+			metrics.Read(samples)
+			peak = max(peak, samples[0].Value.Uint64())
+			select {
+			case <-ticker.C:
+			case <-hs.stop:
+				hs.done <- peak
+				return
+			case <-ctx.Done():
+				hs.done <- peak
+				return
+			}
+		}
+	}()
+	return hs
+}
+
+// peak stops the sampler and returns the peak size of the heap.
+func (hs *heapSampler) peak() uint64 {
+	close(hs.stop)
+	return <-hs.done
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2025 Matheus Degiovani
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcbench
+
+import (
+	"strconv"
+	"testing"
+)
+
+// TestMaxMessageSizeFromEnv tests that invalid max message sizes in the
+// environment fall back to the default one with an error.
+func TestMaxMessageSizeFromEnv(t *testing.T) {
+	tests := []struct {
+		env     string
+		want    int
+		wantErr bool
+	}{
+		{env: "", want: DefaultMaxMessageSize},
+		{env: strconv.Itoa(MinMaxMessageSize), want: MinMaxMessageSize},
+		{env: strconv.Itoa(MinMaxMessageSize - 1), want: DefaultMaxMessageSize, wantErr: true},
+		{env: "16MiB", want: DefaultMaxMessageSize, wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Setenv(maxMessageSizeEnv, tc.env)
+		size, err := maxMessageSizeFromEnv()
+		if size != tc.want || (err != nil) != tc.wantErr {
+			t.Fatalf("env %q: got %d, %v, want %d (error: %v)", tc.env, size, err, tc.want, tc.wantErr)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2025 Matheus Degiovani
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcbench
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+)
+
+// makeMap returns a map with n entries, keyed by random (and distinct) strings.
+// Its values are set on every call.
+func makeMap(rng *rand.Rand, n int) map[string]int64 {
+	m := make(map[string]int64, n)
+	for i := range n {
+		key := fmt.Sprintf("%s.%s.%d", pick(rng, recordWords), pick(rng, recordWords), i)
+		m[key] = 0
+	}
+	return m
+}
+
+// randomizeMap sets the values of the map to random values, which can be
+// incremented without overflowing.
+func randomizeMap(rng *rand.Rand, m map[string]int64) {
+	for k := range m {
+		m[k] = rng.Int64N(math.MaxInt64)
+	}
+}
+
+// mapSize returns the size of the entries of the map.
+func mapSize(m map[string]int64) int {
+	var size int
+	for k := range m {
+		size += len(k) + 8
+	}
+	return size
+}
+
+// checkMap checks that the map replied by the server has the same keys as the
+// one sent to it, with their values incremented.
+func checkMap(in, out map[string]int64) error {
+	if len(out) != len(in) {
+		return fmt.Errorf("unexpected number of entries %d", len(out))
+	}
+	for k, v := range in {
+		got, ok := out[k]
+		if !ok {
+			return fmt.Errorf("missing entry %q", k)
+		}
+		if got != v+1 {
+			return fmt.Errorf("mismatch in entry %q: got %d, want %d", k, got, v+1)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2025 Matheus Degiovani
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcbench
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+)
+
+// MetadataEntry is a key/value of the metadata of a call. Keys are lowercase,
+// so they are valid as gRPC metadata keys and HTTP header names.
+type MetadataEntry struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// Keys of the metadata of ClientCallMetadata calls.
+const (
+	MetadataKeyAuth    = "authorization"
+	MetadataKeyTenant  = "x-tenant-id"
+	MetadataKeyTraceID = "x-trace-id"
+
+	// MetadataEchoKey is the key of the entry servers echo back, which
+	// changes on every call.
+	MetadataEchoKey = MetadataKeyTraceID
+)
+
+const (
+	// metadataAuthScheme is the prefix of the auth token entry.
+	metadataAuthScheme = "Bearer "
+
+	// metadataTokenLen is the length of the (hex-encoded) auth tokens.
+	metadataTokenLen = 64
+
+	// metadataTraceIDLen is the length of the (hex-encoded) trace ids.
+	metadataTraceIDLen = 32
+)
+
+// makeMetadata returns the metadata of ClientCallMetadata calls: an auth token,
+// tenant and trace ids, along with a few other entries servers ignore. The
+// trace id is set on every call.
+func makeMetadata(rng *rand.Rand) []MetadataEntry {
+	var token strings.Builder
+	token.WriteString(metadataAuthScheme)
+	for range metadataTokenLen / 16 {
+		fmt.Fprintf(&token, "%016x", rng.Uint64())
+	}
+	return []MetadataEntry{
+		{Key: MetadataKeyAuth, Value: token.String()},
+		{Key: MetadataKeyTenant, Value: fmt.Sprintf("tenant-%d", rng.IntN(1000))},
+		{Key: MetadataKeyTraceID},
+		{Key: "x-request-source", Value: "gorpcbench"},
+		{Key: "x-client-version", Value: "1.4.2"},
+		{Key: "x-locale", Value: pick(rng, rowLocales)},
+	}
+}
+
+// setTraceID sets a new random trace id on the metadata.
+func setTraceID(rng *rand.Rand, md []MetadataEntry) string {
+	traceID := fmt.Sprintf("%016x%016x", rng.Uint64(), rng.Uint64())
+	for i := range md {
+		if md[i].Key == MetadataKeyTraceID {
+			md[i].Value = traceID
+		}
+	}
+	return traceID
+}
+
+// metadataSize returns the size of the keys and values of the metadata.
+func metadataSize(md []MetadataEntry) int {
+	var size int
+	for _, e := range md {
+		size += len(e.Key) + len(e.Value)
+	}
+	return size
+}
+
+// MetadataValue returns the value of the first entry of the metadata with the
+// key, or an empty string.
+func MetadataValue(md []MetadataEntry, key string) string {
+	for _, e := range md {
+		if e.Key == key {
+			return e.Value
+		}
+	}
+	return ""
+}
+
+// isLowerHex returns true if s is only made of lowercase hex digits.
+func isLowerHex(s string) bool {
+	for i := range len(s) {
+		if !('0' <= s[i] && s[i] <= '9' || 'a' <= s[i] && s[i] <= 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+// rejectMetadata returns the app error servers fail calls with invalid
+// metadata with.
+func rejectMetadata(msg string) *AppError {
+	return &AppError{Code: AppErrPermissionDenied, Message: msg}
+}
+
+// CheckMetadata validates the metadata of a ClientCallMetadata call, given a
+// func that returns the value of each key (or an empty string for missing
+// keys). It returns the value servers should echo back, or the app error they
+// should fail the call with.
+func CheckMetadata(get func(key string) string) (string, *AppError) {
+	auth := get(MetadataKeyAuth)
+	token, ok := strings.CutPrefix(auth, metadataAuthScheme)
+	if !ok || len(token) != metadataTokenLen || !isLowerHex(token) {
+		return "", rejectMetadata("invalid auth token")
+	}
+	if get(MetadataKeyTenant) == "" {
+		return "", rejectMetadata("missing tenant id")
+	}
+	traceID := get(MetadataKeyTraceID)
+	if len(traceID) != metadataTraceIDLen || !isLowerHex(traceID) {
+		return "", rejectMetadata("invalid trace id")
+	}
+	return traceID, nil
+}
@@ -0,0 +1,482 @@
+// Copyright (c) 2025 Matheus Degiovani
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcbench
+
+import (
+	"math/rand/v2"
+	"net"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// LinkProfile describes the characteristics of an emulated network link.
+type LinkProfile struct {
+	// Name of the profile. Used in test names.
+	Name string
+
+	// Latency is the one-way latency of the link.
+	Latency time.Duration
+
+	// Jitter is the maximum amount of time by which the latency of each
+	// packet randomly varies (in either direction).
+	Jitter time.Duration
+
+	// Bandwidth is the max number of bytes per second sent in each
+	// direction of the link. Zero means unlimited bandwidth.
+	Bandwidth int64
+
+	// MaxWriteSize is the max size of each packet sent through the link.
+	// Larger writes are split into multiple packets, which are delivered
+	// individually to the remote end (and therefore may require multiple
+	// reads). Zero means writes are not split.
+	MaxWriteSize int
+}
+
+var (
+	// LinkLAN emulates a link between two hosts in the same datacenter.
+	LinkLAN = LinkProfile{
+		Name:         "lan",
+		Latency:      100 * time.Microsecond,
+		Jitter:       20 * time.Microsecond,
+		Bandwidth:    1e9 / 8, // 1 Gbps
+		MaxWriteSize: 64 * 1024,
+	}
+
+	// LinkCrossRegion emulates a link between two hosts in different
+	// regions of a cloud provider.
+	LinkCrossRegion = LinkProfile{
+		Name:         "xregion",
+		Latency:      35 * time.Millisecond,
+		Jitter:       3 * time.Millisecond,
+		Bandwidth:    100e6 / 8, // 100 Mbps
+		MaxWriteSize: 1460,
+	}
+
+	// LinkConstrained emulates a slow, unreliable link (e.g. a mobile
+	// connection).
+	LinkConstrained = LinkProfile{
+		Name:         "constrained",
+		Latency:      60 * time.Millisecond,
+		Jitter:       15 * time.Millisecond,
+		Bandwidth:    2e6 / 8, // 2 Mbps
+		MaxWriteSize: 536,
+	}
+)
+
+// LinkProfiles returns the list of predefined link profiles.
+func LinkProfiles() []*LinkProfile {
+	return []*LinkProfile{&LinkLAN, &LinkCrossRegion, &LinkConstrained}
+}
+
+// emuMaxReadSize is the size of the reads done on the underlying conn when the
+// link does not limit the packet size.
+const emuMaxReadSize = 64 * 1024
+
+// emuQueueLen is the max number of packets in flight in each direction of a
+// conn. Writes block once this is reached.
+const emuQueueLen = 256
+
+// emuFlushTimeout is how long closing a conn waits for the packets that were
+// already written to be delivered, before dropping them.
+const emuFlushTimeout = time.Second
+
+// emuPacket is a chunk of data that is delivered at a specific time.
+type emuPacket struct {
+	buf *[]byte
+	off int
+	n   int
+	due time.Time
+}
+
+// linkShaper determines the delivery time of packets sent in one direction
+// of an emulated link.
+type linkShaper struct {
+	link      *LinkProfile
+	rng       *rand.Rand
+	lastTxEnd time.Time
+	lastDue   time.Time
+}
+
+// schedule returns the time a packet of size n, sent now, should be delivered
+// to the remote end. Packets are always delivered in order.
+func (s *linkShaper) schedule(n int) time.Time {
+	txEnd := time.Now()
+	if s.link.Bandwidth > 0 {
+		txEnd = maxTime(txEnd, s.lastTxEnd)
+		txEnd = txEnd.Add(time.Duration(int64(n) * int64(time.Second) / s.link.Bandwidth))
+		s.lastTxEnd = txEnd
+	}
+
+	delay := s.link.Latency
+	if s.link.Jitter > 0 {
+		delay += time.Duration(s.rng.Int64N(2*int64(s.link.Jitter))) - s.link.Jitter
+	}
+	due := maxTime(txEnd.Add(max(delay, 0)), s.lastDue)
+	s.lastDue = due
+	return due
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+// newStoppedTimer returns a timer that is not running, to be started with
+// Reset.
+func newStoppedTimer() *time.Timer {
+	t := time.NewTimer(time.Hour)
+	t.Stop()
+	return t
+}
+
+// connDeadline tracks the read or write deadline of a conn.
+type connDeadline struct {
+	mu      sync.Mutex
+	t       time.Time
+	changed chan struct{}
+
+	// timer is only used by the goroutine waiting on the deadline.
+	timer *time.Timer
+}
+
+func newConnDeadline() connDeadline {
+	return connDeadline{changed: make(chan struct{}), timer: newStoppedTimer()}
+}
+
+// wait returns a channel that fires when the deadline expires (nil when there
+// is no deadline) and one that is closed when the deadline changes. It fails
+// with os.ErrDeadlineExceeded when the deadline has already expired.
+//
+// Callers must call stop once they are done waiting.
+func (d *connDeadline) wait() (<-chan time.Time, <-chan struct{}, error) {
+	d.mu.Lock()
+	t, changed := d.t, d.changed
+	d.mu.Unlock()
+
+	if t.IsZero() {
+		return nil, changed, nil
+	}
+	wait := time.Until(t)
+	if wait <= 0 {
+		return nil, nil, os.ErrDeadlineExceeded
+	}
+	d.timer.Reset(wait)
+	return d.timer.C, changed, nil
+}
+
+func (d *connDeadline) stop() {
+	d.timer.Stop()
+}
+
+func (d *connDeadline) set(t time.Time) {
+	d.mu.Lock()
+	d.t = t
+	close(d.changed)
+	d.changed = make(chan struct{})
+	d.mu.Unlock()
+}
+
+// emuConn is a conn that emulates the characteristics of a link, in both
+// directions.
+//
+// Written data is split into packets that are sent to the underlying conn by
+// a separate goroutine, at their delivery time. Data read from the underlying
+// conn is only returned by Read() after its delivery time.
+//
+// Deadlines are emulated as well, and never set on the underlying conn: the
+// write deadline only applies to writes blocked on a full queue of packets, as
+// with the send buffer of a socket.
+type emuConn struct {
+	net.Conn
+	link    *LinkProfile
+	bufPool sync.Pool
+
+	writeMu    sync.Mutex
+	outSh      linkShaper
+	out        chan emuPacket
+	writeErr   atomic.Pointer[error]
+	wd         connDeadline
+	writeTimer *time.Timer // Only used by writeLoop.
+
+	inSh      linkShaper
+	in        chan emuPacket
+	inErr     error // Only read after in is closed.
+	pending   emuPacket
+	rd        connDeadline
+	readTimer *time.Timer // Only used by Read.
+
+	closeOnce sync.Once
+	closing   chan struct{}
+	abort     chan struct{}
+	writeDone chan struct{}
+}
+
+func newEmuConn(c net.Conn, link *LinkProfile, seed uint64) *emuConn {
+	bufSize := emuMaxReadSize
+	if link.MaxWriteSize > 0 {
+		bufSize = link.MaxWriteSize
+	}
+
+	ec := &emuConn{
+		Conn: c,
+		link: link,
+		bufPool: sync.Pool{New: func() any {
+			buf := make([]byte, bufSize)
+			return &buf
+		}},
+		outSh:      linkShaper{link: link, rng: rand.New(rand.NewPCG(seed, 1))},
+		out:        make(chan emuPacket, emuQueueLen),
+		wd:         newConnDeadline(),
+		writeTimer: newStoppedTimer(),
+		inSh:       linkShaper{link: link, rng: rand.New(rand.NewPCG(seed, 2))},
+		in:         make(chan emuPacket, emuQueueLen),
+		rd:         newConnDeadline(),
+		readTimer:  newStoppedTimer(),
+		closing:    make(chan struct{}),
+		abort:      make(chan struct{}),
+		writeDone:  make(chan struct{}),
+	}
+	go ec.writeLoop()
+	go ec.readLoop()
+	return ec
+}
+
+// deliver writes the packet to the underlying conn, at its delivery time.
+func (c *emuConn) deliver(pkt emuPacket) {
+	defer c.bufPool.Put(pkt.buf)
+	if c.writeErr.Load() != nil {
+		return
+	}
+	if wait := time.Until(pkt.due); wait > 0 {
+		c.writeTimer.Reset(wait)
+		select {
+		case <-c.writeTimer.C:
+		case <-c.abort:
+			c.writeTimer.Stop()
+			err := net.ErrClosed
+			c.writeErr.Store(&err)
+			return
+		}
+	}
+	if _, err := c.Conn.Write((*pkt.buf)[:pkt.n]); err != nil {
+		c.writeErr.Store(&err)
+	}
+}
+
+func (c *emuConn) writeLoop() {
+	defer close(c.writeDone)
+	for {
+		select {
+		case pkt := <-c.out:
+			c.deliver(pkt)
+		case <-c.closing:
+			// Flush the packets that were written before Close().
+			for {
+				select {
+				case pkt := <-c.out:
+					c.deliver(pkt)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (c *emuConn) readLoop() {
+	for {
+		buf := c.bufPool.Get().(*[]byte)
+		n, err := c.Conn.Read(*buf)
+		if n > 0 {
+			pkt := emuPacket{buf: buf, n: n, due: c.inSh.schedule(n)}
+			select {
+			case c.in <- pkt:
+			case <-c.closing:
+				return
+			}
+		} else {
+			c.bufPool.Put(buf)
+		}
+		if err != nil {
+			c.inErr = err
+			close(c.in)
+			return
+		}
+	}
+}
+
+// Write splits b into packets and queues them for delivery.
+func (c *emuConn) Write(b []byte) (int, error) {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	var total int
+	for len(b) > 0 {
+		if err := c.writeErr.Load(); err != nil {
+			return total, *err
+		}
+
+		buf := c.bufPool.Get().(*[]byte)
+		n := copy(*buf, b)
+		pkt := emuPacket{buf: buf, n: n, due: c.outSh.schedule(n)}
+		if err := c.queue(pkt); err != nil {
+			c.bufPool.Put(buf)
+			return total, err
+		}
+		b = b[n:]
+		total += n
+	}
+	return total, nil
+}
+
+// queue queues the packet for delivery. While the queue is full, this blocks
+// until the write deadline.
+func (c *emuConn) queue(pkt emuPacket) error {
+	for {
+		timeout, changed, err := c.wd.wait()
+		if err != nil {
+			return err
+		}
+
+		var queued bool
+		select {
+		case c.out <- pkt:
+			queued = true
+		case <-timeout:
+			err = os.ErrDeadlineExceeded
+		case <-changed:
+			// Deadline changed, check it again.
+		case <-c.closing:
+			err = net.ErrClosed
+		}
+		c.wd.stop()
+		if queued || err != nil {
+			return err
+		}
+	}
+}
+
+// pendingDue returns true if there is a pending packet whose data is due.
+func (c *emuConn) pendingDue() bool {
+	return c.pending.buf != nil && !time.Now().Before(c.pending.due)
+}
+
+// Read returns data from the next packet, once it is due.
+func (c *emuConn) Read(b []byte) (int, error) {
+	for !c.pendingDue() {
+		timeout, changed, err := c.rd.wait()
+		if err != nil {
+			return 0, err
+		}
+
+		// Receive the next packet or, if it was already received, wait
+		// until it is due.
+		in, due := c.in, (<-chan time.Time)(nil)
+		if c.pending.buf != nil {
+			in = nil
+			c.readTimer.Reset(time.Until(c.pending.due))
+			due = c.readTimer.C
+		}
+
+		select {
+		case pkt, ok := <-in:
+			if !ok {
+				err = c.inErr
+			} else {
+				c.pending = pkt
+			}
+
+		case <-due:
+
+		case <-timeout:
+			err = os.ErrDeadlineExceeded
+
+		case <-changed:
+			// Deadline changed, check it again.
+
+		case <-c.closing:
+			err = net.ErrClosed
+		}
+		c.rd.stop()
+		c.readTimer.Stop()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(b, (*c.pending.buf)[c.pending.off:c.pending.n])
+	c.pending.off += n
+	if c.pending.off == c.pending.n {
+		c.bufPool.Put(c.pending.buf)
+		c.pending = emuPacket{}
+	}
+	return n, nil
+}
+
+func (c *emuConn) SetDeadline(t time.Time) error {
+	c.rd.set(t)
+	c.wd.set(t)
+	return nil
+}
+
+func (c *emuConn) SetReadDeadline(t time.Time) error {
+	c.rd.set(t)
+	return nil
+}
+
+func (c *emuConn) SetWriteDeadline(t time.Time) error {
+	c.wd.set(t)
+	return nil
+}
+
+// Close flushes the packets that were already written and closes the
+// underlying conn. Packets that are not delivered within emuFlushTimeout (for
+// example, because the remote end stopped reading) are dropped.
+func (c *emuConn) Close() error {
+	var err error
+	c.closeOnce.Do(func() {
+		close(c.closing)
+		timer := time.NewTimer(emuFlushTimeout)
+		defer timer.Stop()
+		select {
+		case <-c.writeDone:
+			err = c.Conn.Close()
+		case <-timer.C:
+			// Closing the underlying conn aborts a blocked write.
+			close(c.abort)
+			err = c.Conn.Close()
+			<-c.writeDone
+		}
+	})
+	return err
+}
+
+// linkListener is a listener that wraps accepted conns in an emulated link.
+type linkListener struct {
+	net.Listener
+	link  *LinkProfile
+	seeds atomic.Uint64
+}
+
+// Accept waits for and returns the next conn, wrapped in the emulated link.
+func (l *linkListener) Accept() (net.Conn, error) {
+	c, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return newEmuConn(c, l.link, l.seeds.Add(1)), nil
+}
+
+// NewLinkListener returns a listener whose accepted conns emulate the given
+// link. The characteristics of the link are applied on both directions of the
+// conn, therefore clients connecting to this listener experience the link
+// without any changes.
+func NewLinkListener(l net.Listener, link *LinkProfile) net.Listener {
+	return &linkListener{Listener: l, link: link}
+}
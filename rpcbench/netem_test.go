@@ -0,0 +1,250 @@
+// Copyright (c) 2025 Matheus Degiovani
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcbench
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"testing"
+	"time"
+)
+
+// newLinkPair returns a plain client conn connected to a server conn that
+// emulates the link.
+func newLinkPair(t *testing.T, link *LinkProfile) (client, server net.Conn) {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ll := NewLinkListener(l, link)
+	defer ll.Close()
+
+	client, err = net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	server, err = ll.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		client.Close()
+		server.Close()
+	})
+	return client, server
+}
+
+// TestEmuConnLatency tests that data takes the latency of the link to go
+// through it, in each direction.
+func TestEmuConnLatency(t *testing.T) {
+	const latency = 20 * time.Millisecond
+	client, server := newLinkPair(t, &LinkProfile{Latency: latency})
+
+	start := time.Now()
+	if _, err := client.Write([]byte("ping")); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 4)
+	if _, err := io.ReadFull(server, buf); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < latency {
+		t.Fatalf("data read after %v, before the link latency", elapsed)
+	}
+
+	if _, err := server.Write([]byte("pong")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := io.ReadFull(client, buf); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < 2*latency {
+		t.Fatalf("round trip took %v, less than twice the link latency", elapsed)
+	}
+	if string(buf) != "pong" {
+		t.Fatalf("unexpected data %q", buf)
+	}
+}
+
+// TestEmuConnFragmentation tests that data is delivered in packets of up to
+// the max write size of the link.
+func TestEmuConnFragmentation(t *testing.T) {
+	tests := []struct {
+		name         string
+		maxWriteSize int
+		maxRead      int
+	}{
+		{name: "unlimited", maxWriteSize: 0, maxRead: emuMaxReadSize},
+		{name: "536", maxWriteSize: 536, maxRead: 536},
+		{name: "1", maxWriteSize: 1, maxRead: 1},
+	}
+
+	data := make([]byte, 4096)
+	for i := range data {
+		data[i] = byte(i)
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client, server := newLinkPair(t, &LinkProfile{MaxWriteSize: tc.maxWriteSize})
+
+			// Data written by the emulated end.
+			go server.Write(data)
+			got := make([]byte, len(data))
+			if _, err := io.ReadFull(client, got); err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, data) {
+				t.Fatal("data written through the link was modified")
+			}
+
+			// Data read by the emulated end.
+			go client.Write(data)
+			got = got[:0]
+			buf := make([]byte, 2*len(data))
+			for len(got) < len(data) {
+				n, err := server.Read(buf)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if n > tc.maxRead {
+					t.Fatalf("read %d bytes, larger than the packet size %d", n, tc.maxRead)
+				}
+				got = append(got, buf[:n]...)
+			}
+			if !bytes.Equal(got, data) {
+				t.Fatal("data read through the link was modified")
+			}
+		})
+	}
+}
+
+// TestEmuConnBandwidth tests that data does not go through the link faster
+// than its bandwidth.
+func TestEmuConnBandwidth(t *testing.T) {
+	const bandwidth = 100_000
+	const size = 10_000
+	client, server := newLinkPair(t, &LinkProfile{Bandwidth: bandwidth, MaxWriteSize: 1000})
+
+	start := time.Now()
+	go server.Write(make([]byte, size))
+	if _, err := io.ReadFull(client, make([]byte, size)); err != nil {
+		t.Fatal(err)
+	}
+	if want, elapsed := size*time.Second/bandwidth, time.Since(start); elapsed < want {
+		t.Fatalf("data went through in %v, faster than %v", elapsed, want)
+	}
+}
+
+// TestEmuConnReadDeadline tests that reads fail once the read deadline
+// expires, without breaking the conn.
+func TestEmuConnReadDeadline(t *testing.T) {
+	client, server := newLinkPair(t, &LinkProfile{Latency: time.Millisecond})
+
+	server.SetReadDeadline(time.Now().Add(10 * time.Millisecond))
+	buf := make([]byte, 4)
+	if _, err := server.Read(buf); !errors.Is(err, os.ErrDeadlineExceeded) {
+		t.Fatalf("unexpected error: got %v, want %v", err, os.ErrDeadlineExceeded)
+	}
+
+	server.SetReadDeadline(time.Time{})
+	if _, err := client.Write([]byte("data")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := io.ReadFull(server, buf); err != nil {
+		t.Fatalf("read after deadline reset failed: %v", err)
+	}
+}
+
+// TestEmuConnWriteDeadline tests that the write deadline only affects writes
+// blocked on a full queue, and not the delivery of data already written.
+func TestEmuConnWriteDeadline(t *testing.T) {
+	client, server := newLinkPair(t, &LinkProfile{Latency: 20 * time.Millisecond})
+
+	// The deadline expires before the written data is delivered.
+	if _, err := server.Write([]byte("data")); err != nil {
+		t.Fatal(err)
+	}
+	server.SetWriteDeadline(time.Now().Add(time.Millisecond))
+	buf := make([]byte, 4)
+	if _, err := io.ReadFull(client, buf); err != nil {
+		t.Fatal(err)
+	}
+
+	// Writes fail while the deadline is expired, and work once it is
+	// reset.
+	if _, err := server.Write([]byte("data")); !errors.Is(err, os.ErrDeadlineExceeded) {
+		t.Fatalf("unexpected error: got %v, want %v", err, os.ErrDeadlineExceeded)
+	}
+	server.SetWriteDeadline(time.Time{})
+	if _, err := server.Write([]byte("more")); err != nil {
+		t.Fatalf("write after deadline reset failed: %v", err)
+	}
+	if _, err := io.ReadFull(client, buf); err != nil {
+		t.Fatal(err)
+	}
+	if string(buf) != "more" {
+		t.Fatalf("unexpected data %q", buf)
+	}
+
+	// A write blocked on a full queue fails at the deadline.
+	server.SetWriteDeadline(time.Now().Add(20 * time.Millisecond))
+	n, err := server.Write(make([]byte, 2*emuQueueLen*emuMaxReadSize))
+	if !errors.Is(err, os.ErrDeadlineExceeded) {
+		t.Fatalf("unexpected error: got %v, want %v", err, os.ErrDeadlineExceeded)
+	}
+	if n == 0 {
+		t.Fatal("no data was queued before the deadline")
+	}
+}
+
+// TestEmuConnClose tests that closing a conn flushes the data that was already
+// written, and that it does not hang when the remote end stops reading.
+func TestEmuConnClose(t *testing.T) {
+	t.Run("flush", func(t *testing.T) {
+		client, server := newLinkPair(t, &LinkProfile{Latency: 10 * time.Millisecond})
+		if _, err := server.Write([]byte("bye")); err != nil {
+			t.Fatal(err)
+		}
+		if err := server.Close(); err != nil {
+			t.Fatal(err)
+		}
+		got, err := io.ReadAll(client)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != "bye" {
+			t.Fatalf("unexpected data %q", got)
+		}
+	})
+
+	t.Run("peer not reading", func(t *testing.T) {
+		_, server := newLinkPair(t, &LinkProfile{MaxWriteSize: emuMaxReadSize})
+
+		// Fill the socket buffers and the queue, such that the write
+		// loop blocks on the underlying conn.
+		writeErr := make(chan error, 1)
+		go func() {
+			_, err := server.Write(make([]byte, 64<<20))
+			writeErr <- err
+		}()
+		time.Sleep(100 * time.Millisecond)
+
+		closed := make(chan error, 1)
+		go func() { closed <- server.Close() }()
+		select {
+		case <-closed:
+		case <-time.After(emuFlushTimeout + 5*time.Second):
+			t.Fatal("Close() did not return")
+		}
+		if err := <-writeErr; !errors.Is(err, net.ErrClosed) {
+			t.Fatalf("unexpected write error: got %v, want %v", err, net.ErrClosed)
+		}
+	})
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2025 Matheus Degiovani
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcbench
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+)
+
+// Record is a user-like record, with string fields of varying sizes (including
+// multi-byte UTF-8 characters). It is the payload of ClientCallRecords calls.
+type Record struct {
+	ID    int64  `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	Bio   string `json:"bio"`
+}
+
+// UpperName returns the name servers should reply with for a record of
+// ClientCallRecords calls.
+func UpperName(name string) string {
+	return strings.ToUpper(name)
+}
+
+// recordFirstNames, recordLastNames and recordWords are the parts of the
+// strings of the records of ClientCallRecords calls. They mix ASCII with
+// multi-byte UTF-8 characters from several scripts.
+var (
+	recordFirstNames = []string{"Ana", "José", "Zoë", "Łukasz", "Søren", "Ngozi",
+		"Mei", "Dmitriy", "Дмитрий", "أحمد", "Ünal", "Chloé", "Ἀλέξανδρος", "美咲"}
+	recordLastNames = []string{"Silva", "Müller", "Nakamura", "O'Brien", "Kowalski",
+		"Núñez", "Иванов", "Özdemir", "Dupont", "Ødegaard", "山田", "Παπαδόπουλος"}
+	recordWords = []string{"the", "quick", "brown", "fox", "café", "naïve", "résumé",
+		"straße", "façade", "smörgåsbord", "über", "日本語", "テキスト", "привет", "мир",
+		"λόγος", "données", "engineer", "golang", "rpc", "😀", "🚀", "–", "…"}
+)
+
+// makeRecords returns n records with random strings. Their ids are set on
+// every call.
+func makeRecords(rng *rand.Rand, n int) []Record {
+	records := make([]Record, n)
+	var bio strings.Builder
+	for i := range records {
+		first := recordFirstNames[rng.IntN(len(recordFirstNames))]
+		last := recordLastNames[rng.IntN(len(recordLastNames))]
+		bio.Reset()
+		for j := range 4 + rng.IntN(40) {
+			if j > 0 {
+				bio.WriteByte(' ')
+			}
+			bio.WriteString(recordWords[rng.IntN(len(recordWords))])
+		}
+		records[i] = Record{
+			Name:  first + " " + last,
+			Email: fmt.Sprintf("%s.%d@example.com", strings.ToLower(first), rng.IntN(10000)),
+			Bio:   bio.String(),
+		}
+	}
+	return records
+}
+
+// recordsSize returns the size of the fields of the records.
+func recordsSize(records []Record) int {
+	var size int
+	for _, r := range records {
+		size += 8 + len(r.Name) + len(r.Email) + len(r.Bio)
+	}
+	return size
+}
+
+// checkRecords checks that the records replied by the server match the ones
+// sent to it, with their names converted by UpperName.
+func checkRecords(in, out []Record, upperNames []string) error {
+	for i := range in {
+		want := in[i]
+		want.Name = upperNames[i]
+		if out[i] != want {
+			return fmt.Errorf("mismatch in record %d: got %+v, want %+v", i, out[i], want)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+// Copyright (c) 2025 Matheus Degiovani
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcbench
+
+import (
+	"fmt"
+	"math/rand/v2"
+)
+
+// Row is a wide database-like row, with fields of mixed types. Half of its
+// fields are optional (nil when unset), and most of them are unset on the rows
+// of ClientCallRows calls.
+type Row struct {
+	ID        int64   `json:"id"`
+	AccountID int64   `json:"accountId"`
+	CreatedAt int64   `json:"createdAt"`
+	UpdatedAt int64   `json:"updatedAt"`
+	Balance   int64   `json:"balance"`
+	Version   int32   `json:"version"`
+	Status    int32   `json:"status"`
+	Score     float64 `json:"score"`
+	Active    bool    `json:"active"`
+	Verified  bool    `json:"verified"`
+	Name      string  `json:"name"`
+	Email     string  `json:"email"`
+	Country   string  `json:"country"`
+	Currency  string  `json:"currency"`
+	Plan      string  `json:"plan"`
+	Region    string  `json:"region"`
+
+	DeletedAt   *int64   `json:"deletedAt,omitempty"`
+	ParentID    *int64   `json:"parentId,omitempty"`
+	CreditLimit *int64   `json:"creditLimit,omitempty"`
+	Priority    *int32   `json:"priority,omitempty"`
+	Retries     *int32   `json:"retries,omitempty"`
+	Discount    *float64 `json:"discount,omitempty"`
+	Archived    *bool    `json:"archived,omitempty"`
+	TwoFactor   *bool    `json:"twoFactor,omitempty"`
+	ExternalID  *string  `json:"externalId,omitempty"`
+	Phone       *string  `json:"phone,omitempty"`
+	Company     *string  `json:"company,omitempty"`
+	Title       *string  `json:"title,omitempty"`
+	Locale      *string  `json:"locale,omitempty"`
+	Timezone    *string  `json:"timezone,omitempty"`
+	Referrer    *string  `json:"referrer,omitempty"`
+	Notes       *string  `json:"notes,omitempty"`
+}
+
+// rowOptionalFraction is the fraction of the optional fields that are set on
+// the rows of ClientCallRows calls.
+const rowOptionalFraction = 0.25
+
+var (
+	rowCountries  = []string{"BR", "US", "DE", "JP", "IN", "NG", "FR", "GB"}
+	rowCurrencies = []string{"BRL", "USD", "EUR", "JPY", "INR", "NGN", "GBP"}
+	rowPlans      = []string{"free", "starter", "pro", "enterprise"}
+	rowRegions    = []string{"sa-east-1", "us-east-1", "eu-central-1", "ap-northeast-1"}
+	rowTimezones  = []string{"America/Sao_Paulo", "America/New_York", "Europe/Berlin", "Asia/Tokyo"}
+	rowLocales    = []string{"pt-BR", "en-US", "de-DE", "ja-JP"}
+)
+
+// optional returns a pointer to v, or nil when the field should be unset.
+func optional[T any](rng *rand.Rand, v T) *T {
+	if rng.Float64() >= rowOptionalFraction {
+		return nil
+	}
+	return &v
+}
+
+func pick(rng *rand.Rand, l []string) string {
+	return l[rng.IntN(len(l))]
+}
+
+// makeRows returns n rows with random fields. Their ids and versions are set on
+// every call.
+func makeRows(rng *rand.Rand, n int) []Row {
+	rows := make([]Row, n)
+	records := makeRecords(rng, n)
+	for i := range rows {
+		created := rng.Int64N(1 << 60)
+		rows[i] = Row{
+			AccountID: rng.Int64(),
+			CreatedAt: created,
+			UpdatedAt: created + rng.Int64N(1<<40),
+			Balance:   rng.Int64() - rng.Int64(),
+			Status:    rng.Int32N(8),
+			Score:     rng.NormFloat64() * 100,
+			Active:    rng.IntN(2) == 0,
+			Verified:  rng.IntN(2) == 0,
+			Name:      records[i].Name,
+			Email:     records[i].Email,
+			Country:   pick(rng, rowCountries),
+			Currency:  pick(rng, rowCurrencies),
+			Plan:      pick(rng, rowPlans),
+			Region:    pick(rng, rowRegions),
+
+			DeletedAt:   optional(rng, created+rng.Int64N(1<<41)),
+			ParentID:    optional(rng, rng.Int64()),
+			CreditLimit: optional(rng, rng.Int64N(1<<32)),
+			Priority:    optional(rng, rng.Int32N(10)),
+			Retries:     optional(rng, rng.Int32N(5)),
+			Discount:    optional(rng, rng.Float64()),
+			Archived:    optional(rng, rng.IntN(2) == 0),
+			TwoFactor:   optional(rng, rng.IntN(2) == 0),
+			ExternalID:  optional(rng, fmt.Sprintf("ext-%016x", rng.Uint64())),
+			Phone:       optional(rng, fmt.Sprintf("+%d %09d", 1+rng.IntN(99), rng.IntN(1e9))),
+			Company:     optional(rng, pick(rng, recordLastNames)+" Ltd."),
+			Title:       optional(rng, pick(rng, recordWords)),
+			Locale:      optional(rng, pick(rng, rowLocales)),
+			Timezone:    optional(rng, pick(rng, rowTimezones)),
+			Referrer:    optional(rng, "https://example.com/"+pick(rng, recordWords)),
+			Notes:       optional(rng, records[i].Bio),
+		}
+	}
+	return rows
+}
+
+// rowsSize returns the size of the (set) fields of the rows, with every
+// numeric field taking 8 bytes.
+func rowsSize(rows []Row) int {
+	strLen := func(s *string) int {
+		if s == nil {
+			return 0
+		}
+		return len(*s)
+	}
+	var size int
+	for i := range rows {
+		r := &rows[i]
+		size += 10*8 + len(r.Name) + len(r.Email) + len(r.Country) +
+			len(r.Currency) + len(r.Plan) + len(r.Region)
+		for _, set := range []bool{r.DeletedAt != nil, r.ParentID != nil,
+			r.CreditLimit != nil, r.Priority != nil, r.Retries != nil,
+			r.Discount != nil, r.Archived != nil, r.TwoFactor != nil} {
+			if set {
+				size += 8
+			}
+		}
+		for _, s := range []*string{r.ExternalID, r.Phone, r.Company, r.Title,
+			r.Locale, r.Timezone, r.Referrer, r.Notes} {
+			size += strLen(s)
+		}
+	}
+	return size
+}
+
+// optEqual returns true if both optional fields are unset, or if both are set
+// to the same value.
+func optEqual[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func (r *Row) equal(o *Row) bool {
+	return r.ID == o.ID && r.AccountID == o.AccountID &&
+		r.CreatedAt == o.CreatedAt && r.UpdatedAt == o.UpdatedAt &&
+		r.Balance == o.Balance && r.Version == o.Version &&
+		r.Status == o.Status && r.Score == o.Score &&
+		r.Active == o.Active && r.Verified == o.Verified &&
+		r.Name == o.Name && r.Email == o.Email &&
+		r.Country == o.Country && r.Currency == o.Currency &&
+		r.Plan == o.Plan && r.Region == o.Region &&
+		optEqual(r.DeletedAt, o.DeletedAt) && optEqual(r.ParentID, o.ParentID) &&
+		optEqual(r.CreditLimit, o.CreditLimit) && optEqual(r.Priority, o.Priority) &&
+		optEqual(r.Retries, o.Retries) && optEqual(r.Discount, o.Discount) &&
+		optEqual(r.Archived, o.Archived) && optEqual(r.TwoFactor, o.TwoFactor) &&
+		optEqual(r.ExternalID, o.ExternalID) && optEqual(r.Phone, o.Phone) &&
+		optEqual(r.Company, o.Company) && optEqual(r.Title, o.Title) &&
+		optEqual(r.Locale, o.Locale) && optEqual(r.Timezone, o.Timezone) &&
+		optEqual(r.Referrer, o.Referrer) && optEqual(r.Notes, o.Notes)
+}
+
+// checkRows checks that the rows replied by the server match the ones sent to
+// it, with their versions incremented.
+func checkRows(in, out []Row) error {
+	for i := range in {
+		want := in[i]
+		want.Version++
+		if !out[i].equal(&want) {
+			return fmt.Errorf("mismatch in row %d", i)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,312 @@
+// Copyright (c) 2025 Matheus Degiovani
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcbench
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matheusd/gorpcbench/internal/netutils"
+)
+
+// serverProcEnv is the environment variable that signals to a re-executed
+// benchmark binary that it should only run the server of the named RPC system.
+const serverProcEnv = "GORPCBENCH_SERVER_PROC"
+
+// serverProcNetworkEnv is the environment variable with the network the server
+// process listens on.
+const serverProcNetworkEnv = "GORPCBENCH_SERVER_NETWORK"
+
+// serverProcLinkEnv is the environment variable with the name of the link
+// profile emulated by the server process (if any).
+const serverProcLinkEnv = "GORPCBENCH_SERVER_LINK"
+
+// serverProcTLSEnv and serverProcCertEnv are the environment variables with the
+// TLS mode of the server process and its base64-encoded certificate chain and
+// key.
+const (
+	serverProcTLSEnv  = "GORPCBENCH_SERVER_TLS"
+	serverProcCertEnv = "GORPCBENCH_SERVER_CERT"
+)
+
+// Commands sent from the benchmark process to the server process. Each command
+// is a single line written to the stdin of the server process, and each reply
+// is a single line written to its stdout.
+const (
+	serverProcCmdReset = "reset"
+	serverProcCmdCost  = "cost"
+)
+
+// procCost tracks the resources used by a process.
+type procCost struct {
+	cpu    time.Duration
+	allocs uint64
+	bytes  uint64
+}
+
+func readProcCost() procCost {
+	var ms runtime.MemStats
+	runtime.ReadMemStats(&ms)
+	return procCost{
+		cpu:    processCPUTime(),
+		allocs: ms.Mallocs,
+		bytes:  ms.TotalAlloc,
+	}
+}
+
+func (pc procCost) sub(other procCost) procCost {
+	return procCost{
+		cpu:    pc.cpu - other.cpu,
+		allocs: pc.allocs - other.allocs,
+		bytes:  pc.bytes - other.bytes,
+	}
+}
+
+// report adds the per-op costs as metrics of the benchmark, with the given
+// prefix.
+func (pc procCost) report(b *testing.B, prefix string, n int64) {
+	if n == 0 {
+		return
+	}
+	b.ReportMetric(float64(pc.cpu.Nanoseconds())/float64(n), prefix+"-cpu-ns/op")
+	b.ReportMetric(float64(pc.allocs)/float64(n), prefix+"-allocs/op")
+	b.ReportMetric(float64(pc.bytes)/float64(n), prefix+"-B/op")
+}
+
+// MaybeRunServerProcess runs the server of one of the passed systems when the
+// current process was started by the harness as a server process (see
+// [BenchCase.ServerProcess]). In that case, this function never returns.
+// Otherwise, this returns immediately.
+//
+// This MUST be called before any benchmark is executed (for example, in
+// TestMain), otherwise server process cases will fail.
+func MaybeRunServerProcess(systems []RPCSystem) {
+	name := os.Getenv(serverProcEnv)
+	if name == "" {
+		return
+	}
+
+	cfg, err := serverConfigFromEnv()
+	if err == nil {
+		err = runServerProcess(name, systems, cfg, os.Stdin, os.Stdout)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Server process error: %v\n", err)
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
+
+// serverConfigFromEnv returns the server config passed by the benchmark process
+// to the server process.
+func serverConfigFromEnv() (serverConfig, error) {
+	cfg := serverConfig{network: os.Getenv(serverProcNetworkEnv)}
+	if linkName := os.Getenv(serverProcLinkEnv); linkName != "" {
+		links := LinkProfiles()
+		i := slices.IndexFunc(links, func(lp *LinkProfile) bool { return lp.Name == linkName })
+		if i < 0 {
+			return cfg, fmt.Errorf("unknown link profile %q", linkName)
+		}
+		cfg.link = links[i]
+	}
+	if tlsMode := os.Getenv(serverProcTLSEnv); tlsMode != "" {
+		modes := []TLSMode{TLSOn, TLSResume}
+		i := slices.IndexFunc(modes, func(m TLSMode) bool { return m.String() == tlsMode })
+		if i < 0 {
+			return cfg, fmt.Errorf("unknown TLS mode %q", tlsMode)
+		}
+		certPEM, err := base64.StdEncoding.DecodeString(os.Getenv(serverProcCertEnv))
+		if err != nil {
+			return cfg, fmt.Errorf("invalid server certificate: %w", err)
+		}
+		cfg.tlsMode, cfg.certPEM = modes[i], certPEM
+	}
+	return cfg, nil
+}
+
+// runServerProcess runs the server of the named system until in is closed.
+//
+// The address of the server is written as the first line of out. After that,
+// commands are read from in and replied to in out.
+func runServerProcess(name string, systems []RPCSystem, cfg serverConfig, in io.Reader, out io.Writer) error {
+	i := slices.IndexFunc(systems, func(sys RPCSystem) bool { return sys.Name == name })
+	if i < 0 {
+		return fmt.Errorf("unknown RPC system %q", name)
+	}
+
+	l, cleanupListener, err := cfg.listen()
+	if err != nil {
+		return err
+	}
+	defer cleanupListener()
+
+	s, err := systems[i].Initer().NewServer(l)
+	if err != nil {
+		l.Close()
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	runChan := make(chan error, 1)
+	go func() { runChan <- s.Run(ctx) }()
+
+	if _, err := fmt.Fprintln(out, l.Addr().String()); err != nil {
+		return err
+	}
+
+	var base procCost
+	scanner := bufio.NewScanner(in)
+	for scanner.Scan() {
+		switch cmd := scanner.Text(); cmd {
+		case serverProcCmdReset:
+			base = readProcCost()
+			_, err = fmt.Fprintln(out, "ok")
+		case serverProcCmdCost:
+			pc := readProcCost().sub(base)
+			_, err = fmt.Fprintln(out, int64(pc.cpu), pc.allocs, pc.bytes)
+		default:
+			err = fmt.Errorf("unknown command %q", cmd)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	// Input was closed, therefore the benchmark process is done with this
+	// server.
+	cancel()
+	select {
+	case runErr := <-runChan:
+		// The benchmark process may tear down its clients before the
+		// server process is stopped, so servers may fail to (for
+		// example) send a TLS close notification on their conns during
+		// shutdown.
+		if runErr != nil && !errors.Is(runErr, context.Canceled) && !netutils.IsConnClosedErr(runErr) {
+			return runErr
+		}
+		return nil
+	case <-time.After(time.Second):
+		return errors.New("timed out waiting for server Run() to finish")
+	}
+}
+
+// serverProcess is the benchmark side of a server running in a child process.
+type serverProcess struct {
+	cmd *exec.Cmd
+	in  io.WriteCloser
+	out *bufio.Reader
+}
+
+// startServerProcess re-executes the current binary as a server process for the
+// given system. It returns after the child reports the address of its server.
+func startServerProcess(t testing.TB, sys *RPCSystem, cfg serverConfig) (*serverProcess, string, error) {
+	exe, err := os.Executable()
+	if err != nil {
+		return nil, "", err
+	}
+
+	// The test flags ensure that, even if the binary does not call
+	// MaybeRunServerProcess, it won't recursively run the benchmarks.
+	cmd := exec.Command(exe, "-test.run=^$", "-test.bench=^$")
+	cmd.Env = append(os.Environ(), serverProcEnv+"="+sys.Name, serverProcNetworkEnv+"="+cfg.network)
+	cmd.Env = append(cmd.Env, maxMessageSizeEnv+"="+strconv.Itoa(MaxMessageSize()))
+	if cfg.link != nil {
+		cmd.Env = append(cmd.Env, serverProcLinkEnv+"="+cfg.link.Name)
+	}
+	if cfg.tlsMode != TLSOff {
+		cmd.Env = append(cmd.Env, serverProcTLSEnv+"="+cfg.tlsMode.String(),
+			serverProcCertEnv+"="+base64.StdEncoding.EncodeToString(cfg.certPEM))
+	}
+	cmd.Stderr = os.Stderr
+	in, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, "", err
+	}
+	out, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, "", err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, "", err
+	}
+
+	sp := &serverProcess{
+		cmd: cmd,
+		in:  in,
+		out: bufio.NewReader(out),
+	}
+
+	t.Cleanup(func() {
+		// Closing stdin tells the server process to stop.
+		in.Close()
+		waitChan := make(chan error, 1)
+		go func() { waitChan <- cmd.Wait() }()
+		select {
+		case err := <-waitChan:
+			if err != nil {
+				t.Errorf("Error running server process: %v", err)
+			}
+		case <-time.After(5 * time.Second):
+			cmd.Process.Kill()
+			t.Error("Timed out waiting for server process to finish")
+		}
+	})
+
+	addr, err := sp.out.ReadString('\n')
+	if err != nil {
+		return nil, "", fmt.Errorf("server process did not report its address: %w", err)
+	}
+
+	return sp, strings.TrimSpace(addr), nil
+}
+
+// command sends a command to the server process and returns its reply.
+func (sp *serverProcess) command(cmd string) (string, error) {
+	if _, err := fmt.Fprintln(sp.in, cmd); err != nil {
+		return "", err
+	}
+	reply, err := sp.out.ReadString('\n')
+	if err != nil {
+		return "", fmt.Errorf("server process did not reply to %q: %w", cmd, err)
+	}
+	return strings.TrimSpace(reply), nil
+}
+
+// resetCost resets the baseline used to track costs in the server process.
+func (sp *serverProcess) resetCost() error {
+	_, err := sp.command(serverProcCmdReset)
+	return err
+}
+
+// cost returns the costs incurred by the server process since the last reset.
+func (sp *serverProcess) cost() (procCost, error) {
+	reply, err := sp.command(serverProcCmdCost)
+	if err != nil {
+		return procCost{}, err
+	}
+	var cpu int64
+	var pc procCost
+	if _, err := fmt.Sscan(reply, &cpu, &pc.allocs, &pc.bytes); err != nil {
+		return procCost{}, fmt.Errorf("unable to parse server process cost %q: %w", reply, err)
+	}
+	pc.cpu = time.Duration(cpu)
+	return pc, nil
+}
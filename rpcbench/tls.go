@@ -0,0 +1,180 @@
+// Copyright (c) 2025 Matheus Degiovani
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcbench
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// TLSMode is the TLS configuration used by the clients and server of a test.
+type TLSMode int
+
+const (
+	// TLSOff does not use TLS.
+	TLSOff TLSMode = iota
+
+	// TLSOn uses TLS, with full handshakes on every connection.
+	TLSOn
+
+	// TLSResume uses TLS 1.3 with session resumption: after the first
+	// connection, every connection is established using a session ticket
+	// issued by the server.
+	TLSResume
+)
+
+func (m TLSMode) String() string {
+	switch m {
+	case TLSOff:
+		return "notls"
+	case TLSOn:
+		return "tls"
+	case TLSResume:
+		return "tlsresume"
+	default:
+		panic("unknown tls mode")
+	}
+}
+
+// tlsServerName is the name clients use to verify the server's certificate.
+const tlsServerName = "localhost"
+
+// testCerts are the certificates used in TLS tests.
+type testCerts struct {
+	roots *x509.CertPool
+
+	// pem has the server certificate chain and key, PEM-encoded.
+	pem []byte
+}
+
+var (
+	testCertsOnce sync.Once
+	testCertsVal  *testCerts
+	testCertsErr  error
+)
+
+// getTestCerts returns the certificates used in TLS tests. They are generated
+// (in memory) only once per process.
+func getTestCerts() (*testCerts, error) {
+	testCertsOnce.Do(func() {
+		testCertsVal, testCertsErr = genTestCerts()
+	})
+	return testCertsVal, testCertsErr
+}
+
+// genTestCerts generates a self-signed CA and a server certificate signed by
+// it, valid for localhost.
+func genTestCerts() (*testCerts, error) {
+	notBefore := time.Now().Add(-time.Hour)
+	notAfter := notBefore.Add(7 * 24 * time.Hour)
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	caTmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "gorpcbench CA"},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTmpl, caTmpl, &caKey.PublicKey, caKey)
+	if err != nil {
+		return nil, err
+	}
+	caCert, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: tlsServerName},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		DNSNames:     []string{tlsServerName},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, caCert, &key.PublicKey, caKey)
+	if err != nil {
+		return nil, err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	pem.Encode(&buf, &pem.Block{Type: "CERTIFICATE", Bytes: der})
+	pem.Encode(&buf, &pem.Block{Type: "CERTIFICATE", Bytes: caDER})
+	pem.Encode(&buf, &pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+
+	roots := x509.NewCertPool()
+	roots.AddCert(caCert)
+	return &testCerts{roots: roots, pem: buf.Bytes()}, nil
+}
+
+// serverTLSConfig returns the TLS config for a server, given the
+// PEM-encoded certificate chain and key.
+func serverTLSConfig(mode TLSMode, certPEM []byte) (*tls.Config, error) {
+	if mode == TLSOff {
+		return nil, errors.New("TLS is not enabled")
+	}
+	cert, err := tls.X509KeyPair(certPEM, certPEM)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+
+		// h2 is required by gRPC.
+		NextProtos: []string{"h2", "http/1.1"},
+
+		SessionTicketsDisabled: mode != TLSResume,
+	}, nil
+}
+
+// clientTLSConfig returns the TLS config shared by all clients of a test. In
+// TLSResume mode, the handshakes that resumed a session are counted in resumed.
+func clientTLSConfig(mode TLSMode, certs *testCerts, resumed *atomic.Int64) *tls.Config {
+	cfg := &tls.Config{
+		RootCAs:    certs.roots,
+		ServerName: tlsServerName,
+	}
+	if mode == TLSResume {
+		cfg.MinVersion = tls.VersionTLS13
+		cfg.ClientSessionCache = tls.NewLRUClientSessionCache(0)
+
+		// This is also called for resumed sessions.
+		cfg.VerifyConnection = func(cs tls.ConnectionState) error {
+			if cs.DidResume {
+				resumed.Add(1)
+			}
+			return nil
+		}
+	}
+	return cfg
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2025 Matheus Degiovani
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcbench
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+)
+
+// vectorSpecialValues are the values mixed into the vectors of ClientCallVector
+// calls, which exercise the encoding of floats beyond the normal ones.
+var vectorSpecialValues = []float64{math.NaN(), math.Inf(1), math.Inf(-1),
+	math.Copysign(0, -1), math.SmallestNonzeroFloat64, math.MaxFloat64}
+
+// makeVector returns a vector of n random floats, with each of the special
+// values at a random position.
+func makeVector(rng *rand.Rand, n int) []float64 {
+	v := make([]float64, n)
+	for i := range v {
+		v[i] = rng.NormFloat64() * 1000
+	}
+	for _, special := range vectorSpecialValues {
+		v[rng.IntN(n)] = special
+	}
+	return v
+}
+
+// sameFloat returns true if both floats are NaN, or if both have the same bits
+// (which tells apart zero and negative zero).
+func sameFloat(a, b float64) bool {
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return math.IsNaN(a) && math.IsNaN(b)
+	}
+	return math.Float64bits(a) == math.Float64bits(b)
+}
+
+// checkVector checks that the vector replied by the server is the one sent to
+// it, scaled by scale.
+func checkVector(in []float64, scale float64, out []float64) error {
+	for i := range in {
+		if want := in[i] * scale; !sameFloat(out[i], want) {
+			return fmt.Errorf("mismatch in element %d: got %v, want %v", i, out[i], want)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+// Copyright (c) 2025 Matheus Degiovani
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcbench
+
+import "time"
+
+// Work is the work done by servers to handle the Work calls of
+// ClientCallHeadOfLine cases.
+type Work struct {
+	// Duration is the time spent handling the call.
+	Duration time.Duration
+
+	// CPU makes the server spend Duration busy on the CPU, instead of
+	// sleeping.
+	CPU bool
+}
+
+// Do does the work, blocking the calling goroutine until it is done.
+func (w Work) Do() {
+	if !w.CPU {
+		time.Sleep(w.Duration)
+		return
+	}
+	for start := time.Now(); time.Since(start) < w.Duration; {
+	}
+}